@@ -1,12 +1,70 @@
 package credentials
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/stretchr/testify/assert"
 )
 
+type secondStubProvider struct {
+	creds   Value
+	expired bool
+	err     error
+}
+
+func (s *secondStubProvider) Retrieve() (Value, error) {
+	s.expired = false
+	s.creds.ProviderName = "secondStubProvider"
+	return s.creds, s.err
+}
+func (s *secondStubProvider) IsExpired() bool {
+	return s.expired
+}
+
+func TestChainProviderWithNames(t *testing.T) {
+	p := &ChainProvider{
+		Providers: []Provider{
+			&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
+			&stubProvider{err: awserr.New("SecondError", "second provider error", nil)},
+			&secondStubProvider{
+				creds: Value{
+					AccessKeyID:     "AKIF",
+					SecretAccessKey: "NOSECRET",
+					SessionToken:    "",
+				},
+			},
+			&stubProvider{
+				creds: Value{
+					AccessKeyID:     "AKID",
+					SecretAccessKey: "SECRET",
+					SessionToken:    "",
+				},
+			},
+		},
+	}
+
+	creds, err := p.Retrieve()
+	if err != nil {
+		t.Errorf("Expect no error, got %v", err)
+	}
+	if e, a := "secondStubProvider", creds.ProviderName; e != a {
+		t.Errorf("Expect provider name to match, %v got, %v", e, a)
+	}
+
+	// Also check credentials
+	if e, a := "AKIF", creds.AccessKeyID; e != a {
+		t.Errorf("Expect access key ID to match, %v got %v", e, a)
+	}
+	if e, a := "NOSECRET", creds.SecretAccessKey; e != a {
+		t.Errorf("Expect secret access key to match, %v got %v", e, a)
+	}
+	if v := creds.SessionToken; len(v) != 0 {
+		t.Errorf("Expect session token to be empty, %v", v)
+	}
+
+}
+
 func TestChainProviderGet(t *testing.T) {
 	p := &ChainProvider{
 		Providers: []Provider{
@@ -23,10 +81,18 @@ func TestChainProviderGet(t *testing.T) {
 	}
 
 	creds, err := p.Retrieve()
-	assert.Nil(t, err, "Expect no error")
-	assert.Equal(t, "AKID", creds.AccessKeyID, "Expect access key ID to match")
-	assert.Equal(t, "SECRET", creds.SecretAccessKey, "Expect secret access key to match")
-	assert.Empty(t, creds.SessionToken, "Expect session token to be empty")
+	if err != nil {
+		t.Errorf("Expect no error, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("Expect access key ID to match, %v got %v", e, a)
+	}
+	if e, a := "SECRET", creds.SecretAccessKey; e != a {
+		t.Errorf("Expect secret access key to match, %v got %v", e, a)
+	}
+	if v := creds.SessionToken; len(v) != 0 {
+		t.Errorf("Expect session token to be empty, %v", v)
+	}
 }
 
 func TestChainProviderIsExpired(t *testing.T) {
@@ -37,16 +103,29 @@ func TestChainProviderIsExpired(t *testing.T) {
 		},
 	}
 
-	assert.True(t, p.IsExpired(), "Expect expired to be true before any Retrieve")
+	if !p.IsExpired() {
+		t.Errorf("Expect expired to be true before any Retrieve")
+	}
 	_, err := p.Retrieve()
-	assert.Nil(t, err, "Expect no error")
-	assert.False(t, p.IsExpired(), "Expect not expired after retrieve")
+	if err != nil {
+		t.Errorf("Expect no error, got %v", err)
+	}
+	if p.IsExpired() {
+		t.Errorf("Expect not expired after retrieve")
+	}
 
 	stubProvider.expired = true
-	assert.True(t, p.IsExpired(), "Expect return of expired provider")
+	if !p.IsExpired() {
+		t.Errorf("Expect return of expired provider")
+	}
 
 	_, err = p.Retrieve()
-	assert.False(t, p.IsExpired(), "Expect not expired after retrieve")
+	if err != nil {
+		t.Errorf("Expect no error, got %v", err)
+	}
+	if p.IsExpired() {
+		t.Errorf("Expect not expired after retrieve")
+	}
 }
 
 func TestChainProviderWithNoProvider(t *testing.T) {
@@ -54,20 +133,57 @@ func TestChainProviderWithNoProvider(t *testing.T) {
 		Providers: []Provider{},
 	}
 
-	assert.True(t, p.IsExpired(), "Expect expired with no providers")
+	if !p.IsExpired() {
+		t.Errorf("Expect expired with no providers")
+	}
 	_, err := p.Retrieve()
-	assert.Equal(t, ErrNoValidProvidersFoundInChain, err, "Expect no providers error returned")
+	if e, a := ErrNoValidProvidersFoundInChain, err; e != a {
+		t.Errorf("Expect no providers error returned, %v, got %v", e, a)
+	}
 }
 
 func TestChainProviderWithNoValidProvider(t *testing.T) {
+	errs := []error{
+		awserr.New("FirstError", "first provider error", nil),
+		awserr.New("SecondError", "second provider error", nil),
+	}
 	p := &ChainProvider{
 		Providers: []Provider{
-			&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
-			&stubProvider{err: awserr.New("SecondError", "second provider error", nil)},
+			&stubProvider{err: errs[0]},
+			&stubProvider{err: errs[1]},
 		},
 	}
 
-	assert.True(t, p.IsExpired(), "Expect expired with no providers")
+	if !p.IsExpired() {
+		t.Errorf("Expect expired with no providers")
+	}
 	_, err := p.Retrieve()
-	assert.Equal(t, ErrNoValidProvidersFoundInChain, err, "Expect no providers error returned")
+
+	if e, a := ErrNoValidProvidersFoundInChain, err; e != a {
+		t.Errorf("Expect no providers error returned, %v, got %v", e, a)
+	}
+}
+
+func TestChainProviderWithNoValidProviderWithVerboseEnabled(t *testing.T) {
+	errs := []error{
+		awserr.New("FirstError", "first provider error", nil),
+		awserr.New("SecondError", "second provider error", nil),
+	}
+	p := &ChainProvider{
+		VerboseErrors: true,
+		Providers: []Provider{
+			&stubProvider{err: errs[0]},
+			&stubProvider{err: errs[1]},
+		},
+	}
+
+	if !p.IsExpired() {
+		t.Errorf("Expect expired with no providers")
+	}
+	_, err := p.Retrieve()
+
+	expectErr := awserr.NewBatchError("NoCredentialProviders", "no valid providers in chain", errs)
+	if e, a := expectErr, err; !reflect.DeepEqual(e, a) {
+		t.Errorf("Expect no providers error returned, %v, got %v", e, a)
+	}
 }
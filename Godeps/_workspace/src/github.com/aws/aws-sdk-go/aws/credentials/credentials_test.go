@@ -1,10 +1,12 @@
 package credentials
 
 import (
+	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/stretchr/testify/assert"
 )
 
 type stubProvider struct {
@@ -15,6 +17,7 @@ type stubProvider struct {
 
 func (s *stubProvider) Retrieve() (Value, error) {
 	s.expired = false
+	s.creds.ProviderName = "stubProvider"
 	return s.creds, s.err
 }
 func (s *stubProvider) IsExpired() bool {
@@ -32,17 +35,27 @@ func TestCredentialsGet(t *testing.T) {
 	})
 
 	creds, err := c.Get()
-	assert.Nil(t, err, "Expected no error")
-	assert.Equal(t, "AKID", creds.AccessKeyID, "Expect access key ID to match")
-	assert.Equal(t, "SECRET", creds.SecretAccessKey, "Expect secret access key to match")
-	assert.Empty(t, creds.SessionToken, "Expect session token to be empty")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("Expect access key ID to match, %v got %v", e, a)
+	}
+	if e, a := "SECRET", creds.SecretAccessKey; e != a {
+		t.Errorf("Expect secret access key to match, %v got %v", e, a)
+	}
+	if v := creds.SessionToken; len(v) != 0 {
+		t.Errorf("Expect session token to be empty, %v", v)
+	}
 }
 
 func TestCredentialsGetWithError(t *testing.T) {
 	c := NewCredentials(&stubProvider{err: awserr.New("provider error", "", nil), expired: true})
 
 	_, err := c.Get()
-	assert.Equal(t, "provider error", err.(awserr.Error).Code(), "Expected provider error")
+	if e, a := "provider error", err.(awserr.Error).Code(); e != a {
+		t.Errorf("Expected provider error, %v got %v", e, a)
+	}
 }
 
 func TestCredentialsExpire(t *testing.T) {
@@ -50,13 +63,111 @@ func TestCredentialsExpire(t *testing.T) {
 	c := NewCredentials(stub)
 
 	stub.expired = false
-	assert.True(t, c.IsExpired(), "Expected to start out expired")
+	if !c.IsExpired() {
+		t.Errorf("Expected to start out expired")
+	}
 	c.Expire()
-	assert.True(t, c.IsExpired(), "Expected to be expired")
+	if !c.IsExpired() {
+		t.Errorf("Expected to be expired")
+	}
 
 	c.forceRefresh = false
-	assert.False(t, c.IsExpired(), "Expected not to be expired")
+	if c.IsExpired() {
+		t.Errorf("Expected not to be expired")
+	}
 
 	stub.expired = true
-	assert.True(t, c.IsExpired(), "Expected to be expired")
+	if !c.IsExpired() {
+		t.Errorf("Expected to be expired")
+	}
+}
+
+type MockProvider struct {
+	Expiry
+}
+
+func (*MockProvider) Retrieve() (Value, error) {
+	return Value{}, nil
+}
+
+func TestCredentialsGetWithProviderName(t *testing.T) {
+	stub := &stubProvider{}
+
+	c := NewCredentials(stub)
+
+	creds, err := c.Get()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if e, a := creds.ProviderName, "stubProvider"; e != a {
+		t.Errorf("Expected provider name to match, %v got %v", e, a)
+	}
+}
+
+func TestCredentialsIsExpired_Race(t *testing.T) {
+	creds := NewChainCredentials([]Provider{&MockProvider{}})
+
+	starter := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(10)
+	for i := 0; i < 10; i++ {
+		go func() {
+			defer wg.Done()
+			<-starter
+			for i := 0; i < 100; i++ {
+				creds.IsExpired()
+			}
+		}()
+	}
+	close(starter)
+
+	wg.Wait()
+}
+
+func TestCredentialsExpiresAt_NoExpirer(t *testing.T) {
+	stub := &stubProvider{}
+	c := NewCredentials(stub)
+
+	_, err := c.ExpiresAt()
+	if e, a := "ProviderNotExpirer", err.(awserr.Error).Code(); e != a {
+		t.Errorf("Expected provider error, %v got %v", e, a)
+	}
+}
+
+type stubProviderExpirer struct {
+	stubProvider
+	expiration time.Time
+}
+
+func (s *stubProviderExpirer) ExpiresAt() time.Time {
+	return s.expiration
+}
+
+func TestCredentialsExpiresAt_HasExpirer(t *testing.T) {
+	stub := &stubProviderExpirer{}
+	c := NewCredentials(stub)
+
+	// fetch initial credentials so that forceRefresh is set false
+	_, err := c.Get()
+	if err != nil {
+		t.Errorf("Unexpecte error: %v", err)
+	}
+
+	stub.expiration = time.Unix(rand.Int63(), 0)
+	expiration, err := c.ExpiresAt()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if stub.expiration != expiration {
+		t.Errorf("Expected matching expiration, %v got %v", stub.expiration, expiration)
+	}
+
+	c.Expire()
+	expiration, err = c.ExpiresAt()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if !expiration.IsZero() {
+		t.Errorf("Expected distant past expiration, got %v", expiration)
+	}
 }
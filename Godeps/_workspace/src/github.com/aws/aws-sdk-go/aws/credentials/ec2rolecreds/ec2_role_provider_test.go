@@ -7,13 +7,11 @@ import (
 	"testing"
 	"time"
 
-	"github.com/stretchr/testify/assert"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
 )
 
 const credsRespTmpl = `{
@@ -34,7 +32,7 @@ const credsFailRespTmpl = `{
 
 func initTestServer(expireOn string, failAssume bool) *httptest.Server {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/latest/meta-data/iam/security-credentials" {
+		if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
 			fmt.Fprintln(w, "RoleName")
 		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/RoleName" {
 			if failAssume {
@@ -43,7 +41,7 @@ func initTestServer(expireOn string, failAssume bool) *httptest.Server {
 				fmt.Fprintf(w, credsRespTmpl, expireOn)
 			}
 		} else {
-			http.Error(w, "bad request", http.StatusBadRequest)
+			http.Error(w, "Not found", http.StatusNotFound)
 		}
 	}))
 
@@ -55,15 +53,23 @@ func TestEC2RoleProvider(t *testing.T) {
 	defer server.Close()
 
 	p := &ec2rolecreds.EC2RoleProvider{
-		Client: ec2metadata.New(session.New(), &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+		Client: ec2metadata.New(unit.Session, &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
 	}
 
 	creds, err := p.Retrieve()
-	assert.Nil(t, err, "Expect no error, %v", err)
+	if err != nil {
+		t.Errorf("Expect no error, got %v", err)
+	}
 
-	assert.Equal(t, "accessKey", creds.AccessKeyID, "Expect access key ID to match")
-	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
-	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+	if e, a := "accessKey", creds.AccessKeyID; e != a {
+		t.Errorf("Expect access key ID to match, %v got %v", e, a)
+	}
+	if e, a := "secret", creds.SecretAccessKey; e != a {
+		t.Errorf("Expect secret access key to match, %v got %v", e, a)
+	}
+	if e, a := "token", creds.SessionToken; e != a {
+		t.Errorf("Expect session token to match, %v got %v", e, a)
+	}
 }
 
 func TestEC2RoleProviderFailAssume(t *testing.T) {
@@ -71,20 +77,34 @@ func TestEC2RoleProviderFailAssume(t *testing.T) {
 	defer server.Close()
 
 	p := &ec2rolecreds.EC2RoleProvider{
-		Client: ec2metadata.New(session.New(), &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+		Client: ec2metadata.New(unit.Session, &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
 	}
 
 	creds, err := p.Retrieve()
-	assert.Error(t, err, "Expect error")
+	if err == nil {
+		t.Errorf("Expect error")
+	}
 
 	e := err.(awserr.Error)
-	assert.Equal(t, "ErrorCode", e.Code())
-	assert.Equal(t, "ErrorMsg", e.Message())
-	assert.Nil(t, e.OrigErr())
+	if e, a := "ErrorCode", e.Code(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "ErrorMsg", e.Message(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if v := e.OrigErr(); v != nil {
+		t.Errorf("expect nil, got %v", v)
+	}
 
-	assert.Equal(t, "", creds.AccessKeyID, "Expect access key ID to match")
-	assert.Equal(t, "", creds.SecretAccessKey, "Expect secret access key to match")
-	assert.Equal(t, "", creds.SessionToken, "Expect session token to match")
+	if e, a := "", creds.AccessKeyID; e != a {
+		t.Errorf("Expect access key ID to match, %v got %v", e, a)
+	}
+	if e, a := "", creds.SecretAccessKey; e != a {
+		t.Errorf("Expect secret access key to match, %v got %v", e, a)
+	}
+	if e, a := "", creds.SessionToken; e != a {
+		t.Errorf("Expect session token to match, %v got %v", e, a)
+	}
 }
 
 func TestEC2RoleProviderIsExpired(t *testing.T) {
@@ -92,24 +112,32 @@ func TestEC2RoleProviderIsExpired(t *testing.T) {
 	defer server.Close()
 
 	p := &ec2rolecreds.EC2RoleProvider{
-		Client: ec2metadata.New(session.New(), &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+		Client: ec2metadata.New(unit.Session, &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
 	}
 	p.CurrentTime = func() time.Time {
 		return time.Date(2014, 12, 15, 21, 26, 0, 0, time.UTC)
 	}
 
-	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve.")
+	if !p.IsExpired() {
+		t.Errorf("Expect creds to be expired before retrieve.")
+	}
 
 	_, err := p.Retrieve()
-	assert.Nil(t, err, "Expect no error, %v", err)
+	if v := err; v != nil {
+		t.Errorf("Expect no error, %v", err)
+	}
 
-	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+	if p.IsExpired() {
+		t.Errorf("Expect creds to not be expired after retrieve.")
+	}
 
 	p.CurrentTime = func() time.Time {
 		return time.Date(3014, 12, 15, 21, 26, 0, 0, time.UTC)
 	}
 
-	assert.True(t, p.IsExpired(), "Expect creds to be expired.")
+	if !p.IsExpired() {
+		t.Errorf("Expect creds to be expired.")
+	}
 }
 
 func TestEC2RoleProviderExpiryWindowIsExpired(t *testing.T) {
@@ -117,25 +145,33 @@ func TestEC2RoleProviderExpiryWindowIsExpired(t *testing.T) {
 	defer server.Close()
 
 	p := &ec2rolecreds.EC2RoleProvider{
-		Client:       ec2metadata.New(session.New(), &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+		Client:       ec2metadata.New(unit.Session, &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
 		ExpiryWindow: time.Hour * 1,
 	}
 	p.CurrentTime = func() time.Time {
 		return time.Date(2014, 12, 15, 0, 51, 37, 0, time.UTC)
 	}
 
-	assert.True(t, p.IsExpired(), "Expect creds to be expired before retrieve.")
+	if !p.IsExpired() {
+		t.Errorf("Expect creds to be expired before retrieve.")
+	}
 
 	_, err := p.Retrieve()
-	assert.Nil(t, err, "Expect no error, %v", err)
+	if v := err; v != nil {
+		t.Errorf("Expect no error, %v", err)
+	}
 
-	assert.False(t, p.IsExpired(), "Expect creds to not be expired after retrieve.")
+	if p.IsExpired() {
+		t.Errorf("Expect creds to not be expired after retrieve.")
+	}
 
 	p.CurrentTime = func() time.Time {
 		return time.Date(2014, 12, 16, 0, 55, 37, 0, time.UTC)
 	}
 
-	assert.True(t, p.IsExpired(), "Expect creds to be expired.")
+	if !p.IsExpired() {
+		t.Errorf("Expect creds to be expired.")
+	}
 }
 
 func BenchmarkEC3RoleProvider(b *testing.B) {
@@ -143,7 +179,7 @@ func BenchmarkEC3RoleProvider(b *testing.B) {
 	defer server.Close()
 
 	p := &ec2rolecreds.EC2RoleProvider{
-		Client: ec2metadata.New(session.New(), &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
+		Client: ec2metadata.New(unit.Session, &aws.Config{Endpoint: aws.String(server.URL + "/latest")}),
 	}
 	_, err := p.Retrieve()
 	if err != nil {
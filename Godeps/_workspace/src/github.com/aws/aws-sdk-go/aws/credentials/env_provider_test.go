@@ -1,70 +1,106 @@
 package credentials
 
 import (
-	"github.com/stretchr/testify/assert"
 	"os"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/internal/sdktesting"
 )
 
 func TestEnvProviderRetrieve(t *testing.T) {
-	os.Clearenv()
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
 	os.Setenv("AWS_ACCESS_KEY_ID", "access")
 	os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
 	os.Setenv("AWS_SESSION_TOKEN", "token")
 
 	e := EnvProvider{}
 	creds, err := e.Retrieve()
-	assert.Nil(t, err, "Expect no error")
-
-	assert.Equal(t, "access", creds.AccessKeyID, "Expect access key ID to match")
-	assert.Equal(t, "secret", creds.SecretAccessKey, "Expect secret access key to match")
-	assert.Equal(t, "token", creds.SessionToken, "Expect session token to match")
+	if err != nil {
+		t.Errorf("expect nil, got %v", err)
+	}
+
+	if e, a := "access", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "secret", creds.SecretAccessKey; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "token", creds.SessionToken; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
 }
 
 func TestEnvProviderIsExpired(t *testing.T) {
-	os.Clearenv()
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
 	os.Setenv("AWS_ACCESS_KEY_ID", "access")
 	os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
 	os.Setenv("AWS_SESSION_TOKEN", "token")
 
 	e := EnvProvider{}
 
-	assert.True(t, e.IsExpired(), "Expect creds to be expired before retrieve.")
+	if !e.IsExpired() {
+		t.Errorf("Expect creds to be expired before retrieve.")
+	}
 
 	_, err := e.Retrieve()
-	assert.Nil(t, err, "Expect no error")
+	if err != nil {
+		t.Errorf("expect nil, got %v", err)
+	}
 
-	assert.False(t, e.IsExpired(), "Expect creds to not be expired after retrieve.")
+	if e.IsExpired() {
+		t.Errorf("Expect creds to not be expired after retrieve.")
+	}
 }
 
 func TestEnvProviderNoAccessKeyID(t *testing.T) {
-	os.Clearenv()
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
 	os.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
 
 	e := EnvProvider{}
-	creds, err := e.Retrieve()
-	assert.Equal(t, ErrAccessKeyIDNotFound, err, "ErrAccessKeyIDNotFound expected, but was %#v error: %#v", creds, err)
+	_, err := e.Retrieve()
+	if e, a := ErrAccessKeyIDNotFound, err; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
 }
 
 func TestEnvProviderNoSecretAccessKey(t *testing.T) {
-	os.Clearenv()
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
 	os.Setenv("AWS_ACCESS_KEY_ID", "access")
 
 	e := EnvProvider{}
-	creds, err := e.Retrieve()
-	assert.Equal(t, ErrSecretAccessKeyNotFound, err, "ErrSecretAccessKeyNotFound expected, but was %#v error: %#v", creds, err)
+	_, err := e.Retrieve()
+	if e, a := ErrSecretAccessKeyNotFound, err; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
 }
 
 func TestEnvProviderAlternateNames(t *testing.T) {
-	os.Clearenv()
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
 	os.Setenv("AWS_ACCESS_KEY", "access")
 	os.Setenv("AWS_SECRET_KEY", "secret")
 
 	e := EnvProvider{}
 	creds, err := e.Retrieve()
-	assert.Nil(t, err, "Expect no error")
-
-	assert.Equal(t, "access", creds.AccessKeyID, "Expected access key ID")
-	assert.Equal(t, "secret", creds.SecretAccessKey, "Expected secret access key")
-	assert.Empty(t, creds.SessionToken, "Expected no token")
+	if err != nil {
+		t.Errorf("expect nil, got %v", err)
+	}
+
+	if e, a := "access", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "secret", creds.SecretAccessKey; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if v := creds.SessionToken; len(v) != 0 {
+		t.Errorf("Expected no token, %v", v)
+	}
 }
@@ -1,7 +1,6 @@
 package credentials
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
@@ -15,10 +14,18 @@ func TestStaticProviderGet(t *testing.T) {
 	}
 
 	creds, err := s.Retrieve()
-	assert.Nil(t, err, "Expect no error")
-	assert.Equal(t, "AKID", creds.AccessKeyID, "Expect access key ID to match")
-	assert.Equal(t, "SECRET", creds.SecretAccessKey, "Expect secret access key to match")
-	assert.Empty(t, creds.SessionToken, "Expect no session token")
+	if err != nil {
+		t.Errorf("expect nil, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "SECRET", creds.SecretAccessKey; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if v := creds.SessionToken; len(v) != 0 {
+		t.Errorf("Expect no session token, %v", v)
+	}
 }
 
 func TestStaticProviderIsExpired(t *testing.T) {
@@ -30,5 +37,7 @@ func TestStaticProviderIsExpired(t *testing.T) {
 		},
 	}
 
-	assert.False(t, s.IsExpired(), "Expect static credentials to never expire")
+	if s.IsExpired() {
+		t.Errorf("Expect static credentials to never expire")
+	}
 }
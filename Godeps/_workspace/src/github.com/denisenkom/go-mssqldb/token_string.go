@@ -0,0 +1,53 @@
+// Code generated by "stringer -type token"; DO NOT EDIT
+
+package mssql
+
+import "fmt"
+
+const (
+	_token_name_0 = "tokenReturnStatus"
+	_token_name_1 = "tokenColMetadata"
+	_token_name_2 = "tokenOrdertokenErrortokenInfo"
+	_token_name_3 = "tokenLoginAck"
+	_token_name_4 = "tokenRowtokenNbcRow"
+	_token_name_5 = "tokenEnvChange"
+	_token_name_6 = "tokenSSPI"
+	_token_name_7 = "tokenDonetokenDoneProctokenDoneInProc"
+)
+
+var (
+	_token_index_0 = [...]uint8{0, 17}
+	_token_index_1 = [...]uint8{0, 16}
+	_token_index_2 = [...]uint8{0, 10, 20, 29}
+	_token_index_3 = [...]uint8{0, 13}
+	_token_index_4 = [...]uint8{0, 8, 19}
+	_token_index_5 = [...]uint8{0, 14}
+	_token_index_6 = [...]uint8{0, 9}
+	_token_index_7 = [...]uint8{0, 9, 22, 37}
+)
+
+func (i token) String() string {
+	switch {
+	case i == 121:
+		return _token_name_0
+	case i == 129:
+		return _token_name_1
+	case 169 <= i && i <= 171:
+		i -= 169
+		return _token_name_2[_token_index_2[i]:_token_index_2[i+1]]
+	case i == 173:
+		return _token_name_3
+	case 209 <= i && i <= 210:
+		i -= 209
+		return _token_name_4[_token_index_4[i]:_token_index_4[i+1]]
+	case i == 227:
+		return _token_name_5
+	case i == 237:
+		return _token_name_6
+	case 253 <= i && i <= 255:
+		i -= 253
+		return _token_name_7[_token_index_7[i]:_token_index_7[i+1]]
+	default:
+		return fmt.Sprintf("token(%d)", i)
+	}
+}
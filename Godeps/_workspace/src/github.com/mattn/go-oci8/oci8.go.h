@@ -0,0 +1,2 @@
+#include <oci.h>
+#include <stdlib.h>
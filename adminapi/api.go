@@ -0,0 +1,172 @@
+package adminapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/pivotal-golang/lager"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi/auth"
+)
+
+const instanceIDLogKey = "instance-id"
+const bindingIDLogKey = "binding-id"
+
+type AdminBroker interface {
+	RotateMasterPassword(instanceID string) error
+	RotateBindingCredentials(instanceID string) error
+	FetchBinding(instanceID, bindingID string) (brokerapi.BindingResponse, error)
+	CreateSnapshot(instanceID string) (Snapshot, error)
+	ListSnapshots(instanceID string) ([]Snapshot, error)
+}
+
+type Snapshot struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	Type      string    `json:"type,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SnapshotsResponse struct {
+	Snapshots []Snapshot `json:"snapshots"`
+}
+
+func New(adminBroker AdminBroker, logger lager.Logger, credentials brokerapi.BrokerCredentials) http.Handler {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/admin/service_instances/{instance_id}/rotate_master_password", rotateMasterPassword(adminBroker, logger)).Methods("POST")
+	router.HandleFunc("/admin/service_instances/{instance_id}/rotate_binding_credentials", rotateBindingCredentials(adminBroker, logger)).Methods("POST")
+	router.HandleFunc("/admin/service_instances/{instance_id}/service_bindings/{binding_id}", fetchBinding(adminBroker, logger)).Methods("GET")
+	router.HandleFunc("/admin/service_instances/{instance_id}/snapshots", createSnapshot(adminBroker, logger)).Methods("POST")
+	router.HandleFunc("/admin/service_instances/{instance_id}/snapshots", listSnapshots(adminBroker, logger)).Methods("GET")
+
+	return auth.NewWrapper(credentials.Username, credentials.Password).Wrap(router)
+}
+
+func rotateMasterPassword(adminBroker AdminBroker, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instanceID := mux.Vars(req)["instance_id"]
+
+		logger := logger.Session("rotate-master-password", lager.Data{
+			instanceIDLogKey: instanceID,
+		})
+
+		if err := adminBroker.RotateMasterPassword(instanceID); err != nil {
+			respondError(w, logger, err)
+			return
+		}
+
+		respond(w, http.StatusAccepted, brokerapi.EmptyResponse{})
+	}
+}
+
+func rotateBindingCredentials(adminBroker AdminBroker, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instanceID := mux.Vars(req)["instance_id"]
+
+		logger := logger.Session("rotate-binding-credentials", lager.Data{
+			instanceIDLogKey: instanceID,
+		})
+
+		if err := adminBroker.RotateBindingCredentials(instanceID); err != nil {
+			respondError(w, logger, err)
+			return
+		}
+
+		respond(w, http.StatusOK, brokerapi.EmptyResponse{})
+	}
+}
+
+func fetchBinding(adminBroker AdminBroker, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		vars := mux.Vars(req)
+		instanceID := vars["instance_id"]
+		bindingID := vars["binding_id"]
+
+		logger := logger.Session("fetch-binding", lager.Data{
+			instanceIDLogKey: instanceID,
+			bindingIDLogKey:  bindingID,
+		})
+
+		bindingResponse, err := adminBroker.FetchBinding(instanceID, bindingID)
+		if err != nil {
+			respondError(w, logger, err)
+			return
+		}
+
+		respond(w, http.StatusOK, bindingResponse)
+	}
+}
+
+func createSnapshot(adminBroker AdminBroker, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instanceID := mux.Vars(req)["instance_id"]
+
+		logger := logger.Session("create-snapshot", lager.Data{
+			instanceIDLogKey: instanceID,
+		})
+
+		snapshot, err := adminBroker.CreateSnapshot(instanceID)
+		if err != nil {
+			respondError(w, logger, err)
+			return
+		}
+
+		respond(w, http.StatusAccepted, snapshot)
+	}
+}
+
+func listSnapshots(adminBroker AdminBroker, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instanceID := mux.Vars(req)["instance_id"]
+
+		logger := logger.Session("list-snapshots", lager.Data{
+			instanceIDLogKey: instanceID,
+		})
+
+		snapshots, err := adminBroker.ListSnapshots(instanceID)
+		if err != nil {
+			respondError(w, logger, err)
+			return
+		}
+
+		respond(w, http.StatusOK, SnapshotsResponse{Snapshots: snapshots})
+	}
+}
+
+func respondError(w http.ResponseWriter, logger lager.Logger, err error) {
+	switch err {
+	case brokerapi.ErrInstanceDoesNotExist:
+		logger.Error("instance-missing", err)
+		respond(w, http.StatusNotFound, brokerapi.ErrorResponse{
+			Description: err.Error(),
+		})
+	case brokerapi.ErrBindingDoesNotExist:
+		logger.Error("binding-missing", err)
+		respond(w, http.StatusNotFound, brokerapi.ErrorResponse{
+			Description: err.Error(),
+		})
+	case brokerapi.ErrConcurrentAccess:
+		logger.Error("concurrent-access", err)
+		respond(w, http.StatusUnprocessableEntity, brokerapi.ErrorResponse{
+			Error:       "ConcurrencyError",
+			Description: err.Error(),
+		})
+	default:
+		logger.Error("unknown-error", err)
+		respond(w, http.StatusInternalServerError, brokerapi.ErrorResponse{
+			Description: err.Error(),
+		})
+	}
+}
+
+func respond(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	encoder := json.NewEncoder(w)
+	encoder.Encode(response)
+}
@@ -0,0 +1,215 @@
+package adminapi_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/adminapi"
+
+	"github.com/pivotal-golang/lager/lagertest"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/adminapi/fakes"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+)
+
+var _ = Describe("Admin API", func() {
+	var (
+		adminBroker *fakes.FakeAdminBroker
+		adminAPI    http.Handler
+
+		credentials = brokerapi.BrokerCredentials{
+			Username: "username",
+			Password: "password",
+		}
+	)
+
+	BeforeEach(func() {
+		adminBroker = &fakes.FakeAdminBroker{}
+		adminAPI = New(adminBroker, lagertest.NewTestLogger("admin-api"), credentials)
+	})
+
+	makeRequest := func(method string, path string, username string, password string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(method, path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.SetBasicAuth(username, password)
+		adminAPI.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	Describe("authentication", func() {
+		It("returns 401 when the credentials are not valid", func() {
+			response := makeRequest("POST", "/admin/service_instances/instance-id/rotate_master_password", "username", "wrong")
+			Expect(response.Code).To(Equal(http.StatusUnauthorized))
+			Expect(adminBroker.RotateMasterPasswordCalled).To(BeFalse())
+		})
+	})
+
+	Describe("rotating the master password", func() {
+		path := "/admin/service_instances/instance-id/rotate_master_password"
+
+		It("calls the broker with the instance ID", func() {
+			response := makeRequest("POST", path, "username", "password")
+			Expect(response.Code).To(Equal(http.StatusAccepted))
+			Expect(response.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(adminBroker.RotateMasterPasswordCalled).To(BeTrue())
+			Expect(adminBroker.RotateMasterPasswordInstanceID).To(Equal("instance-id"))
+		})
+
+		It("does not accept other methods", func() {
+			response := makeRequest("GET", path, "username", "password")
+			Expect(response.Code).To(Equal(http.StatusNotFound))
+			Expect(adminBroker.RotateMasterPasswordCalled).To(BeFalse())
+		})
+
+		Context("when the instance does not exist", func() {
+			BeforeEach(func() {
+				adminBroker.RotateMasterPasswordError = brokerapi.ErrInstanceDoesNotExist
+			})
+
+			It("returns 404", func() {
+				response := makeRequest("POST", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the instance has an operation in progress", func() {
+			BeforeEach(func() {
+				adminBroker.RotateMasterPasswordError = brokerapi.ErrConcurrentAccess
+			})
+
+			It("returns 422", func() {
+				response := makeRequest("POST", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(response.Body.String()).To(ContainSubstring("ConcurrencyError"))
+			})
+		})
+
+		Context("when rotating the master password fails", func() {
+			BeforeEach(func() {
+				adminBroker.RotateMasterPasswordError = errors.New("operation failed")
+			})
+
+			It("returns 500 with the error description", func() {
+				response := makeRequest("POST", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusInternalServerError))
+				Expect(response.Body.String()).To(ContainSubstring("operation failed"))
+			})
+		})
+	})
+
+	Describe("rotating the binding credentials", func() {
+		path := "/admin/service_instances/instance-id/rotate_binding_credentials"
+
+		It("calls the broker with the instance ID", func() {
+			response := makeRequest("POST", path, "username", "password")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(adminBroker.RotateBindingCredentialsCalled).To(BeTrue())
+			Expect(adminBroker.RotateBindingCredentialsInstanceID).To(Equal("instance-id"))
+		})
+
+		Context("when the instance does not exist", func() {
+			BeforeEach(func() {
+				adminBroker.RotateBindingCredentialsError = brokerapi.ErrInstanceDoesNotExist
+			})
+
+			It("returns 404", func() {
+				response := makeRequest("POST", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("fetching a binding", func() {
+		path := "/admin/service_instances/instance-id/service_bindings/binding-id"
+
+		BeforeEach(func() {
+			adminBroker.FetchBindingBindingResponse = brokerapi.BindingResponse{
+				Credentials: &brokerapi.CredentialsHash{
+					Username: "binding-username",
+					Password: "binding-password",
+				},
+			}
+		})
+
+		It("returns the binding credentials", func() {
+			response := makeRequest("GET", path, "username", "password")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(adminBroker.FetchBindingInstanceID).To(Equal("instance-id"))
+			Expect(adminBroker.FetchBindingBindingID).To(Equal("binding-id"))
+			Expect(response.Body.String()).To(MatchJSON(`{"credentials":{"username":"binding-username","password":"binding-password"}}`))
+		})
+
+		Context("when the binding does not exist", func() {
+			BeforeEach(func() {
+				adminBroker.FetchBindingError = brokerapi.ErrBindingDoesNotExist
+			})
+
+			It("returns 404", func() {
+				response := makeRequest("GET", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("creating a snapshot", func() {
+		path := "/admin/service_instances/instance-id/snapshots"
+
+		BeforeEach(func() {
+			adminBroker.CreateSnapshotSnapshot = Snapshot{ID: "snapshot-id", Status: "creating", Type: "manual"}
+		})
+
+		It("returns the snapshot being created", func() {
+			response := makeRequest("POST", path, "username", "password")
+			Expect(response.Code).To(Equal(http.StatusAccepted))
+			Expect(adminBroker.CreateSnapshotCalled).To(BeTrue())
+			Expect(adminBroker.CreateSnapshotInstanceID).To(Equal("instance-id"))
+			Expect(response.Body.String()).To(ContainSubstring(`"id":"snapshot-id"`))
+			Expect(response.Body.String()).To(ContainSubstring(`"status":"creating"`))
+		})
+
+		Context("when the instance does not exist", func() {
+			BeforeEach(func() {
+				adminBroker.CreateSnapshotError = brokerapi.ErrInstanceDoesNotExist
+			})
+
+			It("returns 404", func() {
+				response := makeRequest("POST", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("listing the snapshots", func() {
+		path := "/admin/service_instances/instance-id/snapshots"
+
+		BeforeEach(func() {
+			adminBroker.ListSnapshotsSnapshots = []Snapshot{
+				Snapshot{ID: "snapshot-1", Status: "available", Type: "manual", CreatedAt: time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)},
+			}
+		})
+
+		It("returns the snapshots", func() {
+			response := makeRequest("GET", path, "username", "password")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(adminBroker.ListSnapshotsInstanceID).To(Equal("instance-id"))
+			Expect(response.Body.String()).To(MatchJSON(`{"snapshots":[{"id":"snapshot-1","status":"available","type":"manual","created_at":"2016-01-02T03:04:05Z"}]}`))
+		})
+
+		Context("when listing the snapshots fails", func() {
+			BeforeEach(func() {
+				adminBroker.ListSnapshotsError = errors.New("operation failed")
+			})
+
+			It("returns 500", func() {
+				response := makeRequest("GET", path, "username", "password")
+				Expect(response.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -0,0 +1,69 @@
+package fakes
+
+import (
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/adminapi"
+)
+
+type FakeAdminBroker struct {
+	RotateMasterPasswordCalled     bool
+	RotateMasterPasswordInstanceID string
+	RotateMasterPasswordError      error
+
+	RotateBindingCredentialsCalled     bool
+	RotateBindingCredentialsInstanceID string
+	RotateBindingCredentialsError      error
+
+	FetchBindingCalled          bool
+	FetchBindingInstanceID      string
+	FetchBindingBindingID       string
+	FetchBindingBindingResponse brokerapi.BindingResponse
+	FetchBindingError           error
+
+	CreateSnapshotCalled     bool
+	CreateSnapshotInstanceID string
+	CreateSnapshotSnapshot   adminapi.Snapshot
+	CreateSnapshotError      error
+
+	ListSnapshotsCalled     bool
+	ListSnapshotsInstanceID string
+	ListSnapshotsSnapshots  []adminapi.Snapshot
+	ListSnapshotsError      error
+}
+
+func (f *FakeAdminBroker) RotateMasterPassword(instanceID string) error {
+	f.RotateMasterPasswordCalled = true
+	f.RotateMasterPasswordInstanceID = instanceID
+
+	return f.RotateMasterPasswordError
+}
+
+func (f *FakeAdminBroker) RotateBindingCredentials(instanceID string) error {
+	f.RotateBindingCredentialsCalled = true
+	f.RotateBindingCredentialsInstanceID = instanceID
+
+	return f.RotateBindingCredentialsError
+}
+
+func (f *FakeAdminBroker) FetchBinding(instanceID, bindingID string) (brokerapi.BindingResponse, error) {
+	f.FetchBindingCalled = true
+	f.FetchBindingInstanceID = instanceID
+	f.FetchBindingBindingID = bindingID
+
+	return f.FetchBindingBindingResponse, f.FetchBindingError
+}
+
+func (f *FakeAdminBroker) CreateSnapshot(instanceID string) (adminapi.Snapshot, error) {
+	f.CreateSnapshotCalled = true
+	f.CreateSnapshotInstanceID = instanceID
+
+	return f.CreateSnapshotSnapshot, f.CreateSnapshotError
+}
+
+func (f *FakeAdminBroker) ListSnapshots(instanceID string) ([]adminapi.Snapshot, error) {
+	f.ListSnapshotsCalled = true
+	f.ListSnapshotsInstanceID = instanceID
+
+	return f.ListSnapshotsSnapshots, f.ListSnapshotsError
+}
@@ -2,6 +2,7 @@ package awsrds
 
 import (
 	"errors"
+	"time"
 )
 
 type DBCluster interface {
@@ -9,6 +10,15 @@ type DBCluster interface {
 	Create(ID string, dbClusterDetails DBClusterDetails) error
 	Modify(ID string, dbClusterDetails DBClusterDetails, applyImmediately bool) error
 	Delete(ID string, skipFinalSnapshot bool) error
+	Stop(ID string) error
+	Start(ID string) error
+	ModifyMasterPassword(ID string, masterPassword string) error
+	CreateSnapshot(ID string, snapshotID string, tags map[string]string) error
+	DescribeSnapshot(snapshotID string) (DBSnapshotDetails, error)
+	ListSnapshots(ID string) ([]DBSnapshotDetails, error)
+	RestoreFromSnapshot(ID string, snapshotID string, dbClusterDetails DBClusterDetails) error
+	RestoreToPointInTime(ID string, sourceID string, restoreTime time.Time, dbClusterDetails DBClusterDetails) error
+	DescribeTags(ID string) (map[string]string, error)
 }
 
 type DBClusterDetails struct {
@@ -18,11 +28,14 @@ type DBClusterDetails struct {
 	AvailabilityZones           []string
 	BackupRetentionPeriod       int64
 	CharacterSetName            string
+	DBClusterMembers            []string
 	DBClusterParameterGroupName string
 	DBSubnetGroupName           string
 	DatabaseName                string
 	Endpoint                    string
+	ReaderEndpoint              string
 	Engine                      string
+	EngineMode                  string
 	EngineVersion               string
 	MasterUsername              string
 	MasterUserPassword          string
@@ -30,10 +43,24 @@ type DBClusterDetails struct {
 	Port                        int64
 	PreferredBackupWindow       string
 	PreferredMaintenanceWindow  string
+	ScalingConfiguration        *ScalingConfiguration
 	VpcSecurityGroupIds         []string
 	Tags                        map[string]string
 }
 
+// ScalingConfiguration is the capacity range of an Aurora Serverless DB Cluster, in Aurora capacity units
+type ScalingConfiguration struct {
+	MinCapacity           int64
+	MaxCapacity           int64
+	AutoPause             bool
+	SecondsUntilAutoPause int64
+}
+
+const (
+	EngineModeProvisioned = "provisioned"
+	EngineModeServerless  = "serverless"
+)
+
 var (
 	ErrDBClusterDoesNotExist = errors.New("rds db cluster does not exist")
 )
@@ -0,0 +1,22 @@
+package awsrds
+
+import (
+	"time"
+)
+
+const (
+	DBEventSourceTypeDBInstance = "db-instance"
+	DBEventSourceTypeDBCluster  = "db-cluster"
+)
+
+type DBEvents interface {
+	Describe(sourceType string, sourceID string, startTime time.Time) ([]DBEventDetails, error)
+}
+
+type DBEventDetails struct {
+	SourceIdentifier string
+	SourceType       string
+	Message          string
+	EventCategories  []string
+	Date             time.Time
+}
@@ -2,6 +2,7 @@ package awsrds
 
 import (
 	"errors"
+	"time"
 )
 
 type DBInstance interface {
@@ -9,6 +10,16 @@ type DBInstance interface {
 	Create(ID string, dbInstanceDetails DBInstanceDetails) error
 	Modify(ID string, dbInstanceDetails DBInstanceDetails, applyImmediately bool) error
 	Delete(ID string, skipFinalSnapshot bool) error
+	Stop(ID string) error
+	Start(ID string) error
+	ModifyMasterPassword(ID string, masterPassword string) error
+	CreateSnapshot(ID string, snapshotID string, tags map[string]string) error
+	DescribeSnapshot(snapshotID string) (DBSnapshotDetails, error)
+	ListSnapshots(ID string) ([]DBSnapshotDetails, error)
+	RestoreFromSnapshot(ID string, snapshotID string, dbInstanceDetails DBInstanceDetails) error
+	RestoreToPointInTime(ID string, sourceID string, restoreTime time.Time, dbInstanceDetails DBInstanceDetails) error
+	DescribeTags(ID string) (map[string]string, error)
+	CreateReadReplica(ID string, sourceID string, dbInstanceDetails DBInstanceDetails) error
 }
 
 type DBInstanceDetails struct {
@@ -0,0 +1,21 @@
+package awsrds
+
+import (
+	"errors"
+	"time"
+)
+
+type DBSnapshotDetails struct {
+	Identifier       string
+	SourceIdentifier string
+	Status           string
+	Engine           string
+	EngineVersion    string
+	SnapshotType     string
+	CreateTime       time.Time
+	Tags             map[string]string
+}
+
+var (
+	ErrDBSnapshotDoesNotExist = errors.New("rds db snapshot does not exist")
+)
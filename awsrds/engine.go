@@ -0,0 +1,56 @@
+package awsrds
+
+import (
+	"strings"
+)
+
+const (
+	ProtocolMySQL     = "mysql"
+	ProtocolPostgres  = "postgres"
+	ProtocolOracle    = "oracle"
+	ProtocolSQLServer = "sqlserver"
+)
+
+// EngineFamily describes how an RDS engine is provisioned and how to connect to its databases
+type EngineFamily struct {
+	// Cluster engines are provisioned as a DB Cluster holding one or more DB Instances
+	Cluster bool
+
+	// Protocol is the wire protocol spoken by the engine databases
+	Protocol string
+
+	// ReadReplicas tells if the engine supports read replicas
+	ReadReplicas bool
+}
+
+var engineFamilies = map[string]EngineFamily{
+	"aurora":            {Cluster: true, Protocol: ProtocolMySQL, ReadReplicas: true},
+	"aurora-mysql":      {Cluster: true, Protocol: ProtocolMySQL, ReadReplicas: true},
+	"aurora-postgresql": {Cluster: true, Protocol: ProtocolPostgres, ReadReplicas: true},
+	"mariadb":           {Protocol: ProtocolMySQL, ReadReplicas: true},
+	"mysql":             {Protocol: ProtocolMySQL, ReadReplicas: true},
+	"oracle-ee":         {Protocol: ProtocolOracle},
+	"oracle-se":         {Protocol: ProtocolOracle},
+	"oracle-se1":        {Protocol: ProtocolOracle},
+	"oracle-se2":        {Protocol: ProtocolOracle},
+	"postgres":          {Protocol: ProtocolPostgres, ReadReplicas: true},
+	"sqlserver-ee":      {Protocol: ProtocolSQLServer},
+	"sqlserver-ex":      {Protocol: ProtocolSQLServer},
+	"sqlserver-se":      {Protocol: ProtocolSQLServer},
+	"sqlserver-web":     {Protocol: ProtocolSQLServer},
+}
+
+func FindEngineFamily(engine string) (EngineFamily, bool) {
+	engineFamily, ok := engineFamilies[strings.ToLower(engine)]
+	return engineFamily, ok
+}
+
+func IsClusterEngine(engine string) bool {
+	engineFamily, _ := FindEngineFamily(engine)
+	return engineFamily.Cluster
+}
+
+func EngineProtocol(engine string) string {
+	engineFamily, _ := FindEngineFamily(engine)
+	return engineFamily.Protocol
+}
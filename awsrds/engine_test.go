@@ -0,0 +1,56 @@
+package awsrds_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+)
+
+var _ = Describe("Engine Families", func() {
+	Describe("FindEngineFamily", func() {
+		It("returns the proper Engine Family", func() {
+			engineFamily, ok := FindEngineFamily("aurora-postgresql")
+			Expect(ok).To(BeTrue())
+			Expect(engineFamily).To(Equal(EngineFamily{Cluster: true, Protocol: ProtocolPostgres, ReadReplicas: true}))
+		})
+
+		It("ignores the engine case", func() {
+			engineFamily, ok := FindEngineFamily("MySQL")
+			Expect(ok).To(BeTrue())
+			Expect(engineFamily.Protocol).To(Equal(ProtocolMySQL))
+		})
+
+		It("returns false if the engine is not supported", func() {
+			_, ok := FindEngineFamily("unknown")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("IsClusterEngine", func() {
+		It("returns true for Aurora engines", func() {
+			Expect(IsClusterEngine("aurora")).To(BeTrue())
+			Expect(IsClusterEngine("aurora-mysql")).To(BeTrue())
+			Expect(IsClusterEngine("aurora-postgresql")).To(BeTrue())
+		})
+
+		It("returns false for DB Instance engines", func() {
+			Expect(IsClusterEngine("mysql")).To(BeFalse())
+			Expect(IsClusterEngine("postgres")).To(BeFalse())
+			Expect(IsClusterEngine("unknown")).To(BeFalse())
+		})
+	})
+
+	Describe("EngineProtocol", func() {
+		It("returns the engine wire protocol", func() {
+			Expect(EngineProtocol("aurora-mysql")).To(Equal(ProtocolMySQL))
+			Expect(EngineProtocol("aurora-postgresql")).To(Equal(ProtocolPostgres))
+			Expect(EngineProtocol("oracle-se2")).To(Equal(ProtocolOracle))
+			Expect(EngineProtocol("sqlserver-ex")).To(Equal(ProtocolSQLServer))
+		})
+
+		It("returns an empty protocol if the engine is not supported", func() {
+			Expect(EngineProtocol("unknown")).To(BeEmpty())
+		})
+	})
+})
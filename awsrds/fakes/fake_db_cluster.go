@@ -1,6 +1,8 @@
 package fakes
 
 import (
+	"time"
+
 	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
 )
 
@@ -25,6 +27,53 @@ type FakeDBCluster struct {
 	DeleteID                string
 	DeleteSkipFinalSnapshot bool
 	DeleteError             error
+
+	StopCalled bool
+	StopID     string
+	StopError  error
+
+	StartCalled bool
+	StartID     string
+	StartError  error
+
+	ModifyMasterPasswordCalled         bool
+	ModifyMasterPasswordID             string
+	ModifyMasterPasswordMasterPassword string
+	ModifyMasterPasswordError          error
+
+	CreateSnapshotCalled     bool
+	CreateSnapshotID         string
+	CreateSnapshotSnapshotID string
+	CreateSnapshotTags       map[string]string
+	CreateSnapshotError      error
+
+	DescribeSnapshotCalled            bool
+	DescribeSnapshotSnapshotID        string
+	DescribeSnapshotDBSnapshotDetails awsrds.DBSnapshotDetails
+	DescribeSnapshotError             error
+
+	ListSnapshotsCalled            bool
+	ListSnapshotsID                string
+	ListSnapshotsDBSnapshotDetails []awsrds.DBSnapshotDetails
+	ListSnapshotsError             error
+
+	RestoreFromSnapshotCalled           bool
+	RestoreFromSnapshotID               string
+	RestoreFromSnapshotSnapshotID       string
+	RestoreFromSnapshotDBClusterDetails awsrds.DBClusterDetails
+	RestoreFromSnapshotError            error
+
+	RestoreToPointInTimeCalled           bool
+	RestoreToPointInTimeID               string
+	RestoreToPointInTimeSourceID         string
+	RestoreToPointInTimeRestoreTime      time.Time
+	RestoreToPointInTimeDBClusterDetails awsrds.DBClusterDetails
+	RestoreToPointInTimeError            error
+
+	DescribeTagsCalled bool
+	DescribeTagsID     string
+	DescribeTagsTags   map[string]string
+	DescribeTagsError  error
 }
 
 func (f *FakeDBCluster) Describe(ID string) (awsrds.DBClusterDetails, error) {
@@ -58,3 +107,74 @@ func (f *FakeDBCluster) Delete(ID string, skipFinalSnapshot bool) error {
 
 	return f.DeleteError
 }
+
+func (f *FakeDBCluster) Stop(ID string) error {
+	f.StopCalled = true
+	f.StopID = ID
+
+	return f.StopError
+}
+
+func (f *FakeDBCluster) Start(ID string) error {
+	f.StartCalled = true
+	f.StartID = ID
+
+	return f.StartError
+}
+
+func (f *FakeDBCluster) ModifyMasterPassword(ID string, masterPassword string) error {
+	f.ModifyMasterPasswordCalled = true
+	f.ModifyMasterPasswordID = ID
+	f.ModifyMasterPasswordMasterPassword = masterPassword
+
+	return f.ModifyMasterPasswordError
+}
+
+func (f *FakeDBCluster) CreateSnapshot(ID string, snapshotID string, tags map[string]string) error {
+	f.CreateSnapshotCalled = true
+	f.CreateSnapshotID = ID
+	f.CreateSnapshotSnapshotID = snapshotID
+	f.CreateSnapshotTags = tags
+
+	return f.CreateSnapshotError
+}
+
+func (f *FakeDBCluster) DescribeSnapshot(snapshotID string) (awsrds.DBSnapshotDetails, error) {
+	f.DescribeSnapshotCalled = true
+	f.DescribeSnapshotSnapshotID = snapshotID
+
+	return f.DescribeSnapshotDBSnapshotDetails, f.DescribeSnapshotError
+}
+
+func (f *FakeDBCluster) ListSnapshots(ID string) ([]awsrds.DBSnapshotDetails, error) {
+	f.ListSnapshotsCalled = true
+	f.ListSnapshotsID = ID
+
+	return f.ListSnapshotsDBSnapshotDetails, f.ListSnapshotsError
+}
+
+func (f *FakeDBCluster) RestoreFromSnapshot(ID string, snapshotID string, dbClusterDetails awsrds.DBClusterDetails) error {
+	f.RestoreFromSnapshotCalled = true
+	f.RestoreFromSnapshotID = ID
+	f.RestoreFromSnapshotSnapshotID = snapshotID
+	f.RestoreFromSnapshotDBClusterDetails = dbClusterDetails
+
+	return f.RestoreFromSnapshotError
+}
+
+func (f *FakeDBCluster) RestoreToPointInTime(ID string, sourceID string, restoreTime time.Time, dbClusterDetails awsrds.DBClusterDetails) error {
+	f.RestoreToPointInTimeCalled = true
+	f.RestoreToPointInTimeID = ID
+	f.RestoreToPointInTimeSourceID = sourceID
+	f.RestoreToPointInTimeRestoreTime = restoreTime
+	f.RestoreToPointInTimeDBClusterDetails = dbClusterDetails
+
+	return f.RestoreToPointInTimeError
+}
+
+func (f *FakeDBCluster) DescribeTags(ID string) (map[string]string, error) {
+	f.DescribeTagsCalled = true
+	f.DescribeTagsID = ID
+
+	return f.DescribeTagsTags, f.DescribeTagsError
+}
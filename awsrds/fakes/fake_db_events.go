@@ -0,0 +1,33 @@
+package fakes
+
+import (
+	"time"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+)
+
+type FakeDBEvents struct {
+	DescribeCalled         bool
+	DescribeSourceType     string
+	DescribeSourceID       string
+	DescribeStartTime      time.Time
+	DescribeDBEventDetails []awsrds.DBEventDetails
+	DescribeError          error
+}
+
+// Describe returns the fake events of the described source only, as a DB Cluster and its members are described in turn
+func (f *FakeDBEvents) Describe(sourceType string, sourceID string, startTime time.Time) ([]awsrds.DBEventDetails, error) {
+	f.DescribeCalled = true
+	f.DescribeSourceType = sourceType
+	f.DescribeSourceID = sourceID
+	f.DescribeStartTime = startTime
+
+	dbEventsDetails := []awsrds.DBEventDetails{}
+	for _, dbEventDetails := range f.DescribeDBEventDetails {
+		if dbEventDetails.SourceIdentifier == sourceID {
+			dbEventsDetails = append(dbEventsDetails, dbEventDetails)
+		}
+	}
+
+	return dbEventsDetails, f.DescribeError
+}
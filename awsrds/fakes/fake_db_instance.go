@@ -1,6 +1,8 @@
 package fakes
 
 import (
+	"time"
+
 	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
 )
 
@@ -12,19 +14,76 @@ type FakeDBInstance struct {
 
 	CreateCalled            bool
 	CreateID                string
+	CreateIDs               []string
 	CreateDBInstanceDetails awsrds.DBInstanceDetails
 	CreateError             error
+	CreateErrorAfter        int
 
 	ModifyCalled            bool
 	ModifyID                string
+	ModifyIDs               []string
 	ModifyDBInstanceDetails awsrds.DBInstanceDetails
 	ModifyApplyImmediately  bool
 	ModifyError             error
 
 	DeleteCalled            bool
 	DeleteID                string
+	DeleteIDs               []string
 	DeleteSkipFinalSnapshot bool
 	DeleteError             error
+
+	StopCalled bool
+	StopID     string
+	StopError  error
+
+	StartCalled bool
+	StartID     string
+	StartError  error
+
+	ModifyMasterPasswordCalled         bool
+	ModifyMasterPasswordID             string
+	ModifyMasterPasswordMasterPassword string
+	ModifyMasterPasswordError          error
+
+	CreateSnapshotCalled     bool
+	CreateSnapshotID         string
+	CreateSnapshotSnapshotID string
+	CreateSnapshotTags       map[string]string
+	CreateSnapshotError      error
+
+	DescribeSnapshotCalled            bool
+	DescribeSnapshotSnapshotID        string
+	DescribeSnapshotDBSnapshotDetails awsrds.DBSnapshotDetails
+	DescribeSnapshotError             error
+
+	ListSnapshotsCalled            bool
+	ListSnapshotsID                string
+	ListSnapshotsDBSnapshotDetails []awsrds.DBSnapshotDetails
+	ListSnapshotsError             error
+
+	RestoreFromSnapshotCalled            bool
+	RestoreFromSnapshotID                string
+	RestoreFromSnapshotSnapshotID        string
+	RestoreFromSnapshotDBInstanceDetails awsrds.DBInstanceDetails
+	RestoreFromSnapshotError             error
+
+	RestoreToPointInTimeCalled            bool
+	RestoreToPointInTimeID                string
+	RestoreToPointInTimeSourceID          string
+	RestoreToPointInTimeRestoreTime       time.Time
+	RestoreToPointInTimeDBInstanceDetails awsrds.DBInstanceDetails
+	RestoreToPointInTimeError             error
+
+	DescribeTagsCalled bool
+	DescribeTagsID     string
+	DescribeTagsTags   map[string]string
+	DescribeTagsError  error
+
+	CreateReadReplicaCalled            bool
+	CreateReadReplicaID                string
+	CreateReadReplicaSourceID          string
+	CreateReadReplicaDBInstanceDetails awsrds.DBInstanceDetails
+	CreateReadReplicaError             error
 }
 
 func (f *FakeDBInstance) Describe(ID string) (awsrds.DBInstanceDetails, error) {
@@ -37,14 +96,20 @@ func (f *FakeDBInstance) Describe(ID string) (awsrds.DBInstanceDetails, error) {
 func (f *FakeDBInstance) Create(ID string, dbInstanceDetails awsrds.DBInstanceDetails) error {
 	f.CreateCalled = true
 	f.CreateID = ID
+	f.CreateIDs = append(f.CreateIDs, ID)
 	f.CreateDBInstanceDetails = dbInstanceDetails
 
+	if len(f.CreateIDs) <= f.CreateErrorAfter {
+		return nil
+	}
+
 	return f.CreateError
 }
 
 func (f *FakeDBInstance) Modify(ID string, dbInstanceDetails awsrds.DBInstanceDetails, applyImmediately bool) error {
 	f.ModifyCalled = true
 	f.ModifyID = ID
+	f.ModifyIDs = append(f.ModifyIDs, ID)
 	f.ModifyDBInstanceDetails = dbInstanceDetails
 	f.ModifyApplyImmediately = applyImmediately
 
@@ -54,7 +119,88 @@ func (f *FakeDBInstance) Modify(ID string, dbInstanceDetails awsrds.DBInstanceDe
 func (f *FakeDBInstance) Delete(ID string, skipFinalSnapshot bool) error {
 	f.DeleteCalled = true
 	f.DeleteID = ID
+	f.DeleteIDs = append(f.DeleteIDs, ID)
 	f.DeleteSkipFinalSnapshot = skipFinalSnapshot
 
 	return f.DeleteError
 }
+
+func (f *FakeDBInstance) Stop(ID string) error {
+	f.StopCalled = true
+	f.StopID = ID
+
+	return f.StopError
+}
+
+func (f *FakeDBInstance) Start(ID string) error {
+	f.StartCalled = true
+	f.StartID = ID
+
+	return f.StartError
+}
+
+func (f *FakeDBInstance) ModifyMasterPassword(ID string, masterPassword string) error {
+	f.ModifyMasterPasswordCalled = true
+	f.ModifyMasterPasswordID = ID
+	f.ModifyMasterPasswordMasterPassword = masterPassword
+
+	return f.ModifyMasterPasswordError
+}
+
+func (f *FakeDBInstance) CreateSnapshot(ID string, snapshotID string, tags map[string]string) error {
+	f.CreateSnapshotCalled = true
+	f.CreateSnapshotID = ID
+	f.CreateSnapshotSnapshotID = snapshotID
+	f.CreateSnapshotTags = tags
+
+	return f.CreateSnapshotError
+}
+
+func (f *FakeDBInstance) DescribeSnapshot(snapshotID string) (awsrds.DBSnapshotDetails, error) {
+	f.DescribeSnapshotCalled = true
+	f.DescribeSnapshotSnapshotID = snapshotID
+
+	return f.DescribeSnapshotDBSnapshotDetails, f.DescribeSnapshotError
+}
+
+func (f *FakeDBInstance) ListSnapshots(ID string) ([]awsrds.DBSnapshotDetails, error) {
+	f.ListSnapshotsCalled = true
+	f.ListSnapshotsID = ID
+
+	return f.ListSnapshotsDBSnapshotDetails, f.ListSnapshotsError
+}
+
+func (f *FakeDBInstance) RestoreFromSnapshot(ID string, snapshotID string, dbInstanceDetails awsrds.DBInstanceDetails) error {
+	f.RestoreFromSnapshotCalled = true
+	f.RestoreFromSnapshotID = ID
+	f.RestoreFromSnapshotSnapshotID = snapshotID
+	f.RestoreFromSnapshotDBInstanceDetails = dbInstanceDetails
+
+	return f.RestoreFromSnapshotError
+}
+
+func (f *FakeDBInstance) RestoreToPointInTime(ID string, sourceID string, restoreTime time.Time, dbInstanceDetails awsrds.DBInstanceDetails) error {
+	f.RestoreToPointInTimeCalled = true
+	f.RestoreToPointInTimeID = ID
+	f.RestoreToPointInTimeSourceID = sourceID
+	f.RestoreToPointInTimeRestoreTime = restoreTime
+	f.RestoreToPointInTimeDBInstanceDetails = dbInstanceDetails
+
+	return f.RestoreToPointInTimeError
+}
+
+func (f *FakeDBInstance) DescribeTags(ID string) (map[string]string, error) {
+	f.DescribeTagsCalled = true
+	f.DescribeTagsID = ID
+
+	return f.DescribeTagsTags, f.DescribeTagsError
+}
+
+func (f *FakeDBInstance) CreateReadReplica(ID string, sourceID string, dbInstanceDetails awsrds.DBInstanceDetails) error {
+	f.CreateReadReplicaCalled = true
+	f.CreateReadReplicaID = ID
+	f.CreateReadReplicaSourceID = sourceID
+	f.CreateReadReplicaDBInstanceDetails = dbInstanceDetails
+
+	return f.CreateReadReplicaError
+}
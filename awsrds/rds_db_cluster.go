@@ -3,6 +3,7 @@ package awsrds
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -138,11 +139,240 @@ func (r *RDSDBCluster) Delete(ID string, skipFinalSnapshot bool) error {
 
 	return nil
 }
+
+func (r *RDSDBCluster) Stop(ID string) error {
+	stopDBClusterInput := &rds.StopDBClusterInput{
+		DBClusterIdentifier: aws.String(ID),
+	}
+	r.logger.Debug("stop-db-cluster", lager.Data{"input": stopDBClusterInput})
+
+	stopDBClusterOutput, err := r.rdssvc.StopDBCluster(stopDBClusterInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBClusterDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("stop-db-cluster", lager.Data{"output": stopDBClusterOutput})
+
+	return nil
+}
+
+func (r *RDSDBCluster) Start(ID string) error {
+	startDBClusterInput := &rds.StartDBClusterInput{
+		DBClusterIdentifier: aws.String(ID),
+	}
+	r.logger.Debug("start-db-cluster", lager.Data{"input": startDBClusterInput})
+
+	startDBClusterOutput, err := r.rdssvc.StartDBCluster(startDBClusterInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBClusterDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("start-db-cluster", lager.Data{"output": startDBClusterOutput})
+
+	return nil
+}
+
+// ModifyMasterPassword only changes the master password, leaving every other setting as it is
+func (r *RDSDBCluster) ModifyMasterPassword(ID string, masterPassword string) error {
+	modifyDBClusterInput := &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(ID),
+		MasterUserPassword:  aws.String(masterPassword),
+		ApplyImmediately:    aws.Bool(true),
+	}
+	r.logger.Debug("modify-db-cluster-master-password", lager.Data{"db-cluster-id": ID})
+
+	if _, err := r.rdssvc.ModifyDBCluster(modifyDBClusterInput); err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBClusterDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *RDSDBCluster) CreateSnapshot(ID string, snapshotID string, tags map[string]string) error {
+	createDBClusterSnapshotInput := &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(ID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	if len(tags) > 0 {
+		createDBClusterSnapshotInput.Tags = BuilRDSTags(tags)
+	}
+
+	r.logger.Debug("create-db-cluster-snapshot", lager.Data{"input": createDBClusterSnapshotInput})
+
+	createDBClusterSnapshotOutput, err := r.rdssvc.CreateDBClusterSnapshot(createDBClusterSnapshotInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBClusterDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("create-db-cluster-snapshot", lager.Data{"output": createDBClusterSnapshotOutput})
+
+	return nil
+}
+
+func (r *RDSDBCluster) DescribeSnapshot(snapshotID string) (DBSnapshotDetails, error) {
+	dbSnapshotDetails := DBSnapshotDetails{}
+
+	describeDBClusterSnapshotsInput := &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	dbSnapshots, err := r.describeSnapshots(describeDBClusterSnapshotsInput)
+	if err != nil {
+		return dbSnapshotDetails, err
+	}
+
+	for _, dbSnapshot := range dbSnapshots {
+		if dbSnapshot.Identifier == snapshotID {
+			dbSnapshotARN, err := r.dbClusterSnapshotARN(snapshotID)
+			if err != nil {
+				return dbSnapshotDetails, err
+			}
+
+			if dbSnapshot.Tags, err = ListTagsForResource(dbSnapshotARN, r.rdssvc, r.logger); err != nil {
+				return dbSnapshotDetails, err
+			}
+
+			return dbSnapshot, nil
+		}
+	}
+
+	return dbSnapshotDetails, ErrDBSnapshotDoesNotExist
+}
+
+func (r *RDSDBCluster) ListSnapshots(ID string) ([]DBSnapshotDetails, error) {
+	describeDBClusterSnapshotsInput := &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterIdentifier: aws.String(ID),
+	}
+
+	return r.describeSnapshots(describeDBClusterSnapshotsInput)
+}
+
+func (r *RDSDBCluster) RestoreFromSnapshot(ID string, snapshotID string, dbClusterDetails DBClusterDetails) error {
+	restoreDBClusterInput := r.buildRestoreDBClusterFromSnapshotInput(ID, snapshotID, dbClusterDetails)
+	r.logger.Debug("restore-db-cluster-from-snapshot", lager.Data{"input": restoreDBClusterInput})
+
+	restoreDBClusterOutput, err := r.rdssvc.RestoreDBClusterFromSnapshot(restoreDBClusterInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBSnapshotDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("restore-db-cluster-from-snapshot", lager.Data{"output": restoreDBClusterOutput})
+
+	return nil
+}
+
+func (r *RDSDBCluster) RestoreToPointInTime(ID string, sourceID string, restoreTime time.Time, dbClusterDetails DBClusterDetails) error {
+	restoreDBClusterInput := r.buildRestoreDBClusterToPointInTimeInput(ID, sourceID, restoreTime, dbClusterDetails)
+	r.logger.Debug("restore-db-cluster-to-point-in-time", lager.Data{"input": restoreDBClusterInput})
+
+	restoreDBClusterOutput, err := r.rdssvc.RestoreDBClusterToPointInTime(restoreDBClusterInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBClusterDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("restore-db-cluster-to-point-in-time", lager.Data{"output": restoreDBClusterOutput})
+
+	return nil
+}
+
+func (r *RDSDBCluster) DescribeTags(ID string) (map[string]string, error) {
+	dbClusterARN, err := r.dbClusterARN(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListTagsForResource(dbClusterARN, r.rdssvc, r.logger)
+}
+
+func (r *RDSDBCluster) describeSnapshots(describeDBClusterSnapshotsInput *rds.DescribeDBClusterSnapshotsInput) ([]DBSnapshotDetails, error) {
+	dbSnapshotsDetails := []DBSnapshotDetails{}
+
+	r.logger.Debug("describe-db-cluster-snapshots", lager.Data{"input": describeDBClusterSnapshotsInput})
+
+	dbClusterSnapshots, err := r.rdssvc.DescribeDBClusterSnapshots(describeDBClusterSnapshotsInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return dbSnapshotsDetails, ErrDBSnapshotDoesNotExist
+				}
+			}
+			return dbSnapshotsDetails, errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return dbSnapshotsDetails, err
+	}
+
+	r.logger.Debug("describe-db-cluster-snapshots", lager.Data{"output": dbClusterSnapshots})
+
+	for _, dbClusterSnapshot := range dbClusterSnapshots.DBClusterSnapshots {
+		dbSnapshotsDetails = append(dbSnapshotsDetails, r.buildDBSnapshot(dbClusterSnapshot))
+	}
+
+	return dbSnapshotsDetails, nil
+}
+
 func (r *RDSDBCluster) buildDBCluster(dbCluster *rds.DBCluster) DBClusterDetails {
 	dbClusterDetails := DBClusterDetails{
 		Identifier:       aws.StringValue(dbCluster.DBClusterIdentifier),
 		Status:           aws.StringValue(dbCluster.Status),
 		Engine:           aws.StringValue(dbCluster.Engine),
+		EngineMode:       aws.StringValue(dbCluster.EngineMode),
 		EngineVersion:    aws.StringValue(dbCluster.EngineVersion),
 		DatabaseName:     aws.StringValue(dbCluster.DatabaseName),
 		MasterUsername:   aws.StringValue(dbCluster.MasterUsername),
@@ -151,9 +381,39 @@ func (r *RDSDBCluster) buildDBCluster(dbCluster *rds.DBCluster) DBClusterDetails
 		Port:             aws.Int64Value(dbCluster.Port),
 	}
 
+	// The reader endpoint of an Aurora DB Cluster is its endpoint with the "cluster-ro-" prefix
+	if strings.Contains(dbClusterDetails.Endpoint, ".cluster-") {
+		dbClusterDetails.ReaderEndpoint = strings.Replace(dbClusterDetails.Endpoint, ".cluster-", ".cluster-ro-", 1)
+	}
+
+	if dbCluster.ScalingConfigurationInfo != nil {
+		dbClusterDetails.ScalingConfiguration = &ScalingConfiguration{
+			MinCapacity:           aws.Int64Value(dbCluster.ScalingConfigurationInfo.MinCapacity),
+			MaxCapacity:           aws.Int64Value(dbCluster.ScalingConfigurationInfo.MaxCapacity),
+			AutoPause:             aws.BoolValue(dbCluster.ScalingConfigurationInfo.AutoPause),
+			SecondsUntilAutoPause: aws.Int64Value(dbCluster.ScalingConfigurationInfo.SecondsUntilAutoPause),
+		}
+	}
+
+	for _, dbClusterMember := range dbCluster.DBClusterMembers {
+		dbClusterDetails.DBClusterMembers = append(dbClusterDetails.DBClusterMembers, aws.StringValue(dbClusterMember.DBInstanceIdentifier))
+	}
+
 	return dbClusterDetails
 }
 
+func (r *RDSDBCluster) buildDBSnapshot(dbClusterSnapshot *rds.DBClusterSnapshot) DBSnapshotDetails {
+	return DBSnapshotDetails{
+		Identifier:       aws.StringValue(dbClusterSnapshot.DBClusterSnapshotIdentifier),
+		SourceIdentifier: aws.StringValue(dbClusterSnapshot.DBClusterIdentifier),
+		Status:           aws.StringValue(dbClusterSnapshot.Status),
+		Engine:           aws.StringValue(dbClusterSnapshot.Engine),
+		EngineVersion:    aws.StringValue(dbClusterSnapshot.EngineVersion),
+		SnapshotType:     aws.StringValue(dbClusterSnapshot.SnapshotType),
+		CreateTime:       aws.TimeValue(dbClusterSnapshot.SnapshotCreateTime),
+	}
+}
+
 func (r *RDSDBCluster) buildCreateDBClusterInput(ID string, dbClusterDetails DBClusterDetails) *rds.CreateDBClusterInput {
 	createDBClusterInput := &rds.CreateDBClusterInput{
 		DBClusterIdentifier: aws.String(ID),
@@ -184,6 +444,10 @@ func (r *RDSDBCluster) buildCreateDBClusterInput(ID string, dbClusterDetails DBC
 		createDBClusterInput.DBSubnetGroupName = aws.String(dbClusterDetails.DBSubnetGroupName)
 	}
 
+	if dbClusterDetails.EngineMode != "" {
+		createDBClusterInput.EngineMode = aws.String(dbClusterDetails.EngineMode)
+	}
+
 	if dbClusterDetails.EngineVersion != "" {
 		createDBClusterInput.EngineVersion = aws.String(dbClusterDetails.EngineVersion)
 	}
@@ -212,6 +476,10 @@ func (r *RDSDBCluster) buildCreateDBClusterInput(ID string, dbClusterDetails DBC
 		createDBClusterInput.PreferredMaintenanceWindow = aws.String(dbClusterDetails.PreferredMaintenanceWindow)
 	}
 
+	if dbClusterDetails.ScalingConfiguration != nil {
+		createDBClusterInput.ScalingConfiguration = r.buildScalingConfiguration(dbClusterDetails.ScalingConfiguration)
+	}
+
 	if len(dbClusterDetails.VpcSecurityGroupIds) > 0 {
 		createDBClusterInput.VpcSecurityGroupIds = aws.StringSlice(dbClusterDetails.VpcSecurityGroupIds)
 	}
@@ -257,6 +525,10 @@ func (r *RDSDBCluster) buildModifyDBClusterInput(ID string, dbClusterDetails DBC
 		modifyDBClusterInput.PreferredMaintenanceWindow = aws.String(dbClusterDetails.PreferredMaintenanceWindow)
 	}
 
+	if dbClusterDetails.ScalingConfiguration != nil {
+		modifyDBClusterInput.ScalingConfiguration = r.buildScalingConfiguration(dbClusterDetails.ScalingConfiguration)
+	}
+
 	if len(dbClusterDetails.VpcSecurityGroupIds) > 0 {
 		modifyDBClusterInput.VpcSecurityGroupIds = aws.StringSlice(dbClusterDetails.VpcSecurityGroupIds)
 	}
@@ -264,6 +536,91 @@ func (r *RDSDBCluster) buildModifyDBClusterInput(ID string, dbClusterDetails DBC
 	return modifyDBClusterInput
 }
 
+func (r *RDSDBCluster) buildRestoreDBClusterFromSnapshotInput(ID string, snapshotID string, dbClusterDetails DBClusterDetails) *rds.RestoreDBClusterFromSnapshotInput {
+	restoreDBClusterInput := &rds.RestoreDBClusterFromSnapshotInput{
+		DBClusterIdentifier: aws.String(ID),
+		SnapshotIdentifier:  aws.String(snapshotID),
+		Engine:              aws.String(dbClusterDetails.Engine),
+	}
+
+	if len(dbClusterDetails.AvailabilityZones) > 0 {
+		restoreDBClusterInput.AvailabilityZones = aws.StringSlice(dbClusterDetails.AvailabilityZones)
+	}
+
+	if dbClusterDetails.DatabaseName != "" {
+		restoreDBClusterInput.DatabaseName = aws.String(dbClusterDetails.DatabaseName)
+	}
+
+	if dbClusterDetails.DBSubnetGroupName != "" {
+		restoreDBClusterInput.DBSubnetGroupName = aws.String(dbClusterDetails.DBSubnetGroupName)
+	}
+
+	if dbClusterDetails.EngineMode != "" {
+		restoreDBClusterInput.EngineMode = aws.String(dbClusterDetails.EngineMode)
+	}
+
+	if dbClusterDetails.EngineVersion != "" {
+		restoreDBClusterInput.EngineVersion = aws.String(dbClusterDetails.EngineVersion)
+	}
+
+	if dbClusterDetails.OptionGroupName != "" {
+		restoreDBClusterInput.OptionGroupName = aws.String(dbClusterDetails.OptionGroupName)
+	}
+
+	if dbClusterDetails.Port > 0 {
+		restoreDBClusterInput.Port = aws.Int64(dbClusterDetails.Port)
+	}
+
+	if dbClusterDetails.ScalingConfiguration != nil {
+		restoreDBClusterInput.ScalingConfiguration = r.buildScalingConfiguration(dbClusterDetails.ScalingConfiguration)
+	}
+
+	if len(dbClusterDetails.VpcSecurityGroupIds) > 0 {
+		restoreDBClusterInput.VpcSecurityGroupIds = aws.StringSlice(dbClusterDetails.VpcSecurityGroupIds)
+	}
+
+	if len(dbClusterDetails.Tags) > 0 {
+		restoreDBClusterInput.Tags = BuilRDSTags(dbClusterDetails.Tags)
+	}
+
+	return restoreDBClusterInput
+}
+
+func (r *RDSDBCluster) buildRestoreDBClusterToPointInTimeInput(ID string, sourceID string, restoreTime time.Time, dbClusterDetails DBClusterDetails) *rds.RestoreDBClusterToPointInTimeInput {
+	restoreDBClusterInput := &rds.RestoreDBClusterToPointInTimeInput{
+		DBClusterIdentifier:       aws.String(ID),
+		SourceDBClusterIdentifier: aws.String(sourceID),
+	}
+
+	if restoreTime.IsZero() {
+		restoreDBClusterInput.UseLatestRestorableTime = aws.Bool(true)
+	} else {
+		restoreDBClusterInput.RestoreToTime = aws.Time(restoreTime)
+	}
+
+	if dbClusterDetails.DBSubnetGroupName != "" {
+		restoreDBClusterInput.DBSubnetGroupName = aws.String(dbClusterDetails.DBSubnetGroupName)
+	}
+
+	if dbClusterDetails.OptionGroupName != "" {
+		restoreDBClusterInput.OptionGroupName = aws.String(dbClusterDetails.OptionGroupName)
+	}
+
+	if dbClusterDetails.Port > 0 {
+		restoreDBClusterInput.Port = aws.Int64(dbClusterDetails.Port)
+	}
+
+	if len(dbClusterDetails.VpcSecurityGroupIds) > 0 {
+		restoreDBClusterInput.VpcSecurityGroupIds = aws.StringSlice(dbClusterDetails.VpcSecurityGroupIds)
+	}
+
+	if len(dbClusterDetails.Tags) > 0 {
+		restoreDBClusterInput.Tags = BuilRDSTags(dbClusterDetails.Tags)
+	}
+
+	return restoreDBClusterInput
+}
+
 func (r *RDSDBCluster) buildDeleteDBClusterInput(ID string, skipFinalSnapshot bool) *rds.DeleteDBClusterInput {
 	deleteDBClusterInput := &rds.DeleteDBClusterInput{
 		DBClusterIdentifier: aws.String(ID),
@@ -277,10 +634,39 @@ func (r *RDSDBCluster) buildDeleteDBClusterInput(ID string, skipFinalSnapshot bo
 	return deleteDBClusterInput
 }
 
+func (r *RDSDBCluster) buildScalingConfiguration(scalingConfiguration *ScalingConfiguration) *rds.ScalingConfiguration {
+	rdsScalingConfiguration := &rds.ScalingConfiguration{
+		AutoPause: aws.Bool(scalingConfiguration.AutoPause),
+	}
+
+	if scalingConfiguration.MinCapacity > 0 {
+		rdsScalingConfiguration.MinCapacity = aws.Int64(scalingConfiguration.MinCapacity)
+	}
+
+	if scalingConfiguration.MaxCapacity > 0 {
+		rdsScalingConfiguration.MaxCapacity = aws.Int64(scalingConfiguration.MaxCapacity)
+	}
+
+	if scalingConfiguration.SecondsUntilAutoPause > 0 {
+		rdsScalingConfiguration.SecondsUntilAutoPause = aws.Int64(scalingConfiguration.SecondsUntilAutoPause)
+	}
+
+	return rdsScalingConfiguration
+}
+
 func (r *RDSDBCluster) dbSnapshotName(ID string) string {
 	return fmt.Sprintf("rds-broker-%s-%s", ID, time.Now().Format("2006-01-02-15-04-05"))
 }
 
+func (r *RDSDBCluster) dbClusterSnapshotARN(snapshotID string) (string, error) {
+	userAccount, err := UserAccount(r.iamsvc)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("arn:aws:rds:%s:%s:cluster-snapshot:%s", r.region, userAccount, snapshotID), nil
+}
+
 func (r *RDSDBCluster) dbClusterARN(ID string) (string, error) {
 	userAccount, err := UserAccount(r.iamsvc)
 	if err != nil {
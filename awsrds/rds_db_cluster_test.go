@@ -2,6 +2,7 @@ package awsrds_test
 
 import (
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -118,6 +119,67 @@ var _ = Describe("RDS DB Cluster", func() {
 			Expect(dbClusterDetails).To(Equal(properDBClusterDetails))
 		})
 
+		Context("when RDS DB Cluster has an Aurora endpoint", func() {
+			BeforeEach(func() {
+				describeDBCluster.Endpoint = aws.String("test-cluster.cluster-abcdefghijkl.rds-region.rds.amazonaws.com")
+				properDBClusterDetails.Endpoint = "test-cluster.cluster-abcdefghijkl.rds-region.rds.amazonaws.com"
+				properDBClusterDetails.ReaderEndpoint = "test-cluster.cluster-ro-abcdefghijkl.rds-region.rds.amazonaws.com"
+			})
+
+			It("returns the proper DB Cluster", func() {
+				dbClusterDetails, err := rdsDBCluster.Describe(dbClusterIdentifier)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbClusterDetails).To(Equal(properDBClusterDetails))
+			})
+		})
+
+		Context("when RDS DB Cluster has members", func() {
+			BeforeEach(func() {
+				describeDBCluster.DBClusterMembers = []*rds.DBClusterMember{
+					&rds.DBClusterMember{
+						DBInstanceIdentifier: aws.String("cf-instance-id"),
+						IsClusterWriter:      aws.Bool(true),
+					},
+					&rds.DBClusterMember{
+						DBInstanceIdentifier: aws.String("cf-instance-id-1"),
+						IsClusterWriter:      aws.Bool(false),
+					},
+				}
+				properDBClusterDetails.DBClusterMembers = []string{"cf-instance-id", "cf-instance-id-1"}
+			})
+
+			It("returns the proper DB Cluster", func() {
+				dbClusterDetails, err := rdsDBCluster.Describe(dbClusterIdentifier)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbClusterDetails).To(Equal(properDBClusterDetails))
+			})
+		})
+
+		Context("when RDS DB Cluster is serverless", func() {
+			BeforeEach(func() {
+				describeDBCluster.EngineMode = aws.String("serverless")
+				describeDBCluster.ScalingConfigurationInfo = &rds.ScalingConfigurationInfo{
+					MinCapacity:           aws.Int64(2),
+					MaxCapacity:           aws.Int64(8),
+					AutoPause:             aws.Bool(true),
+					SecondsUntilAutoPause: aws.Int64(600),
+				}
+				properDBClusterDetails.EngineMode = "serverless"
+				properDBClusterDetails.ScalingConfiguration = &ScalingConfiguration{
+					MinCapacity:           2,
+					MaxCapacity:           8,
+					AutoPause:             true,
+					SecondsUntilAutoPause: 600,
+				}
+			})
+
+			It("returns the proper DB Cluster", func() {
+				dbClusterDetails, err := rdsDBCluster.Describe(dbClusterIdentifier)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbClusterDetails).To(Equal(properDBClusterDetails))
+			})
+		})
+
 		Context("when the DB Cluster does not exists", func() {
 			JustBeforeEach(func() {
 				describeDBClustersInput = &rds.DescribeDBClustersInput{
@@ -279,6 +341,18 @@ var _ = Describe("RDS DB Cluster", func() {
 			})
 		})
 
+		Context("when has EngineMode", func() {
+			BeforeEach(func() {
+				dbClusterDetails.EngineMode = "serverless"
+				createDBClustersInput.EngineMode = aws.String("serverless")
+			})
+
+			It("does not return error", func() {
+				err := rdsDBCluster.Create(dbClusterIdentifier, dbClusterDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("when has EngineVersion", func() {
 			BeforeEach(func() {
 				dbClusterDetails.EngineVersion = "1.2.3"
@@ -363,6 +437,22 @@ var _ = Describe("RDS DB Cluster", func() {
 			})
 		})
 
+		Context("when has ScalingConfiguration", func() {
+			BeforeEach(func() {
+				dbClusterDetails.ScalingConfiguration = &ScalingConfiguration{MinCapacity: 2, MaxCapacity: 8}
+				createDBClustersInput.ScalingConfiguration = &rds.ScalingConfiguration{
+					MinCapacity: aws.Int64(2),
+					MaxCapacity: aws.Int64(8),
+					AutoPause:   aws.Bool(false),
+				}
+			})
+
+			It("does not return error", func() {
+				err := rdsDBCluster.Create(dbClusterIdentifier, dbClusterDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("when has VpcSecurityGroupIds", func() {
 			BeforeEach(func() {
 				dbClusterDetails.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
@@ -419,9 +509,6 @@ var _ = Describe("RDS DB Cluster", func() {
 			dbClusterDetails DBClusterDetails
 			applyImmediately bool
 
-			describeDBClustersInput *rds.DescribeDBClustersInput
-			describeDBClusterError  error
-
 			modifyDBClusterInput *rds.ModifyDBClusterInput
 			modifyDBClusterError error
 
@@ -437,11 +524,6 @@ var _ = Describe("RDS DB Cluster", func() {
 			dbClusterDetails = DBClusterDetails{}
 			applyImmediately = false
 
-			describeDBClustersInput = &rds.DescribeDBClustersInput{
-				DBClusterIdentifier: aws.String(dbClusterIdentifier),
-			}
-			describeDBClusterError = nil
-
 			modifyDBClusterInput = &rds.ModifyDBClusterInput{
 				DBClusterIdentifier: aws.String(dbClusterIdentifier),
 				ApplyImmediately:    aws.Bool(applyImmediately),
@@ -596,6 +678,28 @@ var _ = Describe("RDS DB Cluster", func() {
 			})
 		})
 
+		Context("when has ScalingConfiguration", func() {
+			BeforeEach(func() {
+				dbClusterDetails.ScalingConfiguration = &ScalingConfiguration{
+					MinCapacity:           1,
+					MaxCapacity:           4,
+					AutoPause:             true,
+					SecondsUntilAutoPause: 300,
+				}
+				modifyDBClusterInput.ScalingConfiguration = &rds.ScalingConfiguration{
+					MinCapacity:           aws.Int64(1),
+					MaxCapacity:           aws.Int64(4),
+					AutoPause:             aws.Bool(true),
+					SecondsUntilAutoPause: aws.Int64(300),
+				}
+			})
+
+			It("does not return error", func() {
+				err := rdsDBCluster.Modify(dbClusterIdentifier, dbClusterDetails, applyImmediately)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("when has VpcSecurityGroupIds", func() {
 			BeforeEach(func() {
 				dbClusterDetails.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
@@ -766,4 +870,386 @@ var _ = Describe("RDS DB Cluster", func() {
 			})
 		})
 	})
+
+	var _ = Describe("Stop", func() {
+		var (
+			stopDBClusterError error
+		)
+
+		BeforeEach(func() {
+			stopDBClusterError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("StopDBCluster"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.StopDBClusterInput{}))
+				params := r.Params.(*rds.StopDBClusterInput)
+				Expect(params.DBClusterIdentifier).To(Equal(aws.String(dbClusterIdentifier)))
+				r.Error = stopDBClusterError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBCluster.Stop(dbClusterIdentifier)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when stopping the DB Cluster fails", func() {
+			BeforeEach(func() {
+				stopDBClusterError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBCluster.Stop(dbClusterIdentifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					stopDBClusterError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.Stop(dbClusterIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					stopDBClusterError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.Stop(dbClusterIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBClusterDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("Start", func() {
+		var (
+			startDBClusterError error
+		)
+
+		BeforeEach(func() {
+			startDBClusterError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("StartDBCluster"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.StartDBClusterInput{}))
+				params := r.Params.(*rds.StartDBClusterInput)
+				Expect(params.DBClusterIdentifier).To(Equal(aws.String(dbClusterIdentifier)))
+				r.Error = startDBClusterError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBCluster.Start(dbClusterIdentifier)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when starting the DB Cluster fails", func() {
+			BeforeEach(func() {
+				startDBClusterError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBCluster.Start(dbClusterIdentifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					startDBClusterError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.Start(dbClusterIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					startDBClusterError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.Start(dbClusterIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBClusterDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("ModifyMasterPassword", func() {
+		var (
+			modifyDBClusterError error
+		)
+
+		BeforeEach(func() {
+			modifyDBClusterError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("ModifyDBCluster"))
+				Expect(r.Params).To(Equal(&rds.ModifyDBClusterInput{
+					DBClusterIdentifier: aws.String(dbClusterIdentifier),
+					MasterUserPassword:  aws.String("new-password"),
+					ApplyImmediately:    aws.Bool(true),
+				}))
+				r.Error = modifyDBClusterError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("only modifies the master password", func() {
+			err := rdsDBCluster.ModifyMasterPassword(dbClusterIdentifier, "new-password")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when modifying the DB Cluster fails", func() {
+			BeforeEach(func() {
+				modifyDBClusterError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBCluster.ModifyMasterPassword(dbClusterIdentifier, "new-password")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					modifyDBClusterError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.ModifyMasterPassword(dbClusterIdentifier, "new-password")
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					modifyDBClusterError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.ModifyMasterPassword(dbClusterIdentifier, "new-password")
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBClusterDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("CreateSnapshot", func() {
+		var (
+			createDBClusterSnapshotInput *rds.CreateDBClusterSnapshotInput
+			createDBClusterSnapshotError error
+		)
+
+		BeforeEach(func() {
+			createDBClusterSnapshotInput = &rds.CreateDBClusterSnapshotInput{
+				DBClusterIdentifier:         aws.String(dbClusterIdentifier),
+				DBClusterSnapshotIdentifier: aws.String("snapshot-id"),
+				Tags: []*rds.Tag{
+					&rds.Tag{Key: aws.String("Owner"), Value: aws.String("Cloud Foundry")},
+				},
+			}
+			createDBClusterSnapshotError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("CreateDBClusterSnapshot"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.CreateDBClusterSnapshotInput{}))
+				Expect(r.Params).To(Equal(createDBClusterSnapshotInput))
+				r.Error = createDBClusterSnapshotError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBCluster.CreateSnapshot(dbClusterIdentifier, "snapshot-id", map[string]string{"Owner": "Cloud Foundry"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when creating the DB Cluster snapshot fails", func() {
+			BeforeEach(func() {
+				createDBClusterSnapshotError = awserr.New("code", "message", errors.New("operation failed"))
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBCluster.CreateSnapshot(dbClusterIdentifier, "snapshot-id", map[string]string{"Owner": "Cloud Foundry"})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("code: message"))
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					createDBClusterSnapshotError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBCluster.CreateSnapshot(dbClusterIdentifier, "snapshot-id", map[string]string{"Owner": "Cloud Foundry"})
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBClusterDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("RestoreFromSnapshot", func() {
+		var (
+			dbClusterDetails DBClusterDetails
+
+			restoreDBClusterInput *rds.RestoreDBClusterFromSnapshotInput
+			restoreDBClusterError error
+		)
+
+		BeforeEach(func() {
+			dbClusterDetails = DBClusterDetails{
+				Engine:       "aurora",
+				DatabaseName: "test-db",
+				Port:         3306,
+			}
+
+			restoreDBClusterInput = &rds.RestoreDBClusterFromSnapshotInput{
+				DBClusterIdentifier: aws.String(dbClusterIdentifier),
+				SnapshotIdentifier:  aws.String("snapshot-id"),
+				Engine:              aws.String("aurora"),
+				DatabaseName:        aws.String("test-db"),
+				Port:                aws.Int64(3306),
+			}
+			restoreDBClusterError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("RestoreDBClusterFromSnapshot"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.RestoreDBClusterFromSnapshotInput{}))
+				Expect(r.Params).To(Equal(restoreDBClusterInput))
+				r.Error = restoreDBClusterError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBCluster.RestoreFromSnapshot(dbClusterIdentifier, "snapshot-id", dbClusterDetails)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the DB Cluster snapshot does not exist", func() {
+			BeforeEach(func() {
+				awsError := awserr.New("code", "message", errors.New("operation failed"))
+				restoreDBClusterError = awserr.NewRequestFailure(awsError, 404, "request-id")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBCluster.RestoreFromSnapshot(dbClusterIdentifier, "snapshot-id", dbClusterDetails)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(ErrDBSnapshotDoesNotExist))
+			})
+		})
+	})
+
+	var _ = Describe("RestoreToPointInTime", func() {
+		var (
+			restoreTime      time.Time
+			dbClusterDetails DBClusterDetails
+
+			restoreDBClusterInput *rds.RestoreDBClusterToPointInTimeInput
+			restoreDBClusterError error
+		)
+
+		BeforeEach(func() {
+			restoreTime = time.Time{}
+			dbClusterDetails = DBClusterDetails{
+				Engine: "aurora",
+				Port:   3306,
+			}
+
+			restoreDBClusterInput = &rds.RestoreDBClusterToPointInTimeInput{
+				DBClusterIdentifier:       aws.String(dbClusterIdentifier),
+				SourceDBClusterIdentifier: aws.String("source-cluster-id"),
+				UseLatestRestorableTime:   aws.Bool(true),
+				Port:                      aws.Int64(3306),
+			}
+			restoreDBClusterError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("RestoreDBClusterToPointInTime"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.RestoreDBClusterToPointInTimeInput{}))
+				Expect(r.Params).To(Equal(restoreDBClusterInput))
+				r.Error = restoreDBClusterError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBCluster.RestoreToPointInTime(dbClusterIdentifier, "source-cluster-id", restoreTime, dbClusterDetails)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when has a restore time", func() {
+			BeforeEach(func() {
+				restoreTime = time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)
+				restoreDBClusterInput.UseLatestRestorableTime = nil
+				restoreDBClusterInput.RestoreToTime = aws.Time(restoreTime)
+			})
+
+			It("does not return error", func() {
+				err := rdsDBCluster.RestoreToPointInTime(dbClusterIdentifier, "source-cluster-id", restoreTime, dbClusterDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the source DB Cluster does not exist", func() {
+			BeforeEach(func() {
+				awsError := awserr.New("code", "message", errors.New("operation failed"))
+				restoreDBClusterError = awserr.NewRequestFailure(awsError, 404, "request-id")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBCluster.RestoreToPointInTime(dbClusterIdentifier, "source-cluster-id", restoreTime, dbClusterDetails)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(ErrDBClusterDoesNotExist))
+			})
+		})
+	})
 })
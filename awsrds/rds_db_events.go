@@ -0,0 +1,70 @@
+package awsrds
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/pivotal-golang/lager"
+)
+
+type RDSDBEvents struct {
+	rdssvc *rds.RDS
+	logger lager.Logger
+}
+
+func NewRDSDBEvents(
+	rdssvc *rds.RDS,
+	logger lager.Logger,
+) *RDSDBEvents {
+	return &RDSDBEvents{
+		rdssvc: rdssvc,
+		logger: logger.Session("db-events"),
+	}
+}
+
+// Describe returns the events of a DB Instance or DB Cluster since startTime, oldest first.
+// RDS only keeps the events of the last 14 days, and returns the events of the last hour if startTime is zero
+func (r *RDSDBEvents) Describe(sourceType string, sourceID string, startTime time.Time) ([]DBEventDetails, error) {
+	dbEventsDetails := []DBEventDetails{}
+
+	describeEventsInput := &rds.DescribeEventsInput{
+		SourceType:       aws.String(sourceType),
+		SourceIdentifier: aws.String(sourceID),
+	}
+	if !startTime.IsZero() {
+		describeEventsInput.StartTime = aws.Time(startTime)
+	}
+
+	r.logger.Debug("describe-events", lager.Data{"input": describeEventsInput})
+
+	err := r.rdssvc.DescribeEventsPages(describeEventsInput, func(describeEventsOutput *rds.DescribeEventsOutput, lastPage bool) bool {
+		for _, event := range describeEventsOutput.Events {
+			dbEventsDetails = append(dbEventsDetails, r.buildDBEvent(event))
+		}
+		return true
+	})
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			return dbEventsDetails, errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return dbEventsDetails, err
+	}
+
+	r.logger.Debug("describe-events", lager.Data{"events": dbEventsDetails})
+
+	return dbEventsDetails, nil
+}
+
+func (r *RDSDBEvents) buildDBEvent(event *rds.Event) DBEventDetails {
+	return DBEventDetails{
+		SourceIdentifier: aws.StringValue(event.SourceIdentifier),
+		SourceType:       aws.StringValue(event.SourceType),
+		Message:          aws.StringValue(event.Message),
+		EventCategories:  aws.StringValueSlice(event.EventCategories),
+		Date:             aws.TimeValue(event.Date),
+	}
+}
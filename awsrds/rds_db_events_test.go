@@ -0,0 +1,144 @@
+package awsrds_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/pivotal-golang/lager"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+var _ = Describe("RDS DB Events", func() {
+	var (
+		dbInstanceIdentifier string
+
+		awsSession *session.Session
+
+		rdssvc  *rds.RDS
+		rdsCall func(r *request.Request)
+
+		testSink *lagertest.TestSink
+		logger   lager.Logger
+
+		rdsDBEvents DBEvents
+	)
+
+	BeforeEach(func() {
+		dbInstanceIdentifier = "cf-instance-id"
+	})
+
+	JustBeforeEach(func() {
+		awsSession = session.New(nil)
+
+		rdssvc = rds.New(awsSession)
+
+		logger = lager.NewLogger("rdsdbevents_test")
+		testSink = lagertest.NewTestSink()
+		logger.RegisterSink(testSink)
+
+		rdsDBEvents = NewRDSDBEvents(rdssvc, logger)
+	})
+
+	var _ = Describe("Describe", func() {
+		var (
+			startTime time.Time
+			eventDate time.Time
+
+			describeEventsInput *rds.DescribeEventsInput
+			describeEventsError error
+		)
+
+		BeforeEach(func() {
+			startTime = time.Date(2017, time.July, 14, 2, 40, 0, 0, time.UTC)
+			eventDate = startTime.Add(10 * time.Minute)
+
+			describeEventsInput = &rds.DescribeEventsInput{
+				SourceType:       aws.String("db-instance"),
+				SourceIdentifier: aws.String(dbInstanceIdentifier),
+				StartTime:        aws.Time(startTime),
+			}
+			describeEventsError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("DescribeEvents"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.DescribeEventsInput{}))
+				Expect(r.Params).To(Equal(describeEventsInput))
+				data := r.Data.(*rds.DescribeEventsOutput)
+				data.Events = []*rds.Event{
+					&rds.Event{
+						SourceIdentifier: aws.String(dbInstanceIdentifier),
+						SourceType:       aws.String("db-instance"),
+						Message:          aws.String("DB instance created"),
+						EventCategories:  []*string{aws.String("creation")},
+						Date:             aws.Time(eventDate),
+					},
+				}
+				r.Error = describeEventsError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("returns the proper DB Events", func() {
+			dbEvents, err := rdsDBEvents.Describe(DBEventSourceTypeDBInstance, dbInstanceIdentifier, startTime)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbEvents).To(Equal([]DBEventDetails{
+				DBEventDetails{
+					SourceIdentifier: dbInstanceIdentifier,
+					SourceType:       "db-instance",
+					Message:          "DB instance created",
+					EventCategories:  []string{"creation"},
+					Date:             eventDate,
+				},
+			}))
+		})
+
+		Context("when the start time is not set", func() {
+			BeforeEach(func() {
+				describeEventsInput.StartTime = nil
+			})
+
+			It("does not filter the DB Events by start time", func() {
+				_, err := rdsDBEvents.Describe(DBEventSourceTypeDBInstance, dbInstanceIdentifier, time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when describing the DB Events fails", func() {
+			BeforeEach(func() {
+				describeEventsError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsDBEvents.Describe(DBEventSourceTypeDBInstance, dbInstanceIdentifier, startTime)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					describeEventsError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					_, err := rdsDBEvents.Describe(DBEventSourceTypeDBInstance, dbInstanceIdentifier, startTime)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+		})
+	})
+})
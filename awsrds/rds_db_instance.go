@@ -3,6 +3,7 @@ package awsrds
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -154,15 +155,266 @@ func (r *RDSDBInstance) Delete(ID string, skipFinalSnapshot bool) error {
 	return nil
 }
 
+func (r *RDSDBInstance) Stop(ID string) error {
+	stopDBInstanceInput := &rds.StopDBInstanceInput{
+		DBInstanceIdentifier: aws.String(ID),
+	}
+	r.logger.Debug("stop-db-instance", lager.Data{"input": stopDBInstanceInput})
+
+	stopDBInstanceOutput, err := r.rdssvc.StopDBInstance(stopDBInstanceInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBInstanceDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("stop-db-instance", lager.Data{"output": stopDBInstanceOutput})
+
+	return nil
+}
+
+func (r *RDSDBInstance) Start(ID string) error {
+	startDBInstanceInput := &rds.StartDBInstanceInput{
+		DBInstanceIdentifier: aws.String(ID),
+	}
+	r.logger.Debug("start-db-instance", lager.Data{"input": startDBInstanceInput})
+
+	startDBInstanceOutput, err := r.rdssvc.StartDBInstance(startDBInstanceInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBInstanceDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("start-db-instance", lager.Data{"output": startDBInstanceOutput})
+
+	return nil
+}
+
+// ModifyMasterPassword only changes the master password, leaving every other setting as it is
+func (r *RDSDBInstance) ModifyMasterPassword(ID string, masterPassword string) error {
+	modifyDBInstanceInput := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(ID),
+		MasterUserPassword:   aws.String(masterPassword),
+		ApplyImmediately:     aws.Bool(true),
+	}
+	r.logger.Debug("modify-db-instance-master-password", lager.Data{"db-instance-id": ID})
+
+	if _, err := r.rdssvc.ModifyDBInstance(modifyDBInstanceInput); err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBInstanceDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *RDSDBInstance) CreateSnapshot(ID string, snapshotID string, tags map[string]string) error {
+	createDBSnapshotInput := &rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(ID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	if len(tags) > 0 {
+		createDBSnapshotInput.Tags = BuilRDSTags(tags)
+	}
+
+	r.logger.Debug("create-db-snapshot", lager.Data{"input": createDBSnapshotInput})
+
+	createDBSnapshotOutput, err := r.rdssvc.CreateDBSnapshot(createDBSnapshotInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBInstanceDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("create-db-snapshot", lager.Data{"output": createDBSnapshotOutput})
+
+	return nil
+}
+
+func (r *RDSDBInstance) DescribeSnapshot(snapshotID string) (DBSnapshotDetails, error) {
+	dbSnapshotDetails := DBSnapshotDetails{}
+
+	describeDBSnapshotsInput := &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	dbSnapshots, err := r.describeSnapshots(describeDBSnapshotsInput)
+	if err != nil {
+		return dbSnapshotDetails, err
+	}
+
+	for _, dbSnapshot := range dbSnapshots {
+		if dbSnapshot.Identifier == snapshotID {
+			dbSnapshotARN, err := r.dbSnapshotARN(snapshotID)
+			if err != nil {
+				return dbSnapshotDetails, err
+			}
+
+			if dbSnapshot.Tags, err = ListTagsForResource(dbSnapshotARN, r.rdssvc, r.logger); err != nil {
+				return dbSnapshotDetails, err
+			}
+
+			return dbSnapshot, nil
+		}
+	}
+
+	return dbSnapshotDetails, ErrDBSnapshotDoesNotExist
+}
+
+func (r *RDSDBInstance) ListSnapshots(ID string) ([]DBSnapshotDetails, error) {
+	describeDBSnapshotsInput := &rds.DescribeDBSnapshotsInput{
+		DBInstanceIdentifier: aws.String(ID),
+	}
+
+	return r.describeSnapshots(describeDBSnapshotsInput)
+}
+
+func (r *RDSDBInstance) RestoreFromSnapshot(ID string, snapshotID string, dbInstanceDetails DBInstanceDetails) error {
+	restoreDBInstanceInput := r.buildRestoreDBInstanceFromDBSnapshotInput(ID, snapshotID, dbInstanceDetails)
+	r.logger.Debug("restore-db-instance-from-db-snapshot", lager.Data{"input": restoreDBInstanceInput})
+
+	restoreDBInstanceOutput, err := r.rdssvc.RestoreDBInstanceFromDBSnapshot(restoreDBInstanceInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBSnapshotDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("restore-db-instance-from-db-snapshot", lager.Data{"output": restoreDBInstanceOutput})
+
+	return nil
+}
+
+func (r *RDSDBInstance) RestoreToPointInTime(ID string, sourceID string, restoreTime time.Time, dbInstanceDetails DBInstanceDetails) error {
+	restoreDBInstanceInput := r.buildRestoreDBInstanceToPointInTimeInput(ID, sourceID, restoreTime, dbInstanceDetails)
+	r.logger.Debug("restore-db-instance-to-point-in-time", lager.Data{"input": restoreDBInstanceInput})
+
+	restoreDBInstanceOutput, err := r.rdssvc.RestoreDBInstanceToPointInTime(restoreDBInstanceInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBInstanceDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("restore-db-instance-to-point-in-time", lager.Data{"output": restoreDBInstanceOutput})
+
+	return nil
+}
+
+func (r *RDSDBInstance) CreateReadReplica(ID string, sourceID string, dbInstanceDetails DBInstanceDetails) error {
+	createDBInstanceReadReplicaInput := r.buildCreateDBInstanceReadReplicaInput(ID, sourceID, dbInstanceDetails)
+	r.logger.Debug("create-db-instance-read-replica", lager.Data{"input": createDBInstanceReadReplicaInput})
+
+	createDBInstanceReadReplicaOutput, err := r.rdssvc.CreateDBInstanceReadReplica(createDBInstanceReadReplicaInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return ErrDBInstanceDoesNotExist
+				}
+			}
+			return errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return err
+	}
+
+	r.logger.Debug("create-db-instance-read-replica", lager.Data{"output": createDBInstanceReadReplicaOutput})
+
+	return nil
+}
+
+func (r *RDSDBInstance) DescribeTags(ID string) (map[string]string, error) {
+	dbInstanceARN, err := r.dbInstanceARN(ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListTagsForResource(dbInstanceARN, r.rdssvc, r.logger)
+}
+
+func (r *RDSDBInstance) describeSnapshots(describeDBSnapshotsInput *rds.DescribeDBSnapshotsInput) ([]DBSnapshotDetails, error) {
+	dbSnapshotsDetails := []DBSnapshotDetails{}
+
+	r.logger.Debug("describe-db-snapshots", lager.Data{"input": describeDBSnapshotsInput})
+
+	dbSnapshots, err := r.rdssvc.DescribeDBSnapshots(describeDBSnapshotsInput)
+	if err != nil {
+		r.logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				if reqErr.StatusCode() == 404 {
+					return dbSnapshotsDetails, ErrDBSnapshotDoesNotExist
+				}
+			}
+			return dbSnapshotsDetails, errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return dbSnapshotsDetails, err
+	}
+
+	r.logger.Debug("describe-db-snapshots", lager.Data{"output": dbSnapshots})
+
+	for _, dbSnapshot := range dbSnapshots.DBSnapshots {
+		dbSnapshotsDetails = append(dbSnapshotsDetails, r.buildDBSnapshot(dbSnapshot))
+	}
+
+	return dbSnapshotsDetails, nil
+}
+
 func (r *RDSDBInstance) buildDBInstance(dbInstance *rds.DBInstance) DBInstanceDetails {
 	dbInstanceDetails := DBInstanceDetails{
-		Identifier:       aws.StringValue(dbInstance.DBInstanceIdentifier),
-		Status:           aws.StringValue(dbInstance.DBInstanceStatus),
-		Engine:           aws.StringValue(dbInstance.Engine),
-		EngineVersion:    aws.StringValue(dbInstance.EngineVersion),
-		DBName:           aws.StringValue(dbInstance.DBName),
-		MasterUsername:   aws.StringValue(dbInstance.MasterUsername),
-		AllocatedStorage: aws.Int64Value(dbInstance.AllocatedStorage),
+		Identifier:          aws.StringValue(dbInstance.DBInstanceIdentifier),
+		Status:              aws.StringValue(dbInstance.DBInstanceStatus),
+		Engine:              aws.StringValue(dbInstance.Engine),
+		EngineVersion:       aws.StringValue(dbInstance.EngineVersion),
+		DBName:              aws.StringValue(dbInstance.DBName),
+		DBClusterIdentifier: aws.StringValue(dbInstance.DBClusterIdentifier),
+		MasterUsername:      aws.StringValue(dbInstance.MasterUsername),
+		AllocatedStorage:    aws.Int64Value(dbInstance.AllocatedStorage),
 	}
 
 	if dbInstance.Endpoint != nil {
@@ -172,7 +424,7 @@ func (r *RDSDBInstance) buildDBInstance(dbInstance *rds.DBInstance) DBInstanceDe
 
 	if dbInstance.PendingModifiedValues != nil {
 		emptyPendingModifiedValues := &rds.PendingModifiedValues{}
-		if *dbInstance.PendingModifiedValues != *emptyPendingModifiedValues {
+		if !reflect.DeepEqual(*dbInstance.PendingModifiedValues, *emptyPendingModifiedValues) {
 			dbInstanceDetails.PendingModifications = true
 		}
 	}
@@ -180,6 +432,18 @@ func (r *RDSDBInstance) buildDBInstance(dbInstance *rds.DBInstance) DBInstanceDe
 	return dbInstanceDetails
 }
 
+func (r *RDSDBInstance) buildDBSnapshot(dbSnapshot *rds.DBSnapshot) DBSnapshotDetails {
+	return DBSnapshotDetails{
+		Identifier:       aws.StringValue(dbSnapshot.DBSnapshotIdentifier),
+		SourceIdentifier: aws.StringValue(dbSnapshot.DBInstanceIdentifier),
+		Status:           aws.StringValue(dbSnapshot.Status),
+		Engine:           aws.StringValue(dbSnapshot.Engine),
+		EngineVersion:    aws.StringValue(dbSnapshot.EngineVersion),
+		SnapshotType:     aws.StringValue(dbSnapshot.SnapshotType),
+		CreateTime:       aws.TimeValue(dbSnapshot.SnapshotCreateTime),
+	}
+}
+
 func (r *RDSDBInstance) buildCreateDBInstanceInput(ID string, dbInstanceDetails DBInstanceDetails) *rds.CreateDBInstanceInput {
 	createDBInstanceInput := &rds.CreateDBInstanceInput{
 		DBInstanceIdentifier: aws.String(ID),
@@ -291,6 +555,61 @@ func (r *RDSDBInstance) buildCreateDBInstanceInput(ID string, dbInstanceDetails
 	return createDBInstanceInput
 }
 
+func (r *RDSDBInstance) buildCreateDBInstanceReadReplicaInput(ID string, sourceID string, dbInstanceDetails DBInstanceDetails) *rds.CreateDBInstanceReadReplicaInput {
+	createDBInstanceReadReplicaInput := &rds.CreateDBInstanceReadReplicaInput{
+		DBInstanceIdentifier:       aws.String(ID),
+		SourceDBInstanceIdentifier: aws.String(sourceID),
+	}
+
+	createDBInstanceReadReplicaInput.AutoMinorVersionUpgrade = aws.Bool(dbInstanceDetails.AutoMinorVersionUpgrade)
+
+	if dbInstanceDetails.AvailabilityZone != "" {
+		createDBInstanceReadReplicaInput.AvailabilityZone = aws.String(dbInstanceDetails.AvailabilityZone)
+	}
+
+	createDBInstanceReadReplicaInput.CopyTagsToSnapshot = aws.Bool(dbInstanceDetails.CopyTagsToSnapshot)
+
+	if dbInstanceDetails.DBInstanceClass != "" {
+		createDBInstanceReadReplicaInput.DBInstanceClass = aws.String(dbInstanceDetails.DBInstanceClass)
+	}
+
+	if dbInstanceDetails.DBParameterGroupName != "" {
+		createDBInstanceReadReplicaInput.DBParameterGroupName = aws.String(dbInstanceDetails.DBParameterGroupName)
+	}
+
+	if dbInstanceDetails.DBSubnetGroupName != "" {
+		createDBInstanceReadReplicaInput.DBSubnetGroupName = aws.String(dbInstanceDetails.DBSubnetGroupName)
+	}
+
+	if dbInstanceDetails.Iops > 0 {
+		createDBInstanceReadReplicaInput.Iops = aws.Int64(dbInstanceDetails.Iops)
+	}
+
+	if dbInstanceDetails.OptionGroupName != "" {
+		createDBInstanceReadReplicaInput.OptionGroupName = aws.String(dbInstanceDetails.OptionGroupName)
+	}
+
+	if dbInstanceDetails.Port > 0 {
+		createDBInstanceReadReplicaInput.Port = aws.Int64(dbInstanceDetails.Port)
+	}
+
+	createDBInstanceReadReplicaInput.PubliclyAccessible = aws.Bool(dbInstanceDetails.PubliclyAccessible)
+
+	if dbInstanceDetails.StorageType != "" {
+		createDBInstanceReadReplicaInput.StorageType = aws.String(dbInstanceDetails.StorageType)
+	}
+
+	if len(dbInstanceDetails.Tags) > 0 {
+		createDBInstanceReadReplicaInput.Tags = BuilRDSTags(dbInstanceDetails.Tags)
+	}
+
+	if len(dbInstanceDetails.VpcSecurityGroupIds) > 0 {
+		createDBInstanceReadReplicaInput.VpcSecurityGroupIds = aws.StringSlice(dbInstanceDetails.VpcSecurityGroupIds)
+	}
+
+	return createDBInstanceReadReplicaInput
+}
+
 func (r *RDSDBInstance) buildModifyDBInstanceInput(ID string, dbInstanceDetails DBInstanceDetails, oldDBInstanceDetails DBInstanceDetails, applyImmediately bool) *rds.ModifyDBInstanceInput {
 	modifyDBInstanceInput := &rds.ModifyDBInstanceInput{
 		DBInstanceIdentifier: aws.String(ID),
@@ -363,6 +682,142 @@ func (r *RDSDBInstance) buildModifyDBInstanceInput(ID string, dbInstanceDetails
 	return modifyDBInstanceInput
 }
 
+func (r *RDSDBInstance) buildRestoreDBInstanceFromDBSnapshotInput(ID string, snapshotID string, dbInstanceDetails DBInstanceDetails) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	restoreDBInstanceInput := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(ID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	restoreDBInstanceInput.AutoMinorVersionUpgrade = aws.Bool(dbInstanceDetails.AutoMinorVersionUpgrade)
+
+	if dbInstanceDetails.AvailabilityZone != "" {
+		restoreDBInstanceInput.AvailabilityZone = aws.String(dbInstanceDetails.AvailabilityZone)
+	}
+
+	restoreDBInstanceInput.CopyTagsToSnapshot = aws.Bool(dbInstanceDetails.CopyTagsToSnapshot)
+
+	if dbInstanceDetails.DBInstanceClass != "" {
+		restoreDBInstanceInput.DBInstanceClass = aws.String(dbInstanceDetails.DBInstanceClass)
+	}
+
+	if dbInstanceDetails.DBParameterGroupName != "" {
+		restoreDBInstanceInput.DBParameterGroupName = aws.String(dbInstanceDetails.DBParameterGroupName)
+	}
+
+	if dbInstanceDetails.DBSubnetGroupName != "" {
+		restoreDBInstanceInput.DBSubnetGroupName = aws.String(dbInstanceDetails.DBSubnetGroupName)
+	}
+
+	if dbInstanceDetails.Engine != "" {
+		restoreDBInstanceInput.Engine = aws.String(dbInstanceDetails.Engine)
+	}
+
+	if dbInstanceDetails.Iops > 0 {
+		restoreDBInstanceInput.Iops = aws.Int64(dbInstanceDetails.Iops)
+	}
+
+	if dbInstanceDetails.LicenseModel != "" {
+		restoreDBInstanceInput.LicenseModel = aws.String(dbInstanceDetails.LicenseModel)
+	}
+
+	restoreDBInstanceInput.MultiAZ = aws.Bool(dbInstanceDetails.MultiAZ)
+
+	if dbInstanceDetails.OptionGroupName != "" {
+		restoreDBInstanceInput.OptionGroupName = aws.String(dbInstanceDetails.OptionGroupName)
+	}
+
+	if dbInstanceDetails.Port > 0 {
+		restoreDBInstanceInput.Port = aws.Int64(dbInstanceDetails.Port)
+	}
+
+	restoreDBInstanceInput.PubliclyAccessible = aws.Bool(dbInstanceDetails.PubliclyAccessible)
+
+	if dbInstanceDetails.StorageType != "" {
+		restoreDBInstanceInput.StorageType = aws.String(dbInstanceDetails.StorageType)
+	}
+
+	if len(dbInstanceDetails.Tags) > 0 {
+		restoreDBInstanceInput.Tags = BuilRDSTags(dbInstanceDetails.Tags)
+	}
+
+	if len(dbInstanceDetails.VpcSecurityGroupIds) > 0 {
+		restoreDBInstanceInput.VpcSecurityGroupIds = aws.StringSlice(dbInstanceDetails.VpcSecurityGroupIds)
+	}
+
+	return restoreDBInstanceInput
+}
+
+func (r *RDSDBInstance) buildRestoreDBInstanceToPointInTimeInput(ID string, sourceID string, restoreTime time.Time, dbInstanceDetails DBInstanceDetails) *rds.RestoreDBInstanceToPointInTimeInput {
+	restoreDBInstanceInput := &rds.RestoreDBInstanceToPointInTimeInput{
+		SourceDBInstanceIdentifier: aws.String(sourceID),
+		TargetDBInstanceIdentifier: aws.String(ID),
+	}
+
+	if restoreTime.IsZero() {
+		restoreDBInstanceInput.UseLatestRestorableTime = aws.Bool(true)
+	} else {
+		restoreDBInstanceInput.RestoreTime = aws.Time(restoreTime)
+	}
+
+	restoreDBInstanceInput.AutoMinorVersionUpgrade = aws.Bool(dbInstanceDetails.AutoMinorVersionUpgrade)
+
+	if dbInstanceDetails.AvailabilityZone != "" {
+		restoreDBInstanceInput.AvailabilityZone = aws.String(dbInstanceDetails.AvailabilityZone)
+	}
+
+	restoreDBInstanceInput.CopyTagsToSnapshot = aws.Bool(dbInstanceDetails.CopyTagsToSnapshot)
+
+	if dbInstanceDetails.DBInstanceClass != "" {
+		restoreDBInstanceInput.DBInstanceClass = aws.String(dbInstanceDetails.DBInstanceClass)
+	}
+
+	if dbInstanceDetails.DBParameterGroupName != "" {
+		restoreDBInstanceInput.DBParameterGroupName = aws.String(dbInstanceDetails.DBParameterGroupName)
+	}
+
+	if dbInstanceDetails.DBSubnetGroupName != "" {
+		restoreDBInstanceInput.DBSubnetGroupName = aws.String(dbInstanceDetails.DBSubnetGroupName)
+	}
+
+	if dbInstanceDetails.Engine != "" {
+		restoreDBInstanceInput.Engine = aws.String(dbInstanceDetails.Engine)
+	}
+
+	if dbInstanceDetails.Iops > 0 {
+		restoreDBInstanceInput.Iops = aws.Int64(dbInstanceDetails.Iops)
+	}
+
+	if dbInstanceDetails.LicenseModel != "" {
+		restoreDBInstanceInput.LicenseModel = aws.String(dbInstanceDetails.LicenseModel)
+	}
+
+	restoreDBInstanceInput.MultiAZ = aws.Bool(dbInstanceDetails.MultiAZ)
+
+	if dbInstanceDetails.OptionGroupName != "" {
+		restoreDBInstanceInput.OptionGroupName = aws.String(dbInstanceDetails.OptionGroupName)
+	}
+
+	if dbInstanceDetails.Port > 0 {
+		restoreDBInstanceInput.Port = aws.Int64(dbInstanceDetails.Port)
+	}
+
+	restoreDBInstanceInput.PubliclyAccessible = aws.Bool(dbInstanceDetails.PubliclyAccessible)
+
+	if dbInstanceDetails.StorageType != "" {
+		restoreDBInstanceInput.StorageType = aws.String(dbInstanceDetails.StorageType)
+	}
+
+	if len(dbInstanceDetails.Tags) > 0 {
+		restoreDBInstanceInput.Tags = BuilRDSTags(dbInstanceDetails.Tags)
+	}
+
+	if len(dbInstanceDetails.VpcSecurityGroupIds) > 0 {
+		restoreDBInstanceInput.VpcSecurityGroupIds = aws.StringSlice(dbInstanceDetails.VpcSecurityGroupIds)
+	}
+
+	return restoreDBInstanceInput
+}
+
 func (r *RDSDBInstance) buildDeleteDBInstanceInput(ID string, skipFinalSnapshot bool) *rds.DeleteDBInstanceInput {
 	deleteDBInstanceInput := &rds.DeleteDBInstanceInput{
 		DBInstanceIdentifier: aws.String(ID),
@@ -389,6 +844,15 @@ func (r *RDSDBInstance) dbInstanceARN(ID string) (string, error) {
 	return fmt.Sprintf("arn:aws:rds:%s:%s:db:%s", r.region, userAccount, ID), nil
 }
 
+func (r *RDSDBInstance) dbSnapshotARN(snapshotID string) (string, error) {
+	userAccount, err := UserAccount(r.iamsvc)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("arn:aws:rds:%s:%s:snapshot:%s", r.region, userAccount, snapshotID), nil
+}
+
 func (r *RDSDBInstance) allowMajorVersionUpgrade(newEngineVersion, oldEngineVersion string) bool {
 	newSplittedEngineVersion := strings.Split(newEngineVersion, ".")
 	newMajorEngineVersion := fmt.Sprintf("%s:%s", newSplittedEngineVersion[0], newSplittedEngineVersion[1])
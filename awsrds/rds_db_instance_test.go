@@ -2,6 +2,7 @@ package awsrds_test
 
 import (
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -132,6 +133,19 @@ var _ = Describe("RDS DB Instance", func() {
 			})
 		})
 
+		Context("when RDS DB Instance belongs to a DB Cluster", func() {
+			BeforeEach(func() {
+				describeDBInstance.DBClusterIdentifier = aws.String("cf-cluster-id")
+				properDBInstanceDetails.DBClusterIdentifier = "cf-cluster-id"
+			})
+
+			It("returns the proper DB Instance", func() {
+				dbInstanceDetails, err := rdsDBInstance.Describe(dbInstanceIdentifier)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstanceDetails).To(Equal(properDBInstanceDetails))
+			})
+		})
+
 		Context("when RDS DB Instance has pending modifications", func() {
 			BeforeEach(func() {
 				describeDBInstance.PendingModifiedValues = &rds.PendingModifiedValues{
@@ -1101,4 +1115,759 @@ var _ = Describe("RDS DB Instance", func() {
 			})
 		})
 	})
+
+	var _ = Describe("Stop", func() {
+		var (
+			stopDBInstanceError error
+		)
+
+		BeforeEach(func() {
+			stopDBInstanceError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("StopDBInstance"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.StopDBInstanceInput{}))
+				params := r.Params.(*rds.StopDBInstanceInput)
+				Expect(params.DBInstanceIdentifier).To(Equal(aws.String(dbInstanceIdentifier)))
+				r.Error = stopDBInstanceError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBInstance.Stop(dbInstanceIdentifier)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when stopping the DB Instance fails", func() {
+			BeforeEach(func() {
+				stopDBInstanceError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.Stop(dbInstanceIdentifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					stopDBInstanceError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.Stop(dbInstanceIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					stopDBInstanceError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.Stop(dbInstanceIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBInstanceDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("Start", func() {
+		var (
+			startDBInstanceError error
+		)
+
+		BeforeEach(func() {
+			startDBInstanceError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("StartDBInstance"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.StartDBInstanceInput{}))
+				params := r.Params.(*rds.StartDBInstanceInput)
+				Expect(params.DBInstanceIdentifier).To(Equal(aws.String(dbInstanceIdentifier)))
+				r.Error = startDBInstanceError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBInstance.Start(dbInstanceIdentifier)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when starting the DB Instance fails", func() {
+			BeforeEach(func() {
+				startDBInstanceError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.Start(dbInstanceIdentifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					startDBInstanceError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.Start(dbInstanceIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					startDBInstanceError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.Start(dbInstanceIdentifier)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBInstanceDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("ModifyMasterPassword", func() {
+		var (
+			modifyDBInstanceError error
+		)
+
+		BeforeEach(func() {
+			modifyDBInstanceError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("ModifyDBInstance"))
+				Expect(r.Params).To(Equal(&rds.ModifyDBInstanceInput{
+					DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+					MasterUserPassword:   aws.String("new-password"),
+					ApplyImmediately:     aws.Bool(true),
+				}))
+				r.Error = modifyDBInstanceError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("only modifies the master password", func() {
+			err := rdsDBInstance.ModifyMasterPassword(dbInstanceIdentifier, "new-password")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when modifying the DB Instance fails", func() {
+			BeforeEach(func() {
+				modifyDBInstanceError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.ModifyMasterPassword(dbInstanceIdentifier, "new-password")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is an AWS error", func() {
+				BeforeEach(func() {
+					modifyDBInstanceError = awserr.New("code", "message", errors.New("operation failed"))
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.ModifyMasterPassword(dbInstanceIdentifier, "new-password")
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("code: message"))
+				})
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					modifyDBInstanceError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.ModifyMasterPassword(dbInstanceIdentifier, "new-password")
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBInstanceDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("CreateSnapshot", func() {
+		var (
+			createDBSnapshotInput *rds.CreateDBSnapshotInput
+			createDBSnapshotError error
+		)
+
+		BeforeEach(func() {
+			createDBSnapshotInput = &rds.CreateDBSnapshotInput{
+				DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+				DBSnapshotIdentifier: aws.String("snapshot-id"),
+				Tags: []*rds.Tag{
+					&rds.Tag{Key: aws.String("Owner"), Value: aws.String("Cloud Foundry")},
+				},
+			}
+			createDBSnapshotError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("CreateDBSnapshot"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.CreateDBSnapshotInput{}))
+				Expect(r.Params).To(Equal(createDBSnapshotInput))
+				r.Error = createDBSnapshotError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBInstance.CreateSnapshot(dbInstanceIdentifier, "snapshot-id", map[string]string{"Owner": "Cloud Foundry"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when creating the DB snapshot fails", func() {
+			BeforeEach(func() {
+				createDBSnapshotError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.CreateSnapshot(dbInstanceIdentifier, "snapshot-id", map[string]string{"Owner": "Cloud Foundry"})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					createDBSnapshotError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.CreateSnapshot(dbInstanceIdentifier, "snapshot-id", map[string]string{"Owner": "Cloud Foundry"})
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBInstanceDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("DescribeSnapshot", func() {
+		var (
+			snapshotCreateTime time.Time
+
+			describeDBSnapshots     []*rds.DBSnapshot
+			describeDBSnapshotError error
+
+			listTagsForResourceInput *rds.ListTagsForResourceInput
+			listTagsForResourceError error
+
+			user         *iam.User
+			getUserError error
+		)
+
+		BeforeEach(func() {
+			snapshotCreateTime = time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)
+			describeDBSnapshots = []*rds.DBSnapshot{
+				&rds.DBSnapshot{
+					DBSnapshotIdentifier: aws.String("snapshot-id"),
+					DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+					Status:               aws.String("available"),
+					Engine:               aws.String("test-engine"),
+					EngineVersion:        aws.String("1.2.3"),
+					SnapshotType:         aws.String("manual"),
+					SnapshotCreateTime:   aws.Time(snapshotCreateTime),
+				},
+			}
+			describeDBSnapshotError = nil
+
+			listTagsForResourceInput = &rds.ListTagsForResourceInput{
+				ResourceName: aws.String("arn:aws:rds:rds-region:account:snapshot:snapshot-id"),
+			}
+			listTagsForResourceError = nil
+
+			user = &iam.User{
+				Arn: aws.String("arn:aws:service:region:account:resource"),
+			}
+			getUserError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(MatchRegexp("DescribeDBSnapshots|ListTagsForResource"))
+				switch r.Operation.Name {
+				case "DescribeDBSnapshots":
+					Expect(r.Params).To(Equal(&rds.DescribeDBSnapshotsInput{DBSnapshotIdentifier: aws.String("snapshot-id")}))
+					data := r.Data.(*rds.DescribeDBSnapshotsOutput)
+					data.DBSnapshots = describeDBSnapshots
+					r.Error = describeDBSnapshotError
+				case "ListTagsForResource":
+					Expect(r.Params).To(Equal(listTagsForResourceInput))
+					data := r.Data.(*rds.ListTagsForResourceOutput)
+					data.TagList = []*rds.Tag{
+						&rds.Tag{Key: aws.String("Organization ID"), Value: aws.String("organization-id")},
+					}
+					r.Error = listTagsForResourceError
+				}
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+
+			iamsvc.Handlers.Clear()
+			iamCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("GetUser"))
+				data := r.Data.(*iam.GetUserOutput)
+				data.User = user
+				r.Error = getUserError
+			}
+			iamsvc.Handlers.Send.PushBack(iamCall)
+		})
+
+		It("returns the proper DB Snapshot", func() {
+			dbSnapshotDetails, err := rdsDBInstance.DescribeSnapshot("snapshot-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbSnapshotDetails).To(Equal(DBSnapshotDetails{
+				Identifier:       "snapshot-id",
+				SourceIdentifier: dbInstanceIdentifier,
+				Status:           "available",
+				Engine:           "test-engine",
+				EngineVersion:    "1.2.3",
+				SnapshotType:     "manual",
+				CreateTime:       snapshotCreateTime,
+				Tags:             map[string]string{"Organization ID": "organization-id"},
+			}))
+		})
+
+		Context("when the DB Snapshot does not exist", func() {
+			BeforeEach(func() {
+				describeDBSnapshots = []*rds.DBSnapshot{}
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsDBInstance.DescribeSnapshot("snapshot-id")
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(ErrDBSnapshotDoesNotExist))
+			})
+		})
+
+		Context("when describing the DB Snapshot returns a 404 error", func() {
+			BeforeEach(func() {
+				awsError := awserr.New("code", "message", errors.New("operation failed"))
+				describeDBSnapshotError = awserr.NewRequestFailure(awsError, 404, "request-id")
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsDBInstance.DescribeSnapshot("snapshot-id")
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(ErrDBSnapshotDoesNotExist))
+			})
+		})
+
+		Context("when listing the tags fails", func() {
+			BeforeEach(func() {
+				listTagsForResourceError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsDBInstance.DescribeSnapshot("snapshot-id")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+		})
+	})
+
+	var _ = Describe("ListSnapshots", func() {
+		var (
+			describeDBSnapshotError error
+		)
+
+		BeforeEach(func() {
+			describeDBSnapshotError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("DescribeDBSnapshots"))
+				Expect(r.Params).To(Equal(&rds.DescribeDBSnapshotsInput{DBInstanceIdentifier: aws.String(dbInstanceIdentifier)}))
+				data := r.Data.(*rds.DescribeDBSnapshotsOutput)
+				data.DBSnapshots = []*rds.DBSnapshot{
+					&rds.DBSnapshot{DBSnapshotIdentifier: aws.String("snapshot-1"), Status: aws.String("available")},
+					&rds.DBSnapshot{DBSnapshotIdentifier: aws.String("snapshot-2"), Status: aws.String("creating")},
+				}
+				r.Error = describeDBSnapshotError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("returns the DB Snapshots", func() {
+			dbSnapshots, err := rdsDBInstance.ListSnapshots(dbInstanceIdentifier)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbSnapshots).To(HaveLen(2))
+			Expect(dbSnapshots[0].Identifier).To(Equal("snapshot-1"))
+			Expect(dbSnapshots[1].Status).To(Equal("creating"))
+		})
+
+		Context("when describing the DB Snapshots fails", func() {
+			BeforeEach(func() {
+				describeDBSnapshotError = awserr.New("code", "message", errors.New("operation failed"))
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsDBInstance.ListSnapshots(dbInstanceIdentifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("code: message"))
+			})
+		})
+	})
+
+	var _ = Describe("RestoreFromSnapshot", func() {
+		var (
+			dbInstanceDetails DBInstanceDetails
+
+			restoreDBInstanceInput *rds.RestoreDBInstanceFromDBSnapshotInput
+			restoreDBInstanceError error
+		)
+
+		BeforeEach(func() {
+			dbInstanceDetails = DBInstanceDetails{
+				DBInstanceClass: "db.m3.small",
+				Engine:          "test-engine",
+				Tags:            map[string]string{"Owner": "Cloud Foundry"},
+			}
+
+			restoreDBInstanceInput = &rds.RestoreDBInstanceFromDBSnapshotInput{
+				DBInstanceIdentifier:    aws.String(dbInstanceIdentifier),
+				DBSnapshotIdentifier:    aws.String("snapshot-id"),
+				DBInstanceClass:         aws.String("db.m3.small"),
+				Engine:                  aws.String("test-engine"),
+				AutoMinorVersionUpgrade: aws.Bool(false),
+				CopyTagsToSnapshot:      aws.Bool(false),
+				MultiAZ:                 aws.Bool(false),
+				PubliclyAccessible:      aws.Bool(false),
+				Tags: []*rds.Tag{
+					&rds.Tag{Key: aws.String("Owner"), Value: aws.String("Cloud Foundry")},
+				},
+			}
+			restoreDBInstanceError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("RestoreDBInstanceFromDBSnapshot"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.RestoreDBInstanceFromDBSnapshotInput{}))
+				Expect(r.Params).To(Equal(restoreDBInstanceInput))
+				r.Error = restoreDBInstanceError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBInstance.RestoreFromSnapshot(dbInstanceIdentifier, "snapshot-id", dbInstanceDetails)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when has DBParameterGroupName and VpcSecurityGroupIds", func() {
+			BeforeEach(func() {
+				dbInstanceDetails.DBParameterGroupName = "test-db-parameter-group-name"
+				dbInstanceDetails.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
+				restoreDBInstanceInput.DBParameterGroupName = aws.String("test-db-parameter-group-name")
+				restoreDBInstanceInput.VpcSecurityGroupIds = aws.StringSlice([]string{"test-vpc-security-group-ids"})
+			})
+
+			It("uses the plan parameter group and security groups", func() {
+				err := rdsDBInstance.RestoreFromSnapshot(dbInstanceIdentifier, "snapshot-id", dbInstanceDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when restoring the DB Instance fails", func() {
+			BeforeEach(func() {
+				restoreDBInstanceError = awserr.New("code", "message", errors.New("operation failed"))
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.RestoreFromSnapshot(dbInstanceIdentifier, "snapshot-id", dbInstanceDetails)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("code: message"))
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					restoreDBInstanceError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.RestoreFromSnapshot(dbInstanceIdentifier, "snapshot-id", dbInstanceDetails)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBSnapshotDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("RestoreToPointInTime", func() {
+		var (
+			restoreTime       time.Time
+			dbInstanceDetails DBInstanceDetails
+
+			restoreDBInstanceInput *rds.RestoreDBInstanceToPointInTimeInput
+			restoreDBInstanceError error
+		)
+
+		BeforeEach(func() {
+			restoreTime = time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)
+			dbInstanceDetails = DBInstanceDetails{
+				DBInstanceClass: "db.m3.small",
+				Engine:          "test-engine",
+			}
+
+			restoreDBInstanceInput = &rds.RestoreDBInstanceToPointInTimeInput{
+				SourceDBInstanceIdentifier: aws.String("source-instance-id"),
+				TargetDBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+				RestoreTime:                aws.Time(restoreTime),
+				DBInstanceClass:            aws.String("db.m3.small"),
+				Engine:                     aws.String("test-engine"),
+				AutoMinorVersionUpgrade:    aws.Bool(false),
+				CopyTagsToSnapshot:         aws.Bool(false),
+				MultiAZ:                    aws.Bool(false),
+				PubliclyAccessible:         aws.Bool(false),
+			}
+			restoreDBInstanceError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("RestoreDBInstanceToPointInTime"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.RestoreDBInstanceToPointInTimeInput{}))
+				Expect(r.Params).To(Equal(restoreDBInstanceInput))
+				r.Error = restoreDBInstanceError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBInstance.RestoreToPointInTime(dbInstanceIdentifier, "source-instance-id", restoreTime, dbInstanceDetails)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when has DBParameterGroupName and VpcSecurityGroupIds", func() {
+			BeforeEach(func() {
+				dbInstanceDetails.DBParameterGroupName = "test-db-parameter-group-name"
+				dbInstanceDetails.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
+				restoreDBInstanceInput.DBParameterGroupName = aws.String("test-db-parameter-group-name")
+				restoreDBInstanceInput.VpcSecurityGroupIds = aws.StringSlice([]string{"test-vpc-security-group-ids"})
+			})
+
+			It("uses the plan parameter group and security groups", func() {
+				err := rdsDBInstance.RestoreToPointInTime(dbInstanceIdentifier, "source-instance-id", restoreTime, dbInstanceDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the restore time is not set", func() {
+			BeforeEach(func() {
+				restoreTime = time.Time{}
+				restoreDBInstanceInput.RestoreTime = nil
+				restoreDBInstanceInput.UseLatestRestorableTime = aws.Bool(true)
+			})
+
+			It("restores to the latest restorable time", func() {
+				err := rdsDBInstance.RestoreToPointInTime(dbInstanceIdentifier, "source-instance-id", restoreTime, dbInstanceDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when restoring the DB Instance fails", func() {
+			BeforeEach(func() {
+				restoreDBInstanceError = awserr.New("code", "message", errors.New("operation failed"))
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.RestoreToPointInTime(dbInstanceIdentifier, "source-instance-id", restoreTime, dbInstanceDetails)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("code: message"))
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					restoreDBInstanceError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.RestoreToPointInTime(dbInstanceIdentifier, "source-instance-id", restoreTime, dbInstanceDetails)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBInstanceDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("DescribeTags", func() {
+		var (
+			listTagsForResourceError error
+		)
+
+		BeforeEach(func() {
+			listTagsForResourceError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("ListTagsForResource"))
+				Expect(r.Params).To(Equal(&rds.ListTagsForResourceInput{
+					ResourceName: aws.String("arn:aws:rds:rds-region:account:db:" + dbInstanceIdentifier),
+				}))
+				data := r.Data.(*rds.ListTagsForResourceOutput)
+				data.TagList = []*rds.Tag{
+					&rds.Tag{Key: aws.String("Space ID"), Value: aws.String("space-id")},
+				}
+				r.Error = listTagsForResourceError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+
+			iamsvc.Handlers.Clear()
+			iamCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("GetUser"))
+				data := r.Data.(*iam.GetUserOutput)
+				data.User = &iam.User{Arn: aws.String("arn:aws:service:region:account:resource")}
+			}
+			iamsvc.Handlers.Send.PushBack(iamCall)
+		})
+
+		It("returns the DB Instance tags", func() {
+			tags, err := rdsDBInstance.DescribeTags(dbInstanceIdentifier)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tags).To(Equal(map[string]string{"Space ID": "space-id"}))
+		})
+
+		Context("when listing the tags fails", func() {
+			BeforeEach(func() {
+				listTagsForResourceError = awserr.New("code", "message", errors.New("operation failed"))
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsDBInstance.DescribeTags(dbInstanceIdentifier)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("code: message"))
+			})
+		})
+	})
+
+	var _ = Describe("CreateReadReplica", func() {
+		var (
+			dbInstanceDetails DBInstanceDetails
+
+			createDBInstanceReadReplicaInput *rds.CreateDBInstanceReadReplicaInput
+			createDBInstanceReadReplicaError error
+		)
+
+		BeforeEach(func() {
+			dbInstanceDetails = DBInstanceDetails{
+				DBInstanceClass: "db.m3.small",
+				Engine:          "test-engine",
+				Tags:            map[string]string{"Owner": "Cloud Foundry"},
+			}
+
+			createDBInstanceReadReplicaInput = &rds.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       aws.String(dbInstanceIdentifier),
+				SourceDBInstanceIdentifier: aws.String("source-instance-id"),
+				DBInstanceClass:            aws.String("db.m3.small"),
+				AutoMinorVersionUpgrade:    aws.Bool(false),
+				CopyTagsToSnapshot:         aws.Bool(false),
+				PubliclyAccessible:         aws.Bool(false),
+				Tags: []*rds.Tag{
+					&rds.Tag{Key: aws.String("Owner"), Value: aws.String("Cloud Foundry")},
+				},
+			}
+			createDBInstanceReadReplicaError = nil
+		})
+
+		JustBeforeEach(func() {
+			rdssvc.Handlers.Clear()
+
+			rdsCall = func(r *request.Request) {
+				Expect(r.Operation.Name).To(Equal("CreateDBInstanceReadReplica"))
+				Expect(r.Params).To(BeAssignableToTypeOf(&rds.CreateDBInstanceReadReplicaInput{}))
+				Expect(r.Params).To(Equal(createDBInstanceReadReplicaInput))
+				r.Error = createDBInstanceReadReplicaError
+			}
+			rdssvc.Handlers.Send.PushBack(rdsCall)
+		})
+
+		It("does not return error", func() {
+			err := rdsDBInstance.CreateReadReplica(dbInstanceIdentifier, "source-instance-id", dbInstanceDetails)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when has DBParameterGroupName and VpcSecurityGroupIds", func() {
+			BeforeEach(func() {
+				dbInstanceDetails.DBParameterGroupName = "test-db-parameter-group-name"
+				dbInstanceDetails.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
+				createDBInstanceReadReplicaInput.DBParameterGroupName = aws.String("test-db-parameter-group-name")
+				createDBInstanceReadReplicaInput.VpcSecurityGroupIds = aws.StringSlice([]string{"test-vpc-security-group-ids"})
+			})
+
+			It("uses the plan parameter group and security groups", func() {
+				err := rdsDBInstance.CreateReadReplica(dbInstanceIdentifier, "source-instance-id", dbInstanceDetails)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when creating the read replica fails", func() {
+			BeforeEach(func() {
+				createDBInstanceReadReplicaError = awserr.New("code", "message", errors.New("operation failed"))
+			})
+
+			It("returns the proper error", func() {
+				err := rdsDBInstance.CreateReadReplica(dbInstanceIdentifier, "source-instance-id", dbInstanceDetails)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("code: message"))
+			})
+
+			Context("and it is a 404 error", func() {
+				BeforeEach(func() {
+					awsError := awserr.New("code", "message", errors.New("operation failed"))
+					createDBInstanceReadReplicaError = awserr.NewRequestFailure(awsError, 404, "request-id")
+				})
+
+				It("returns the proper error", func() {
+					err := rdsDBInstance.CreateReadReplica(dbInstanceIdentifier, "source-instance-id", dbInstanceDetails)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(ErrDBInstanceDoesNotExist))
+				})
+			})
+		})
+	})
 })
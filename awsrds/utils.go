@@ -33,6 +33,32 @@ func BuilRDSTags(tags map[string]string) []*rds.Tag {
 	return rdsTags
 }
 
+func ListTagsForResource(resourceARN string, rdssvc *rds.RDS, logger lager.Logger) (map[string]string, error) {
+	listTagsForResourceInput := &rds.ListTagsForResourceInput{
+		ResourceName: aws.String(resourceARN),
+	}
+
+	logger.Debug("list-tags-for-resource", lager.Data{"input": listTagsForResourceInput})
+
+	listTagsForResourceOutput, err := rdssvc.ListTagsForResource(listTagsForResourceInput)
+	if err != nil {
+		logger.Error("aws-rds-error", err)
+		if awsErr, ok := err.(awserr.Error); ok {
+			return nil, errors.New(awsErr.Code() + ": " + awsErr.Message())
+		}
+		return nil, err
+	}
+
+	logger.Debug("list-tags-for-resource", lager.Data{"output": listTagsForResourceOutput})
+
+	tags := make(map[string]string)
+	for _, tag := range listTagsForResourceOutput.TagList {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return tags, nil
+}
+
 func AddTagsToResource(resourceARN string, tags []*rds.Tag, rdssvc *rds.RDS, logger lager.Logger) error {
 	addTagsToResourceInput := &rds.AddTagsToResourceInput{
 		ResourceName: aws.String(resourceARN),
@@ -54,3 +80,28 @@ func AddTagsToResource(resourceARN string, tags []*rds.Tag, rdssvc *rds.RDS, log
 
 	return nil
 }
+
+var transientErrorCodes = []string{
+	"InternalFailure",
+	"InvalidDBClusterStateFault",
+	"InvalidDBInstanceState",
+	"RequestLimitExceeded",
+	"ServiceUnavailable",
+	"Throttling",
+	"ThrottlingException",
+}
+
+// IsTransientError returns whether an RDS error is likely to succeed if the request is retried later
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, code := range transientErrorCodes {
+		if strings.HasPrefix(err.Error(), code+": ") {
+			return true
+		}
+	}
+
+	return false
+}
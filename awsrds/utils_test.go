@@ -177,4 +177,20 @@ var _ = Describe("RDS Utils", func() {
 			})
 		})
 	})
+
+	var _ = Describe("IsTransientError", func() {
+		It("returns true for throttling errors", func() {
+			Expect(IsTransientError(errors.New("Throttling: Rate exceeded"))).To(BeTrue())
+		})
+
+		It("returns true for invalid state errors", func() {
+			Expect(IsTransientError(errors.New("InvalidDBClusterStateFault: DB cluster is not available"))).To(BeTrue())
+		})
+
+		It("returns false for other errors", func() {
+			Expect(IsTransientError(errors.New("DBInstanceAlreadyExists: DB instance already exists"))).To(BeFalse())
+			Expect(IsTransientError(ErrDBInstanceDoesNotExist)).To(BeFalse())
+			Expect(IsTransientError(nil)).To(BeFalse())
+		})
+	})
 })
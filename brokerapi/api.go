@@ -2,19 +2,26 @@ package brokerapi
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/pivotal-golang/lager"
 
-	"github.com/frodenas/brokerapi/auth"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi/auth"
 )
 
 const provisionLogKey = "provision"
 const updateLogKey = "update"
 const deprovisionLogKey = "deprovision"
+const getInstanceLogKey = "get-instance"
 const bindLogKey = "bind"
 const unbindLogKey = "unbind"
+const getBindingLogKey = "get-binding"
 const lastOperationLogKey = "last-operation"
+const lastBindingOperationLogKey = "last-binding-operation"
+const apiVersionLogKey = "api-version"
 
 const instanceIDLogKey = "instance-id"
 const bindingIDLogKey = "binding-id"
@@ -23,6 +30,7 @@ const updateDetailsLogKey = "update-details"
 const deprovisionDetailsLogKey = "deprovision-details"
 const bindDetailsLogKey = "bind-details"
 const unbindDetailsLogKey = "unbind-details"
+const pollDetailsLogKey = "poll-details"
 
 const invalidProvisionDetailsErrorKey = "invalid-provision-details"
 const invalidUpdateDetailsErrorKey = "invalid-update-details"
@@ -34,13 +42,21 @@ const instanceLimitReachedErrorKey = "instance-limit-reached"
 const instanceAsyncRequiredErrorKey = "instance-async-required"
 const instanceNotUpdateableErrorKey = "instance-not-updateable"
 const instanceNotBindableErrorKey = "instance-not-bindable"
+const concurrentAccessErrorKey = "concurrent-access"
 const bindingAlreadyExistsErrorKey = "binding-already-exists"
 const bindingMissingErrorKey = "binding-missing"
 const bindingAppGUIDRequiredErrorKey = "binding-app-guid-required"
 const unknownErrorKey = "unknown-error"
+const invalidAPIVersionErrorKey = "invalid-api-version"
 
 const statusUnprocessableEntity = 422
 
+const apiVersionHeader = "X-Broker-API-Version"
+const apiMajorVersion = 2
+
+// Fetching instances and bindings, and asynchronous bindings, were introduced by version 2.14 of the API
+const fetchMinAPIMinorVersion = 14
+
 type BrokerCredentials struct {
 	Username string
 	Password string
@@ -54,17 +70,89 @@ func New(serviceBroker ServiceBroker, logger lager.Logger, brokerCredentials Bro
 	router.Put("/v2/service_instances/{instance_id}", provision(serviceBroker, router, logger))
 	router.Patch("/v2/service_instances/{instance_id}", update(serviceBroker, router, logger))
 	router.Delete("/v2/service_instances/{instance_id}", deprovision(serviceBroker, router, logger))
+	router.Get("/v2/service_instances/{instance_id}", getInstance(serviceBroker, router, logger))
 
 	router.Put("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", bind(serviceBroker, router, logger))
 	router.Delete("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", unbind(serviceBroker, router, logger))
+	router.Get("/v2/service_instances/{instance_id}/service_bindings/{binding_id}", getBinding(serviceBroker, router, logger))
 
 	router.Get("/v2/service_instances/{instance_id}/last_operation", lastOperation(serviceBroker, router, logger))
+	router.Get("/v2/service_instances/{instance_id}/service_bindings/{binding_id}/last_operation", lastBindingOperation(serviceBroker, router, logger))
+
+	return wrapAuth(wrapAPIVersion(router, logger), brokerCredentials)
+}
+
+func wrapAuth(handler http.Handler, credentials BrokerCredentials) http.Handler {
+	return auth.NewWrapper(credentials.Username, credentials.Password).Wrap(handler)
+}
+
+// wrapAPIVersion rejects the requests of platforms that do not send a supported version of the API
+func wrapAPIVersion(handler http.Handler, logger lager.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if _, err := apiMinorVersion(req); err != nil {
+			logger.Session(apiVersionLogKey).Error(invalidAPIVersionErrorKey, err)
+			respond(w, http.StatusPreconditionFailed, ErrorResponse{
+				Description: err.Error(),
+			})
+			return
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
+func apiMinorVersion(req *http.Request) (int, error) {
+	header := req.Header.Get(apiVersionHeader)
+	if header == "" {
+		return 0, fmt.Errorf("%s header not set", apiVersionHeader)
+	}
+
+	parts := strings.Split(header, ".")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("%s header '%s' is not valid", apiVersionHeader, header)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major != apiMajorVersion {
+		return 0, fmt.Errorf("%s header must be %d.x", apiVersionHeader, apiMajorVersion)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("%s header '%s' is not valid", apiVersionHeader, header)
+	}
 
-	return wrapAuth(router, brokerCredentials)
+	return minor, nil
 }
 
-func wrapAuth(router httpRouter, credentials BrokerCredentials) http.Handler {
-	return auth.NewWrapper(credentials.Username, credentials.Password).Wrap(router)
+// requireAPIMinorVersion responds with a precondition failed error if the request uses an older version of the API
+func requireAPIMinorVersion(w http.ResponseWriter, req *http.Request, logger lager.Logger, minMinorVersion int) bool {
+	minor, err := apiMinorVersion(req)
+	if err == nil && minor < minMinorVersion {
+		err = fmt.Errorf("This endpoint requires %s %d.%d or later", apiVersionHeader, apiMajorVersion, minMinorVersion)
+	}
+
+	if err != nil {
+		logger.Error(invalidAPIVersionErrorKey, err)
+		respond(w, http.StatusPreconditionFailed, ErrorResponse{
+			Description: err.Error(),
+		})
+		return false
+	}
+
+	return true
+}
+
+func acceptsIncomplete(req *http.Request) bool {
+	return req.URL.Query().Get("accepts_incomplete") == "true"
+}
+
+func respondConcurrentAccess(w http.ResponseWriter, logger lager.Logger, err error) {
+	logger.Error(concurrentAccessErrorKey, err)
+	respond(w, statusUnprocessableEntity, ErrorResponse{
+		Error:       "ConcurrencyError",
+		Description: err.Error(),
+	})
 }
 
 func catalog(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) http.HandlerFunc {
@@ -79,10 +167,7 @@ func provision(serviceBroker ServiceBroker, router httpRouter, logger lager.Logg
 	return func(w http.ResponseWriter, req *http.Request) {
 		vars := router.Vars(req)
 		instanceID := vars["instance_id"]
-		acceptsIncomplete := false
-		if req.URL.Query().Get("accepts_incomplete") == "true" {
-			acceptsIncomplete = true
-		}
+		acceptsIncomplete := acceptsIncomplete(req)
 
 		logger := logger.Session(provisionLogKey, lager.Data{
 			instanceIDLogKey: instanceID,
@@ -119,10 +204,7 @@ func provision(serviceBroker ServiceBroker, router httpRouter, logger lager.Logg
 					Description: err.Error(),
 				})
 			default:
-				logger.Error(unknownErrorKey, err)
-				respond(w, http.StatusInternalServerError, ErrorResponse{
-					Description: err.Error(),
-				})
+				respondUnknownError(w, logger, err)
 			}
 			return
 		}
@@ -140,10 +222,7 @@ func update(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger)
 	return func(w http.ResponseWriter, req *http.Request) {
 		vars := router.Vars(req)
 		instanceID := vars["instance_id"]
-		acceptsIncomplete := false
-		if req.URL.Query().Get("accepts_incomplete") == "true" {
-			acceptsIncomplete = true
-		}
+		acceptsIncomplete := acceptsIncomplete(req)
 
 		logger := logger.Session(updateLogKey, lager.Data{
 			instanceIDLogKey: instanceID,
@@ -162,9 +241,11 @@ func update(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger)
 			updateDetailsLogKey: details,
 		})
 
-		asynch, err := serviceBroker.Update(instanceID, details, acceptsIncomplete)
+		updateResponse, asynch, err := serviceBroker.Update(instanceID, details, acceptsIncomplete)
 		if err != nil {
 			switch err {
+			case ErrConcurrentAccess:
+				respondConcurrentAccess(w, logger, err)
 			case ErrInstanceDoesNotExist:
 				logger.Error(instanceMissingErrorKey, err)
 				respond(w, http.StatusInternalServerError, EmptyResponse{})
@@ -180,20 +261,17 @@ func update(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger)
 					Description: err.Error(),
 				})
 			default:
-				logger.Error(unknownErrorKey, err)
-				respond(w, http.StatusInternalServerError, ErrorResponse{
-					Description: err.Error(),
-				})
+				respondUnknownError(w, logger, err)
 			}
 			return
 		}
 
 		if asynch {
-			respond(w, http.StatusAccepted, EmptyResponse{})
+			respond(w, http.StatusAccepted, updateResponse)
 			return
 		}
 
-		respond(w, http.StatusOK, EmptyResponse{})
+		respond(w, http.StatusOK, updateResponse)
 	}
 }
 
@@ -201,10 +279,7 @@ func deprovision(serviceBroker ServiceBroker, router httpRouter, logger lager.Lo
 	return func(w http.ResponseWriter, req *http.Request) {
 		vars := router.Vars(req)
 		instanceID := vars["instance_id"]
-		acceptsIncomplete := false
-		if req.URL.Query().Get("accepts_incomplete") == "true" {
-			acceptsIncomplete = true
-		}
+		acceptsIncomplete := acceptsIncomplete(req)
 
 		logger := logger.Session(deprovisionLogKey, lager.Data{
 			instanceIDLogKey: instanceID,
@@ -219,9 +294,11 @@ func deprovision(serviceBroker ServiceBroker, router httpRouter, logger lager.Lo
 			deprovisionDetailsLogKey: details,
 		})
 
-		asynch, err := serviceBroker.Deprovision(instanceID, details, acceptsIncomplete)
+		deprovisionResponse, asynch, err := serviceBroker.Deprovision(instanceID, details, acceptsIncomplete)
 		if err != nil {
 			switch err {
+			case ErrConcurrentAccess:
+				respondConcurrentAccess(w, logger, err)
 			case ErrInstanceDoesNotExist:
 				logger.Error(instanceMissingErrorKey, err)
 				respond(w, http.StatusGone, EmptyResponse{})
@@ -232,16 +309,13 @@ func deprovision(serviceBroker ServiceBroker, router httpRouter, logger lager.Lo
 					Description: err.Error(),
 				})
 			default:
-				logger.Error(unknownErrorKey, err)
-				respond(w, http.StatusInternalServerError, ErrorResponse{
-					Description: err.Error(),
-				})
+				respondUnknownError(w, logger, err)
 			}
 			return
 		}
 
 		if asynch {
-			respond(w, http.StatusAccepted, EmptyResponse{})
+			respond(w, http.StatusAccepted, deprovisionResponse)
 			return
 		}
 
@@ -249,11 +323,43 @@ func deprovision(serviceBroker ServiceBroker, router httpRouter, logger lager.Lo
 	}
 }
 
+func getInstance(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		vars := router.Vars(req)
+		instanceID := vars["instance_id"]
+
+		logger := logger.Session(getInstanceLogKey, lager.Data{
+			instanceIDLogKey: instanceID,
+		})
+
+		if !requireAPIMinorVersion(w, req, logger, fetchMinAPIMinorVersion) {
+			return
+		}
+
+		getInstanceResponse, err := serviceBroker.GetInstance(instanceID)
+		if err != nil {
+			switch err {
+			case ErrInstanceDoesNotExist:
+				logger.Error(instanceMissingErrorKey, err)
+				respond(w, http.StatusNotFound, EmptyResponse{})
+			case ErrConcurrentAccess:
+				respondConcurrentAccess(w, logger, err)
+			default:
+				respondUnknownError(w, logger, err)
+			}
+			return
+		}
+
+		respond(w, http.StatusOK, getInstanceResponse)
+	}
+}
+
 func bind(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		vars := router.Vars(req)
 		instanceID := vars["instance_id"]
 		bindingID := vars["binding_id"]
+		acceptsIncomplete := acceptsIncomplete(req)
 
 		logger := logger.Session(bindLogKey, lager.Data{
 			instanceIDLogKey: instanceID,
@@ -273,9 +379,15 @@ func bind(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) h
 			bindDetailsLogKey: details,
 		})
 
-		bindingResponse, err := serviceBroker.Bind(instanceID, bindingID, details)
+		bindingResponse, asynch, err := serviceBroker.Bind(instanceID, bindingID, details, acceptsIncomplete)
 		if err != nil {
 			switch err {
+			case ErrAsyncRequired:
+				logger.Error(instanceAsyncRequiredErrorKey, err)
+				respond(w, statusUnprocessableEntity, ErrorResponse{
+					Error:       "AsyncRequired",
+					Description: err.Error(),
+				})
 			case ErrInstanceDoesNotExist:
 				logger.Error(instanceMissingErrorKey, err)
 				respond(w, http.StatusInternalServerError, ErrorResponse{
@@ -297,15 +409,19 @@ func bind(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) h
 				respond(w, http.StatusInternalServerError, ErrorResponse{
 					Description: err.Error(),
 				})
+			case ErrConcurrentAccess:
+				respondConcurrentAccess(w, logger, err)
 			default:
-				logger.Error(unknownErrorKey, err)
-				respond(w, http.StatusInternalServerError, ErrorResponse{
-					Description: err.Error(),
-				})
+				respondUnknownError(w, logger, err)
 			}
 			return
 		}
 
+		if asynch {
+			respond(w, http.StatusAccepted, bindingResponse)
+			return
+		}
+
 		respond(w, http.StatusCreated, bindingResponse)
 	}
 }
@@ -315,6 +431,7 @@ func unbind(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger)
 		vars := router.Vars(req)
 		instanceID := vars["instance_id"]
 		bindingID := vars["binding_id"]
+		acceptsIncomplete := acceptsIncomplete(req)
 
 		logger := logger.Session(unbindLogKey, lager.Data{
 			instanceIDLogKey: instanceID,
@@ -330,8 +447,15 @@ func unbind(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger)
 			unbindDetailsLogKey: details,
 		})
 
-		if err := serviceBroker.Unbind(instanceID, bindingID, details); err != nil {
+		unbindResponse, asynch, err := serviceBroker.Unbind(instanceID, bindingID, details, acceptsIncomplete)
+		if err != nil {
 			switch err {
+			case ErrAsyncRequired:
+				logger.Error(instanceAsyncRequiredErrorKey, err)
+				respond(w, statusUnprocessableEntity, ErrorResponse{
+					Error:       "AsyncRequired",
+					Description: err.Error(),
+				})
 			case ErrInstanceDoesNotExist:
 				logger.Error(instanceMissingErrorKey, err)
 				respond(w, http.StatusInternalServerError, ErrorResponse{
@@ -340,19 +464,57 @@ func unbind(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger)
 			case ErrBindingDoesNotExist:
 				logger.Error(bindingMissingErrorKey, err)
 				respond(w, http.StatusGone, EmptyResponse{})
+			case ErrConcurrentAccess:
+				respondConcurrentAccess(w, logger, err)
 			default:
-				logger.Error(unknownErrorKey, err)
-				respond(w, http.StatusInternalServerError, ErrorResponse{
-					Description: err.Error(),
-				})
+				respondUnknownError(w, logger, err)
 			}
 			return
 		}
 
+		if asynch {
+			respond(w, http.StatusAccepted, unbindResponse)
+			return
+		}
+
 		respond(w, http.StatusOK, EmptyResponse{})
 	}
 }
 
+func getBinding(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		vars := router.Vars(req)
+		instanceID := vars["instance_id"]
+		bindingID := vars["binding_id"]
+
+		logger := logger.Session(getBindingLogKey, lager.Data{
+			instanceIDLogKey: instanceID,
+			bindingIDLogKey:  bindingID,
+		})
+
+		if !requireAPIMinorVersion(w, req, logger, fetchMinAPIMinorVersion) {
+			return
+		}
+
+		getBindingResponse, err := serviceBroker.GetBinding(instanceID, bindingID)
+		if err != nil {
+			switch err {
+			case ErrInstanceDoesNotExist:
+				logger.Error(instanceMissingErrorKey, err)
+				respond(w, http.StatusNotFound, EmptyResponse{})
+			case ErrBindingDoesNotExist:
+				logger.Error(bindingMissingErrorKey, err)
+				respond(w, http.StatusNotFound, EmptyResponse{})
+			default:
+				respondUnknownError(w, logger, err)
+			}
+			return
+		}
+
+		respond(w, http.StatusOK, getBindingResponse)
+	}
+}
+
 func lastOperation(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		vars := router.Vars(req)
@@ -362,17 +524,20 @@ func lastOperation(serviceBroker ServiceBroker, router httpRouter, logger lager.
 			instanceIDLogKey: instanceID,
 		})
 
-		lastOperationResponse, err := serviceBroker.LastOperation(instanceID)
+		details := pollDetails(req)
+
+		logger = logger.WithData(lager.Data{
+			pollDetailsLogKey: details,
+		})
+
+		lastOperationResponse, err := serviceBroker.LastOperation(instanceID, details)
 		if err != nil {
 			switch err {
 			case ErrInstanceDoesNotExist:
 				logger.Error(instanceMissingErrorKey, err)
 				respond(w, http.StatusGone, EmptyResponse{})
 			default:
-				logger.Error(unknownErrorKey, err)
-				respond(w, http.StatusInternalServerError, ErrorResponse{
-					Description: err.Error(),
-				})
+				respondUnknownError(w, logger, err)
 			}
 			return
 		}
@@ -381,6 +546,69 @@ func lastOperation(serviceBroker ServiceBroker, router httpRouter, logger lager.
 	}
 }
 
+func lastBindingOperation(serviceBroker ServiceBroker, router httpRouter, logger lager.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		vars := router.Vars(req)
+		instanceID := vars["instance_id"]
+		bindingID := vars["binding_id"]
+
+		logger := logger.Session(lastBindingOperationLogKey, lager.Data{
+			instanceIDLogKey: instanceID,
+			bindingIDLogKey:  bindingID,
+		})
+
+		if !requireAPIMinorVersion(w, req, logger, fetchMinAPIMinorVersion) {
+			return
+		}
+
+		details := pollDetails(req)
+
+		logger = logger.WithData(lager.Data{
+			pollDetailsLogKey: details,
+		})
+
+		lastOperationResponse, err := serviceBroker.LastBindingOperation(instanceID, bindingID, details)
+		if err != nil {
+			switch err {
+			case ErrInstanceDoesNotExist:
+				logger.Error(instanceMissingErrorKey, err)
+				respond(w, http.StatusGone, EmptyResponse{})
+			case ErrBindingDoesNotExist:
+				logger.Error(bindingMissingErrorKey, err)
+				respond(w, http.StatusGone, EmptyResponse{})
+			default:
+				respondUnknownError(w, logger, err)
+			}
+			return
+		}
+
+		respond(w, http.StatusOK, lastOperationResponse)
+	}
+}
+
+func pollDetails(req *http.Request) PollDetails {
+	return PollDetails{
+		ServiceID:     req.FormValue("service_id"),
+		PlanID:        req.FormValue("plan_id"),
+		OperationData: req.FormValue("operation"),
+	}
+}
+
+func respondUnknownError(w http.ResponseWriter, logger lager.Logger, err error) {
+	if failureResponse, ok := err.(*FailureResponse); ok {
+		logger.Error(failureResponse.LoggerAction(), err)
+		respond(w, failureResponse.StatusCode(), ErrorResponse{
+			Description: err.Error(),
+		})
+		return
+	}
+
+	logger.Error(unknownErrorKey, err)
+	respond(w, http.StatusInternalServerError, ErrorResponse{
+		Description: err.Error(),
+	})
+}
+
 func respond(w http.ResponseWriter, status int, response interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
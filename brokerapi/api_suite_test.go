@@ -1,13 +1,12 @@
 package brokerapi_test
 
 import (
+	"crypto/rand"
 	"fmt"
 	"io/ioutil"
 	"path"
 	"testing"
 
-	"code.google.com/p/go-uuid/uuid"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -28,7 +27,14 @@ func fixture(name string) string {
 }
 
 func uniqueID() string {
-	return uuid.NewRandom().String()
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
 }
 
 func uniqueInstanceID() string {
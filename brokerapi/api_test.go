@@ -9,11 +9,11 @@ import (
 	"net/http/httptest"
 	"strings"
 
-	. "github.com/frodenas/brokerapi"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
-	"github.com/frodenas/brokerapi/fakes"
+	. "github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi/fakes"
 
 	"github.com/drewolson/testflight"
 	"github.com/pivotal-golang/lager"
@@ -28,6 +28,7 @@ var _ = Describe("Service Broker API", func() {
 		Username: "username",
 		Password: "password",
 	}
+	var apiVersion string
 
 	lastLogLine := func() lager.LogFormat {
 		if len(brokerLogger.Logs()) == 0 {
@@ -43,6 +44,7 @@ var _ = Describe("Service Broker API", func() {
 		fakeServiceBroker = &fakes.FakeServiceBroker{}
 		brokerLogger = lagertest.NewTestLogger("broker-api")
 		brokerAPI = New(fakeServiceBroker, brokerLogger, credentials)
+		apiVersion = "2.14"
 	})
 
 	Describe("respose headers", func() {
@@ -50,6 +52,7 @@ var _ = Describe("Service Broker API", func() {
 			recorder := httptest.NewRecorder()
 			request, _ := http.NewRequest("GET", "/v2/catalog", nil)
 			request.SetBasicAuth(credentials.Username, credentials.Password)
+			request.Header.Add("X-Broker-API-Version", apiVersion)
 			brokerAPI.ServeHTTP(recorder, request)
 			return recorder
 		}
@@ -62,6 +65,59 @@ var _ = Describe("Service Broker API", func() {
 		})
 	})
 
+	Describe("api version", func() {
+		makeRequest := func() *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			request, _ := http.NewRequest("GET", "/v2/catalog", nil)
+			request.SetBasicAuth(credentials.Username, credentials.Password)
+			if apiVersion != "" {
+				request.Header.Add("X-Broker-API-Version", apiVersion)
+			}
+			brokerAPI.ServeHTTP(recorder, request)
+			return recorder
+		}
+
+		It("accepts 2.x versions", func() {
+			apiVersion = "2.12"
+
+			response := makeRequest()
+			Expect(response.Code).To(Equal(200))
+		})
+
+		Context("when the version header is not set", func() {
+			BeforeEach(func() {
+				apiVersion = ""
+			})
+
+			It("returns a 412", func() {
+				response := makeRequest()
+				Expect(response.Code).To(Equal(412))
+			})
+
+			It("returns json with a description field and a useful error message", func() {
+				response := makeRequest()
+				Expect(response.Body.String()).To(MatchJSON(`{"description":"X-Broker-API-Version header not set"}`))
+			})
+
+			It("logs an appropriate error", func() {
+				makeRequest()
+				Expect(lastLogLine().Message).To(ContainSubstring("api-version.invalid-api-version"))
+			})
+		})
+
+		Context("when the major version is not supported", func() {
+			BeforeEach(func() {
+				apiVersion = "1.0"
+			})
+
+			It("returns a 412", func() {
+				response := makeRequest()
+				Expect(response.Code).To(Equal(412))
+				Expect(response.Body.String()).To(MatchJSON(`{"description":"X-Broker-API-Version header must be 2.x"}`))
+			})
+		})
+	})
+
 	Describe("authentication", func() {
 		makeRequestWithoutAuth := func() *testflight.Response {
 			response := &testflight.Response{}
@@ -130,6 +186,7 @@ var _ = Describe("Service Broker API", func() {
 			testflight.WithServer(brokerAPI, func(r *testflight.Requester) {
 				request, _ := http.NewRequest("GET", "/v2/catalog", nil)
 				request.SetBasicAuth("username", "password")
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -166,6 +223,7 @@ var _ = Describe("Service Broker API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				request.Header.Add("Content-Type", "application/json")
 				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -233,12 +291,44 @@ var _ = Describe("Service Broker API", func() {
 				Expect(response.StatusCode).To(Equal(202))
 			})
 
+			Context("and returns an operation", func() {
+				BeforeEach(func() {
+					fakeServiceBroker.ProvisionResponse = ProvisioningResponse{OperationData: "provision"}
+				})
+
+				It("returns the operation", func() {
+					response := makeProvisionRequest(provisionInstanceID, provisionDetails, provisionAcceptsIncomplete)
+					Expect(response.Body).To(MatchJSON(`{"operation":"provision"}`))
+				})
+			})
+
 			It("returns proper json", func() {
 				response := makeProvisionRequest(provisionInstanceID, provisionDetails, provisionAcceptsIncomplete)
 				Expect(response.Body).To(MatchJSON(fixture("provision.json")))
 			})
 		})
 
+		Context("when the broker returns a failure response", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.ProvisionError = NewFailureResponse(errors.New("invalid parameters"), http.StatusBadRequest, "invalid-parameters")
+			})
+
+			It("returns the failure response status code", func() {
+				response := makeProvisionRequest(provisionInstanceID, provisionDetails, provisionAcceptsIncomplete)
+				Expect(response.StatusCode).To(Equal(400))
+			})
+
+			It("returns the error description", func() {
+				response := makeProvisionRequest(provisionInstanceID, provisionDetails, provisionAcceptsIncomplete)
+				Expect(response.Body).To(MatchJSON(`{"description": "invalid parameters"}`))
+			})
+
+			It("logs the failure response logger action", func() {
+				makeProvisionRequest(provisionInstanceID, provisionDetails, provisionAcceptsIncomplete)
+				Expect(lastLogLine().Message).To(ContainSubstring("provision.invalid-parameters"))
+			})
+		})
+
 		Context("when the instance already exists", func() {
 			BeforeEach(func() {
 				fakeServiceBroker.ProvisionError = ErrInstanceAlreadyExists
@@ -340,6 +430,7 @@ var _ = Describe("Service Broker API", func() {
 					Expect(err).NotTo(HaveOccurred())
 					request.Header.Add("Content-Type", "application/json")
 					request.SetBasicAuth(credentials.Username, credentials.Password)
+					request.Header.Add("X-Broker-API-Version", apiVersion)
 
 					response = r.Do(request)
 				})
@@ -378,6 +469,7 @@ var _ = Describe("Service Broker API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				request.Header.Add("Content-Type", "application/json")
 				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -398,6 +490,7 @@ var _ = Describe("Service Broker API", func() {
 			}
 			updateAcceptsIncomplete = true
 
+			fakeServiceBroker.UpdateResponse = UpdateResponse{}
 			fakeServiceBroker.UpdateAsynch = false
 			fakeServiceBroker.UpdateError = nil
 		})
@@ -441,6 +534,49 @@ var _ = Describe("Service Broker API", func() {
 				response := makeUpdateRequest(updateInstanceID, updateDetails, updateAcceptsIncomplete)
 				Expect(response.Body).To(MatchJSON(`{}`))
 			})
+
+			Context("and returns an operation", func() {
+				BeforeEach(func() {
+					fakeServiceBroker.UpdateResponse = UpdateResponse{OperationData: "update"}
+				})
+
+				It("returns the operation", func() {
+					response := makeUpdateRequest(updateInstanceID, updateDetails, updateAcceptsIncomplete)
+					Expect(response.Body).To(MatchJSON(`{"operation":"update"}`))
+				})
+			})
+		})
+
+		Context("when the update details have a context", func() {
+			BeforeEach(func() {
+				updateDetails.Context = map[string]interface{}{"platform": "cloudfoundry"}
+			})
+
+			It("calls Update on the service broker with the context", func() {
+				makeUpdateRequest(updateInstanceID, updateDetails, updateAcceptsIncomplete)
+				Expect(fakeServiceBroker.UpdateDetails.Context).To(Equal(map[string]interface{}{"platform": "cloudfoundry"}))
+			})
+		})
+
+		Context("when another operation is in progress", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.UpdateError = ErrConcurrentAccess
+			})
+
+			It("returns a 422", func() {
+				response := makeUpdateRequest(updateInstanceID, updateDetails, updateAcceptsIncomplete)
+				Expect(response.StatusCode).To(Equal(422))
+			})
+
+			It("returns json with an error field", func() {
+				response := makeUpdateRequest(updateInstanceID, updateDetails, updateAcceptsIncomplete)
+				Expect(response.Body).To(MatchJSON(`{"error":"ConcurrencyError","description":"Another operation for this service instance is in progress."}`))
+			})
+
+			It("logs an appropriate error", func() {
+				makeUpdateRequest(updateInstanceID, updateDetails, updateAcceptsIncomplete)
+				Expect(lastLogLine().Message).To(ContainSubstring("update.concurrent-access"))
+			})
 		})
 
 		Context("when the instance does not exists", func() {
@@ -543,6 +679,7 @@ var _ = Describe("Service Broker API", func() {
 					Expect(err).NotTo(HaveOccurred())
 					request.Header.Add("Content-Type", "application/json")
 					request.SetBasicAuth(credentials.Username, credentials.Password)
+					request.Header.Add("X-Broker-API-Version", apiVersion)
 
 					response = r.Do(request)
 				})
@@ -583,6 +720,7 @@ var _ = Describe("Service Broker API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				request.Header.Add("Content-Type", "application/json")
 				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -599,6 +737,7 @@ var _ = Describe("Service Broker API", func() {
 			}
 			deprovisionAcceptsIncomplete = true
 
+			fakeServiceBroker.DeprovisionResponse = DeprovisionResponse{}
 			fakeServiceBroker.DeprovisionAsynch = false
 			fakeServiceBroker.DeprovisionError = nil
 		})
@@ -642,6 +781,17 @@ var _ = Describe("Service Broker API", func() {
 				response := makeDeprovisionRequest(deprovisionInstanceID, deprovisionServiceID, deprovisionPlanID, deprovisionAcceptsIncomplete)
 				Expect(response.Body).To(MatchJSON(`{}`))
 			})
+
+			Context("and returns an operation", func() {
+				BeforeEach(func() {
+					fakeServiceBroker.DeprovisionResponse = DeprovisionResponse{OperationData: "deprovision"}
+				})
+
+				It("returns the operation", func() {
+					response := makeDeprovisionRequest(deprovisionInstanceID, deprovisionServiceID, deprovisionPlanID, deprovisionAcceptsIncomplete)
+					Expect(response.Body).To(MatchJSON(`{"operation":"deprovision"}`))
+				})
+			})
 		})
 
 		Context("when the instance does not exists", func() {
@@ -711,15 +861,115 @@ var _ = Describe("Service Broker API", func() {
 		})
 	})
 
+	Describe("get instance", func() {
+		var getInstanceInstanceID string
+
+		makeGetInstanceRequest := func(instanceID string) *testflight.Response {
+			response := &testflight.Response{}
+			testflight.WithServer(brokerAPI, func(r *testflight.Requester) {
+				path := fmt.Sprintf("/v2/service_instances/%s", instanceID)
+
+				request, err := http.NewRequest("GET", path, nil)
+				Expect(err).NotTo(HaveOccurred())
+				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
+
+				response = r.Do(request)
+			})
+			return response
+		}
+
+		BeforeEach(func() {
+			getInstanceInstanceID = uniqueInstanceID()
+
+			fakeServiceBroker.GetInstanceResponse = GetInstanceResponse{
+				ServiceID:  "service-id",
+				PlanID:     "plan-id",
+				Parameters: map[string]interface{}{"foo": "bar"},
+			}
+			fakeServiceBroker.GetInstanceError = nil
+		})
+
+		It("calls GetInstance on the service broker with the instance id", func() {
+			makeGetInstanceRequest(getInstanceInstanceID)
+			Expect(fakeServiceBroker.GetInstanceInstanceID).To(Equal(getInstanceInstanceID))
+		})
+
+		It("returns a 200", func() {
+			response := makeGetInstanceRequest(getInstanceInstanceID)
+			Expect(response.StatusCode).To(Equal(200))
+		})
+
+		It("returns proper json", func() {
+			response := makeGetInstanceRequest(getInstanceInstanceID)
+			Expect(response.Body).To(MatchJSON(`{"service_id":"service-id","plan_id":"plan-id","parameters":{"foo":"bar"}}`))
+		})
+
+		Context("when the api version is older than 2.14", func() {
+			BeforeEach(func() {
+				apiVersion = "2.13"
+			})
+
+			It("returns a 412", func() {
+				response := makeGetInstanceRequest(getInstanceInstanceID)
+				Expect(response.StatusCode).To(Equal(412))
+				Expect(fakeServiceBroker.BrokerCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the instance does not exists", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.GetInstanceError = ErrInstanceDoesNotExist
+			})
+
+			It("returns a 404", func() {
+				response := makeGetInstanceRequest(getInstanceInstanceID)
+				Expect(response.StatusCode).To(Equal(404))
+			})
+
+			It("logs an appropriate error", func() {
+				makeGetInstanceRequest(getInstanceInstanceID)
+				Expect(lastLogLine().Message).To(ContainSubstring("get-instance.instance-missing"))
+			})
+		})
+
+		Context("when another operation is in progress", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.GetInstanceError = ErrConcurrentAccess
+			})
+
+			It("returns a 422", func() {
+				response := makeGetInstanceRequest(getInstanceInstanceID)
+				Expect(response.StatusCode).To(Equal(422))
+			})
+		})
+
+		Context("when an unexpected error occurs", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.GetInstanceError = errors.New("broker failed")
+			})
+
+			It("returns a 500", func() {
+				response := makeGetInstanceRequest(getInstanceInstanceID)
+				Expect(response.StatusCode).To(Equal(500))
+				Expect(response.Body).To(MatchJSON(`{"description":"broker failed"}`))
+			})
+		})
+	})
+
 	Describe("bind", func() {
 		var bindInstanceID string
 		var bindBindingID string
 		var bindDetails BindDetails
+		var bindAcceptsIncomplete bool
 
 		makeBindRequest := func(instanceID string, bindingID string, bindDetails BindDetails) *testflight.Response {
 			response := &testflight.Response{}
 			testflight.WithServer(brokerAPI, func(r *testflight.Requester) {
 				path := fmt.Sprintf("/v2/service_instances/%s/service_bindings/%s", instanceID, bindingID)
+				if bindAcceptsIncomplete {
+					path = path + "?accepts_incomplete=true"
+				}
 
 				buffer := &bytes.Buffer{}
 				json.NewEncoder(buffer).Encode(bindDetails)
@@ -727,6 +977,7 @@ var _ = Describe("Service Broker API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				request.Header.Add("Content-Type", "application/json")
 				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -741,6 +992,9 @@ var _ = Describe("Service Broker API", func() {
 				PlanID:    "plan-id",
 			}
 
+			bindAcceptsIncomplete = false
+
+			fakeServiceBroker.BindAsynch = false
 			fakeServiceBroker.BindError = nil
 			fakeServiceBroker.BindResponse = BindingResponse{
 				Credentials: map[string]interface{}{
@@ -777,6 +1031,31 @@ var _ = Describe("Service Broker API", func() {
 			Expect(response.StatusCode).To(Equal(201))
 		})
 
+		It("calls Bind on the service broker with accepts incomplete", func() {
+			bindAcceptsIncomplete = true
+
+			makeBindRequest(bindInstanceID, bindBindingID, bindDetails)
+			Expect(fakeServiceBroker.BindAcceptsIncomplete).To(BeTrue())
+		})
+
+		Context("when broker is asynchronous", func() {
+			BeforeEach(func() {
+				bindAcceptsIncomplete = true
+				fakeServiceBroker.BindAsynch = true
+				fakeServiceBroker.BindResponse = BindingResponse{OperationData: "bind"}
+			})
+
+			It("returns a 202", func() {
+				response := makeBindRequest(bindInstanceID, bindBindingID, bindDetails)
+				Expect(response.StatusCode).To(Equal(202))
+			})
+
+			It("returns the operation", func() {
+				response := makeBindRequest(bindInstanceID, bindBindingID, bindDetails)
+				Expect(response.Body).To(MatchJSON(`{"credentials":null,"operation":"bind"}`))
+			})
+		})
+
 		Context("when the instance does not exists", func() {
 			BeforeEach(func() {
 				fakeServiceBroker.BindError = ErrInstanceDoesNotExist
@@ -865,6 +1144,23 @@ var _ = Describe("Service Broker API", func() {
 			})
 		})
 
+		Context("when another operation is in progress", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.BindError = ErrConcurrentAccess
+			})
+
+			It("returns a 422", func() {
+				response := makeBindRequest(bindInstanceID, bindBindingID, bindDetails)
+				Expect(response.StatusCode).To(Equal(422))
+				Expect(response.Body).To(MatchJSON(`{"error":"ConcurrencyError","description":"Another operation for this service instance is in progress."}`))
+			})
+
+			It("logs an appropriate error", func() {
+				makeBindRequest(bindInstanceID, bindBindingID, bindDetails)
+				Expect(lastLogLine().Message).To(ContainSubstring("bind.concurrent-access"))
+			})
+		})
+
 		Context("when an unexpected error occurs", func() {
 			BeforeEach(func() {
 				fakeServiceBroker.BindError = errors.New("broker failed")
@@ -899,6 +1195,7 @@ var _ = Describe("Service Broker API", func() {
 					Expect(err).NotTo(HaveOccurred())
 					request.Header.Add("Content-Type", "application/json")
 					request.SetBasicAuth(credentials.Username, credentials.Password)
+					request.Header.Add("X-Broker-API-Version", apiVersion)
 
 					response = r.Do(request)
 				})
@@ -936,6 +1233,7 @@ var _ = Describe("Service Broker API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				request.Header.Add("Content-Type", "application/json")
 				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -952,6 +1250,7 @@ var _ = Describe("Service Broker API", func() {
 				PlanID:    unbindPlanID,
 			}
 
+			fakeServiceBroker.UnbindAsynch = false
 			fakeServiceBroker.UnbindError = nil
 		})
 
@@ -980,6 +1279,23 @@ var _ = Describe("Service Broker API", func() {
 			Expect(response.StatusCode).To(Equal(200))
 		})
 
+		Context("when broker is asynchronous", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.UnbindAsynch = true
+				fakeServiceBroker.UnbindResponse = UnbindResponse{OperationData: "unbind"}
+			})
+
+			It("returns a 202", func() {
+				response := makeUnbindRequest(unbindInstanceID, unbindBindingID, unbindServiceID, unbindPlanID)
+				Expect(response.StatusCode).To(Equal(202))
+			})
+
+			It("returns the operation", func() {
+				response := makeUnbindRequest(unbindInstanceID, unbindBindingID, unbindServiceID, unbindPlanID)
+				Expect(response.Body).To(MatchJSON(`{"operation":"unbind"}`))
+			})
+		})
+
 		Context("when the instance does not exists", func() {
 			BeforeEach(func() {
 				fakeServiceBroker.UnbindError = ErrInstanceDoesNotExist
@@ -1024,6 +1340,23 @@ var _ = Describe("Service Broker API", func() {
 			})
 		})
 
+		Context("when another operation is in progress", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.UnbindError = ErrConcurrentAccess
+			})
+
+			It("returns a 422", func() {
+				response := makeUnbindRequest(unbindInstanceID, unbindBindingID, unbindServiceID, unbindPlanID)
+				Expect(response.StatusCode).To(Equal(422))
+				Expect(response.Body).To(MatchJSON(`{"error":"ConcurrencyError","description":"Another operation for this service instance is in progress."}`))
+			})
+
+			It("logs an appropriate error", func() {
+				makeUnbindRequest(unbindInstanceID, unbindBindingID, unbindServiceID, unbindPlanID)
+				Expect(lastLogLine().Message).To(ContainSubstring("unbind.concurrent-access"))
+			})
+		})
+
 		Context("when an unexpected error occurs", func() {
 			BeforeEach(func() {
 				fakeServiceBroker.UnbindError = errors.New("broker failed")
@@ -1047,18 +1380,148 @@ var _ = Describe("Service Broker API", func() {
 		})
 	})
 
+	Describe("get binding", func() {
+		var getBindingInstanceID string
+		var getBindingBindingID string
+
+		makeGetBindingRequest := func(instanceID string, bindingID string) *testflight.Response {
+			response := &testflight.Response{}
+			testflight.WithServer(brokerAPI, func(r *testflight.Requester) {
+				path := fmt.Sprintf("/v2/service_instances/%s/service_bindings/%s", instanceID, bindingID)
+
+				request, err := http.NewRequest("GET", path, nil)
+				Expect(err).NotTo(HaveOccurred())
+				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
+
+				response = r.Do(request)
+			})
+			return response
+		}
+
+		BeforeEach(func() {
+			getBindingInstanceID = uniqueInstanceID()
+			getBindingBindingID = uniqueBindingID()
+
+			fakeServiceBroker.GetBindingResponse = GetBindingResponse{
+				Credentials: map[string]interface{}{"username": "batman"},
+				Parameters:  map[string]interface{}{"foo": "bar"},
+			}
+			fakeServiceBroker.GetBindingError = nil
+		})
+
+		It("calls GetBinding on the service broker with the instance and binding ids", func() {
+			makeGetBindingRequest(getBindingInstanceID, getBindingBindingID)
+			Expect(fakeServiceBroker.GetBindingInstanceID).To(Equal(getBindingInstanceID))
+			Expect(fakeServiceBroker.GetBindingBindingID).To(Equal(getBindingBindingID))
+		})
+
+		It("returns a 200", func() {
+			response := makeGetBindingRequest(getBindingInstanceID, getBindingBindingID)
+			Expect(response.StatusCode).To(Equal(200))
+		})
+
+		It("returns proper json", func() {
+			response := makeGetBindingRequest(getBindingInstanceID, getBindingBindingID)
+			Expect(response.Body).To(MatchJSON(`{"credentials":{"username":"batman"},"parameters":{"foo":"bar"}}`))
+		})
+
+		Context("when the api version is older than 2.14", func() {
+			BeforeEach(func() {
+				apiVersion = "2.13"
+			})
+
+			It("returns a 412", func() {
+				response := makeGetBindingRequest(getBindingInstanceID, getBindingBindingID)
+				Expect(response.StatusCode).To(Equal(412))
+				Expect(fakeServiceBroker.BrokerCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the binding does not exists", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.GetBindingError = ErrBindingDoesNotExist
+			})
+
+			It("returns a 404", func() {
+				response := makeGetBindingRequest(getBindingInstanceID, getBindingBindingID)
+				Expect(response.StatusCode).To(Equal(404))
+			})
+
+			It("logs an appropriate error", func() {
+				makeGetBindingRequest(getBindingInstanceID, getBindingBindingID)
+				Expect(lastLogLine().Message).To(ContainSubstring("get-binding.binding-missing"))
+			})
+		})
+	})
+
+	Describe("last binding operation", func() {
+		var lastBindingOperationInstanceID string
+		var lastBindingOperationBindingID string
+
+		makeLastBindingOperationRequest := func(instanceID string, bindingID string) *testflight.Response {
+			response := &testflight.Response{}
+			testflight.WithServer(brokerAPI, func(r *testflight.Requester) {
+				path := fmt.Sprintf("/v2/service_instances/%s/service_bindings/%s/last_operation?operation=bind", instanceID, bindingID)
+
+				request, err := http.NewRequest("GET", path, nil)
+				Expect(err).NotTo(HaveOccurred())
+				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
+
+				response = r.Do(request)
+			})
+			return response
+		}
+
+		BeforeEach(func() {
+			lastBindingOperationInstanceID = uniqueInstanceID()
+			lastBindingOperationBindingID = uniqueBindingID()
+
+			fakeServiceBroker.LastBindingOperationResponse = LastOperationResponse{
+				State: "succeeded",
+			}
+			fakeServiceBroker.LastBindingOperationError = nil
+		})
+
+		It("calls LastBindingOperation on the service broker with the instance and binding ids and the poll details", func() {
+			makeLastBindingOperationRequest(lastBindingOperationInstanceID, lastBindingOperationBindingID)
+			Expect(fakeServiceBroker.LastBindingOperationInstanceID).To(Equal(lastBindingOperationInstanceID))
+			Expect(fakeServiceBroker.LastBindingOperationBindingID).To(Equal(lastBindingOperationBindingID))
+			Expect(fakeServiceBroker.LastBindingOperationDetails).To(Equal(PollDetails{OperationData: "bind"}))
+		})
+
+		It("returns proper json", func() {
+			response := makeLastBindingOperationRequest(lastBindingOperationInstanceID, lastBindingOperationBindingID)
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(response.Body).To(MatchJSON(fixture("last_operation.json")))
+		})
+
+		Context("when the binding does not exists", func() {
+			BeforeEach(func() {
+				fakeServiceBroker.LastBindingOperationError = ErrBindingDoesNotExist
+			})
+
+			It("returns a 410", func() {
+				response := makeLastBindingOperationRequest(lastBindingOperationInstanceID, lastBindingOperationBindingID)
+				Expect(response.StatusCode).To(Equal(410))
+			})
+		})
+	})
+
 	Describe("last operation", func() {
 		var lastOperationInstanceID string
 
 		makeLastOperationRequest := func(instanceID string) *testflight.Response {
 			response := &testflight.Response{}
 			testflight.WithServer(brokerAPI, func(r *testflight.Requester) {
-				path := fmt.Sprintf("/v2/service_instances/%s/last_operation", instanceID)
+				path := fmt.Sprintf("/v2/service_instances/%s/last_operation?service_id=service-id&plan_id=plan-id&operation=update", instanceID)
 
 				request, err := http.NewRequest("GET", path, strings.NewReader(""))
 				Expect(err).NotTo(HaveOccurred())
 				request.Header.Add("Content-Type", "application/json")
 				request.SetBasicAuth(credentials.Username, credentials.Password)
+				request.Header.Add("X-Broker-API-Version", apiVersion)
 
 				response = r.Do(request)
 			})
@@ -1079,6 +1542,15 @@ var _ = Describe("Service Broker API", func() {
 			Expect(fakeServiceBroker.LastOperationInstanceID).To(Equal(lastOperationInstanceID))
 		})
 
+		It("calls LastOperation on the service broker with the poll details", func() {
+			makeLastOperationRequest(lastOperationInstanceID)
+			Expect(fakeServiceBroker.LastOperationDetails).To(Equal(PollDetails{
+				ServiceID:     "service-id",
+				PlanID:        "plan-id",
+				OperationData: "update",
+			}))
+		})
+
 		It("returns a 200", func() {
 			response := makeLastOperationRequest(lastOperationInstanceID)
 			Expect(response.StatusCode).To(Equal(200))
@@ -7,7 +7,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
-	"github.com/frodenas/brokerapi/auth"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi/auth"
 )
 
 var _ = Describe("Auth Wrapper", func() {
@@ -37,6 +37,25 @@ type ServicePlan struct {
 	Description string               `json:"description"`
 	Metadata    *ServicePlanMetadata `json:"metadata,omitempty"`
 	Free        bool                 `json:"free"`
+	Schemas     *ServiceSchemas      `json:"schemas,omitempty"`
+}
+
+type ServiceSchemas struct {
+	Instance ServiceInstanceSchema `json:"service_instance,omitempty"`
+	Binding  ServiceBindingSchema  `json:"service_binding,omitempty"`
+}
+
+type ServiceInstanceSchema struct {
+	Create Schema `json:"create,omitempty"`
+	Update Schema `json:"update,omitempty"`
+}
+
+type ServiceBindingSchema struct {
+	Create Schema `json:"create,omitempty"`
+}
+
+type Schema struct {
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
 }
 
 type ServicePlanMetadata struct {
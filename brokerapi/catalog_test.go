@@ -1,11 +1,11 @@
 package brokerapi_test
 
 import (
-	. "github.com/frodenas/brokerapi"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
-	"github.com/frodenas/brokerapi/matchers"
+	. "github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi/matchers"
 )
 
 var _ = Describe("Catalog", func() {
@@ -0,0 +1,24 @@
+package brokerapi
+
+// FailureResponse is an error that is returned to the client with a specific HTTP status code
+type FailureResponse struct {
+	error
+	statusCode   int
+	loggerAction string
+}
+
+func NewFailureResponse(err error, statusCode int, loggerAction string) *FailureResponse {
+	return &FailureResponse{
+		error:        err,
+		statusCode:   statusCode,
+		loggerAction: loggerAction,
+	}
+}
+
+func (f *FailureResponse) StatusCode() int {
+	return f.statusCode
+}
+
+func (f *FailureResponse) LoggerAction() string {
+	return f.loggerAction
+}
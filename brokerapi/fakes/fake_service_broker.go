@@ -1,6 +1,6 @@
 package fakes
 
-import "github.com/frodenas/brokerapi"
+import "github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
 
 type FakeServiceBroker struct {
 	BrokerCalled bool
@@ -15,36 +15,59 @@ type FakeServiceBroker struct {
 	UpdateInstanceID        string
 	UpdateDetails           brokerapi.UpdateDetails
 	UpdateAcceptsIncomplete bool
+	UpdateResponse          brokerapi.UpdateResponse
 	UpdateAsynch            bool
 	UpdateError             error
 
 	DeprovisionInstanceID        string
 	DeprovisionDetails           brokerapi.DeprovisionDetails
 	DeprovisionAcceptsIncomplete bool
+	DeprovisionResponse          brokerapi.DeprovisionResponse
 	DeprovisionAsynch            bool
 	DeprovisionError             error
 
-	BindInstanceID string
-	BindBindingID  string
-	BindDetails    brokerapi.BindDetails
-	BindResponse   brokerapi.BindingResponse
-	BindError      error
-
-	UnbindInstanceID string
-	UnbindBindingID  string
-	UnbindDetails    brokerapi.UnbindDetails
-	UnbindError      error
+	GetInstanceInstanceID string
+	GetInstanceResponse   brokerapi.GetInstanceResponse
+	GetInstanceError      error
+
+	BindInstanceID        string
+	BindBindingID         string
+	BindDetails           brokerapi.BindDetails
+	BindAcceptsIncomplete bool
+	BindResponse          brokerapi.BindingResponse
+	BindAsynch            bool
+	BindError             error
+
+	UnbindInstanceID        string
+	UnbindBindingID         string
+	UnbindDetails           brokerapi.UnbindDetails
+	UnbindAcceptsIncomplete bool
+	UnbindResponse          brokerapi.UnbindResponse
+	UnbindAsynch            bool
+	UnbindError             error
+
+	GetBindingInstanceID string
+	GetBindingBindingID  string
+	GetBindingResponse   brokerapi.GetBindingResponse
+	GetBindingError      error
 
 	LastOperationInstanceID string
+	LastOperationDetails    brokerapi.PollDetails
 	LastOperationResponse   brokerapi.LastOperationResponse
 	LastOperationError      error
+
+	LastBindingOperationInstanceID string
+	LastBindingOperationBindingID  string
+	LastBindingOperationDetails    brokerapi.PollDetails
+	LastBindingOperationResponse   brokerapi.LastOperationResponse
+	LastBindingOperationError      error
 }
 
 func (fakeBroker *FakeServiceBroker) Services() brokerapi.CatalogResponse {
 	fakeBroker.BrokerCalled = true
 
 	return brokerapi.CatalogResponse{
-		[]brokerapi.Service{
+		Services: []brokerapi.Service{
 			brokerapi.Service{
 				ID:          "0A789746-596F-4CEA-BFAC-A0795DA056E3",
 				Name:        "p-cassandra",
@@ -100,45 +123,72 @@ func (fakeBroker *FakeServiceBroker) Provision(instanceID string, details broker
 	return fakeBroker.ProvisionResponse, fakeBroker.ProvisionAsynch, fakeBroker.ProvisionError
 }
 
-func (fakeBroker *FakeServiceBroker) Update(instanceID string, details brokerapi.UpdateDetails, acceptsIncomplete bool) (bool, error) {
+func (fakeBroker *FakeServiceBroker) Update(instanceID string, details brokerapi.UpdateDetails, acceptsIncomplete bool) (brokerapi.UpdateResponse, bool, error) {
 	fakeBroker.BrokerCalled = true
 	fakeBroker.UpdateInstanceID = instanceID
 	fakeBroker.UpdateDetails = details
 	fakeBroker.UpdateAcceptsIncomplete = acceptsIncomplete
 
-	return fakeBroker.UpdateAsynch, fakeBroker.UpdateError
+	return fakeBroker.UpdateResponse, fakeBroker.UpdateAsynch, fakeBroker.UpdateError
 }
 
-func (fakeBroker *FakeServiceBroker) Deprovision(instanceID string, details brokerapi.DeprovisionDetails, acceptsIncomplete bool) (bool, error) {
+func (fakeBroker *FakeServiceBroker) Deprovision(instanceID string, details brokerapi.DeprovisionDetails, acceptsIncomplete bool) (brokerapi.DeprovisionResponse, bool, error) {
 	fakeBroker.BrokerCalled = true
 	fakeBroker.DeprovisionInstanceID = instanceID
 	fakeBroker.DeprovisionDetails = details
 	fakeBroker.DeprovisionAcceptsIncomplete = acceptsIncomplete
 
-	return fakeBroker.DeprovisionAsynch, fakeBroker.DeprovisionError
+	return fakeBroker.DeprovisionResponse, fakeBroker.DeprovisionAsynch, fakeBroker.DeprovisionError
 }
 
-func (fakeBroker *FakeServiceBroker) Bind(instanceID string, bindingID string, details brokerapi.BindDetails) (brokerapi.BindingResponse, error) {
+func (fakeBroker *FakeServiceBroker) GetInstance(instanceID string) (brokerapi.GetInstanceResponse, error) {
+	fakeBroker.BrokerCalled = true
+	fakeBroker.GetInstanceInstanceID = instanceID
+
+	return fakeBroker.GetInstanceResponse, fakeBroker.GetInstanceError
+}
+
+func (fakeBroker *FakeServiceBroker) Bind(instanceID string, bindingID string, details brokerapi.BindDetails, acceptsIncomplete bool) (brokerapi.BindingResponse, bool, error) {
 	fakeBroker.BrokerCalled = true
 	fakeBroker.BindInstanceID = instanceID
 	fakeBroker.BindBindingID = bindingID
 	fakeBroker.BindDetails = details
+	fakeBroker.BindAcceptsIncomplete = acceptsIncomplete
 
-	return fakeBroker.BindResponse, fakeBroker.BindError
+	return fakeBroker.BindResponse, fakeBroker.BindAsynch, fakeBroker.BindError
 }
 
-func (fakeBroker *FakeServiceBroker) Unbind(instanceID string, bindingID string, details brokerapi.UnbindDetails) error {
+func (fakeBroker *FakeServiceBroker) Unbind(instanceID string, bindingID string, details brokerapi.UnbindDetails, acceptsIncomplete bool) (brokerapi.UnbindResponse, bool, error) {
 	fakeBroker.BrokerCalled = true
 	fakeBroker.UnbindInstanceID = instanceID
 	fakeBroker.UnbindBindingID = bindingID
 	fakeBroker.UnbindDetails = details
+	fakeBroker.UnbindAcceptsIncomplete = acceptsIncomplete
+
+	return fakeBroker.UnbindResponse, fakeBroker.UnbindAsynch, fakeBroker.UnbindError
+}
+
+func (fakeBroker *FakeServiceBroker) GetBinding(instanceID string, bindingID string) (brokerapi.GetBindingResponse, error) {
+	fakeBroker.BrokerCalled = true
+	fakeBroker.GetBindingInstanceID = instanceID
+	fakeBroker.GetBindingBindingID = bindingID
 
-	return fakeBroker.UnbindError
+	return fakeBroker.GetBindingResponse, fakeBroker.GetBindingError
 }
 
-func (fakeBroker *FakeServiceBroker) LastOperation(instanceID string) (brokerapi.LastOperationResponse, error) {
+func (fakeBroker *FakeServiceBroker) LastOperation(instanceID string, details brokerapi.PollDetails) (brokerapi.LastOperationResponse, error) {
 	fakeBroker.BrokerCalled = true
 	fakeBroker.LastOperationInstanceID = instanceID
+	fakeBroker.LastOperationDetails = details
 
 	return fakeBroker.LastOperationResponse, fakeBroker.LastOperationError
 }
+
+func (fakeBroker *FakeServiceBroker) LastBindingOperation(instanceID string, bindingID string, details brokerapi.PollDetails) (brokerapi.LastOperationResponse, error) {
+	fakeBroker.BrokerCalled = true
+	fakeBroker.LastBindingOperationInstanceID = instanceID
+	fakeBroker.LastBindingOperationBindingID = bindingID
+	fakeBroker.LastBindingOperationDetails = details
+
+	return fakeBroker.LastBindingOperationResponse, fakeBroker.LastBindingOperationError
+}
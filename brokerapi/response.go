@@ -12,12 +12,40 @@ type CatalogResponse struct {
 }
 
 type ProvisioningResponse struct {
-	DashboardURL string `json:"dashboard_url,omitempty"`
+	DashboardURL  string `json:"dashboard_url,omitempty"`
+	OperationData string `json:"operation,omitempty"`
+}
+
+type UpdateResponse struct {
+	DashboardURL  string `json:"dashboard_url,omitempty"`
+	OperationData string `json:"operation,omitempty"`
+}
+
+type DeprovisionResponse struct {
+	OperationData string `json:"operation,omitempty"`
+}
+
+type GetInstanceResponse struct {
+	ServiceID    string                 `json:"service_id"`
+	PlanID       string                 `json:"plan_id"`
+	DashboardURL string                 `json:"dashboard_url,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
 }
 
 type BindingResponse struct {
 	Credentials    interface{} `json:"credentials"`
 	SyslogDrainURL string      `json:"syslog_drain_url,omitempty"`
+	OperationData  string      `json:"operation,omitempty"`
+}
+
+type UnbindResponse struct {
+	OperationData string `json:"operation,omitempty"`
+}
+
+type GetBindingResponse struct {
+	Credentials    interface{}            `json:"credentials"`
+	SyslogDrainURL string                 `json:"syslog_drain_url,omitempty"`
+	Parameters     map[string]interface{} `json:"parameters,omitempty"`
 }
 
 type CredentialsHash struct {
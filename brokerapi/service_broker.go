@@ -6,13 +6,16 @@ type ServiceBroker interface {
 	Services() CatalogResponse
 
 	Provision(instanceID string, details ProvisionDetails, acceptsIncomplete bool) (ProvisioningResponse, bool, error)
-	Update(instanceID string, details UpdateDetails, acceptsIncomplete bool) (bool, error)
-	Deprovision(instanceID string, details DeprovisionDetails, acceptsIncomplete bool) (bool, error)
+	Update(instanceID string, details UpdateDetails, acceptsIncomplete bool) (UpdateResponse, bool, error)
+	Deprovision(instanceID string, details DeprovisionDetails, acceptsIncomplete bool) (DeprovisionResponse, bool, error)
+	GetInstance(instanceID string) (GetInstanceResponse, error)
 
-	Bind(instanceID string, bindingID string, details BindDetails) (BindingResponse, error)
-	Unbind(instanceID string, bindingID string, details UnbindDetails) error
+	Bind(instanceID string, bindingID string, details BindDetails, acceptsIncomplete bool) (BindingResponse, bool, error)
+	Unbind(instanceID string, bindingID string, details UnbindDetails, acceptsIncomplete bool) (UnbindResponse, bool, error)
+	GetBinding(instanceID string, bindingID string) (GetBindingResponse, error)
 
-	LastOperation(instanceID string) (LastOperationResponse, error)
+	LastOperation(instanceID string, details PollDetails) (LastOperationResponse, error)
+	LastBindingOperation(instanceID string, bindingID string, details PollDetails) (LastOperationResponse, error)
 }
 
 type ProvisionDetails struct {
@@ -21,6 +24,7 @@ type ProvisionDetails struct {
 	ServiceID        string                 `json:"service_id"`
 	SpaceGUID        string                 `json:"space_guid"`
 	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+	Context          map[string]interface{} `json:"context,omitempty"`
 }
 
 type UpdateDetails struct {
@@ -28,6 +32,7 @@ type UpdateDetails struct {
 	PlanID         string                 `json:"plan_id"`
 	Parameters     map[string]interface{} `json:"parameters"`
 	PreviousValues PreviousValues         `json:"previous_values"`
+	Context        map[string]interface{} `json:"context,omitempty"`
 }
 
 type PreviousValues struct {
@@ -47,6 +52,7 @@ type BindDetails struct {
 	PlanID     string                 `json:"plan_id"`
 	AppGUID    string                 `json:"app_guid,omitempty"`
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Context    map[string]interface{} `json:"context,omitempty"`
 }
 
 type UnbindDetails struct {
@@ -54,6 +60,13 @@ type UnbindDetails struct {
 	PlanID    string `json:"plan_id"`
 }
 
+// PollDetails are the query parameters of last operation requests
+type PollDetails struct {
+	ServiceID     string `json:"service_id"`
+	PlanID        string `json:"plan_id"`
+	OperationData string `json:"operation"`
+}
+
 var (
 	ErrInstanceAlreadyExists = errors.New("instance already exists")
 	ErrInstanceDoesNotExist  = errors.New("instance does not exist")
@@ -62,6 +75,7 @@ var (
 	ErrInstanceNotBindable   = errors.New("instance is not bindable")
 	ErrBindingAlreadyExists  = errors.New("binding already exists")
 	ErrBindingDoesNotExist   = errors.New("binding does not exist")
+	ErrConcurrentAccess      = errors.New("Another operation for this service instance is in progress.")
 	ErrAsyncRequired         = errors.New("This service plan requires client support for asynchronous service operations.")
 	ErrAppGUIDRequired       = errors.New("This service supports generation of credentials through binding an application only.")
 )
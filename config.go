@@ -7,14 +7,20 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/cloudfoundry-community/pe-rds-broker/encryption"
 	"github.com/cloudfoundry-community/pe-rds-broker/rdsbroker"
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
 )
 
 type Config struct {
-	LogLevel  string           `json:"log_level"`
-	Username  string           `json:"username"`
-	Password  string           `json:"password"`
-	RDSConfig rdsbroker.Config `json:"rds_config"`
+	LogLevel      string            `json:"log_level"`
+	Username      string            `json:"username"`
+	Password      string            `json:"password"`
+	AdminUsername string            `json:"admin_username"`
+	AdminPassword string            `json:"admin_password"`
+	StateStore    statestore.Config `json:"state_store"`
+	Encryption    encryption.Config `json:"encryption"`
+	RDSConfig     rdsbroker.Config  `json:"rds_config"`
 }
 
 func LoadConfig(configFile string) (config *Config, err error) {
@@ -57,9 +63,30 @@ func (c Config) Validate() error {
 		return errors.New("Must provide a non-empty Password")
 	}
 
+	if (c.AdminUsername == "") != (c.AdminPassword == "") {
+		return errors.New("Must provide both AdminUsername and AdminPassword, or neither to disable the Admin API")
+	}
+
+	if c.AdminUsername == c.Username && c.AdminPassword == c.Password {
+		return errors.New("Must provide AdminUsername and AdminPassword different from the Service Broker API credentials")
+	}
+
+	if err := c.StateStore.Validate(); err != nil {
+		return fmt.Errorf("Validating State Store configuration: %s", err)
+	}
+
+	if err := c.Encryption.Validate(); err != nil {
+		return fmt.Errorf("Validating Encryption configuration: %s", err)
+	}
+
 	if err := c.RDSConfig.Validate(); err != nil {
 		return fmt.Errorf("Validating RDS configuration: %s", err)
 	}
 
 	return nil
 }
+
+// AdminAPIEnabled reports whether the Admin API has its own credentials, as it is not served otherwise
+func (c Config) AdminAPIEnabled() bool {
+	return c.AdminUsername != "" && c.AdminPassword != ""
+}
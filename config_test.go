@@ -6,7 +6,9 @@ import (
 
 	. "github.com/cloudfoundry-community/pe-rds-broker"
 
+	"github.com/cloudfoundry-community/pe-rds-broker/encryption"
 	"github.com/cloudfoundry-community/pe-rds-broker/rdsbroker"
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
 )
 
 var _ = Describe("Config", func() {
@@ -14,9 +16,19 @@ var _ = Describe("Config", func() {
 		config Config
 
 		validConfig = Config{
-			LogLevel: "DEBUG",
-			Username: "broker-username",
-			Password: "broker-password",
+			LogLevel:      "DEBUG",
+			Username:      "broker-username",
+			Password:      "broker-password",
+			AdminUsername: "admin-username",
+			AdminPassword: "admin-password",
+			StateStore: statestore.Config{
+				Type: "file",
+				Path: "rds-broker-state.json",
+			},
+			Encryption: encryption.Config{
+				Type:    "local",
+				KeyFile: "rds-broker.key",
+			},
 			RDSConfig: rdsbroker.Config{
 				Region:   "rds-region",
 				DBPrefix: "cf",
@@ -58,6 +70,48 @@ var _ = Describe("Config", func() {
 			Expect(err.Error()).To(ContainSubstring("Must provide a non-empty Password"))
 		})
 
+		It("returns error if only one of the Admin API credentials is provided", func() {
+			config.AdminPassword = ""
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Must provide both AdminUsername and AdminPassword"))
+		})
+
+		It("returns error if the Admin API credentials are the Service Broker API credentials", func() {
+			config.AdminUsername = config.Username
+			config.AdminPassword = config.Password
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Must provide AdminUsername and AdminPassword different from the Service Broker API credentials"))
+		})
+
+		It("does not return error if the Admin API credentials are not provided", func() {
+			config.AdminUsername = ""
+			config.AdminPassword = ""
+
+			err := config.Validate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AdminAPIEnabled()).To(BeFalse())
+		})
+
+		It("returns error if State Store configuration is not valid", func() {
+			config.StateStore = statestore.Config{}
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Validating State Store configuration"))
+		})
+
+		It("returns error if Encryption configuration is not valid", func() {
+			config.Encryption = encryption.Config{}
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Validating Encryption configuration"))
+		})
+
 		It("returns error if RDS configuration is not valid", func() {
 			config.RDSConfig = rdsbroker.Config{}
 
@@ -0,0 +1,41 @@
+package encryption
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pivotal-golang/lager"
+)
+
+type Config struct {
+	Type    string `json:"type"`
+	KeyFile string `json:"key_file,omitempty"`
+}
+
+func (c Config) Validate() error {
+	switch strings.ToLower(c.Type) {
+	case "local":
+		if c.KeyFile == "" {
+			return errors.New("Must provide a non-empty KeyFile")
+		}
+	default:
+		return fmt.Errorf("This broker does not support encryption type '%s'", c.Type)
+	}
+
+	return nil
+}
+
+func New(config Config, logger lager.Logger) (Encryptor, error) {
+	switch strings.ToLower(config.Type) {
+	case "local":
+		keyProvider, err := NewLocalKeyProvider(config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		logger.Debug("encryption", lager.Data{"type": config.Type, "key-file": config.KeyFile})
+		return NewEnvelopeEncryptor(keyProvider), nil
+	}
+
+	return nil, fmt.Errorf("Encryption type '%s' not supported", config.Type)
+}
@@ -0,0 +1,44 @@
+package encryption_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/encryption"
+)
+
+var _ = Describe("Config", func() {
+	var (
+		config Config
+	)
+
+	BeforeEach(func() {
+		config = Config{
+			Type:    "local",
+			KeyFile: "/tmp/rds-broker.key",
+		}
+	})
+
+	Describe("Validate", func() {
+		It("does not return error if all sections are valid", func() {
+			err := config.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if KeyFile is not valid", func() {
+			config.KeyFile = ""
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Must provide a non-empty KeyFile"))
+		})
+
+		It("returns error if Type is not supported", func() {
+			config.Type = "unknown"
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support encryption type 'unknown'"))
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+)
+
+const envelopeVersion = "v1"
+
+type Encryptor interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+type KeyProvider interface {
+	GenerateDataKey() (plaintextKey []byte, encryptedKey []byte, err error)
+	DecryptDataKey(encryptedKey []byte) ([]byte, error)
+}
+
+var (
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+)
+
+type EnvelopeEncryptor struct {
+	keyProvider KeyProvider
+}
+
+func NewEnvelopeEncryptor(keyProvider KeyProvider) *EnvelopeEncryptor {
+	return &EnvelopeEncryptor{keyProvider: keyProvider}
+}
+
+func (e *EnvelopeEncryptor) Encrypt(plaintext string) (string, error) {
+	dataKey, encryptedDataKey, err := e.keyProvider.GenerateDataKey()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		envelopeVersion,
+		base64.StdEncoding.EncodeToString(encryptedDataKey),
+		base64.StdEncoding.EncodeToString(sealed),
+	}, ":"), nil
+}
+
+func (e *EnvelopeEncryptor) Decrypt(ciphertext string) (string, error) {
+	parts := strings.Split(ciphertext, ":")
+	if len(parts) != 3 || parts[0] != envelopeVersion {
+		return "", ErrInvalidCiphertext
+	}
+
+	encryptedDataKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	dataKey, err := e.keyProvider.DecryptDataKey(encryptedDataKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dataKey, sealed)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func seal(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key []byte, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
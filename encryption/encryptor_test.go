@@ -0,0 +1,109 @@
+package encryption_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/encryption"
+)
+
+var _ = Describe("Envelope Encryptor", func() {
+	var (
+		tmpDir  string
+		keyFile string
+
+		keyProvider *LocalKeyProvider
+		encryptor   *EnvelopeEncryptor
+	)
+
+	writeKey := func(key []byte) {
+		err := ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "encryption-test")
+		Expect(err).ToNot(HaveOccurred())
+		keyFile = filepath.Join(tmpDir, "master.key")
+		writeKey([]byte("0123456789abcdef0123456789abcdef"))
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		keyProvider, err = NewLocalKeyProvider(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		encryptor = NewEnvelopeEncryptor(keyProvider)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("encrypts and decrypts a secret", func() {
+		ciphertext, err := encryptor.Encrypt("my-secret-password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ciphertext).ToNot(ContainSubstring("my-secret-password"))
+
+		plaintext, err := encryptor.Decrypt(ciphertext)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plaintext).To(Equal("my-secret-password"))
+	})
+
+	It("uses a different data key for every secret", func() {
+		ciphertext1, err := encryptor.Encrypt("my-secret-password")
+		Expect(err).ToNot(HaveOccurred())
+		ciphertext2, err := encryptor.Encrypt("my-secret-password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ciphertext1).ToNot(Equal(ciphertext2))
+		Expect(strings.Split(ciphertext1, ":")[1]).ToNot(Equal(strings.Split(ciphertext2, ":")[1]))
+	})
+
+	It("returns error when the ciphertext is not valid", func() {
+		_, err := encryptor.Decrypt("invalid")
+		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(ErrInvalidCiphertext))
+	})
+
+	It("returns error when the ciphertext has been tampered", func() {
+		ciphertext, err := encryptor.Encrypt("my-secret-password")
+		Expect(err).ToNot(HaveOccurred())
+
+		parts := strings.Split(ciphertext, ":")
+		sealed, err := base64.StdEncoding.DecodeString(parts[2])
+		Expect(err).ToNot(HaveOccurred())
+		sealed[len(sealed)-1] ^= 0xff
+		parts[2] = base64.StdEncoding.EncodeToString(sealed)
+
+		_, err = encryptor.Decrypt(strings.Join(parts, ":"))
+		Expect(err).To(Equal(ErrInvalidCiphertext))
+	})
+
+	It("returns error when decrypting with a different master key", func() {
+		ciphertext, err := encryptor.Encrypt("my-secret-password")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeKey([]byte("fedcba9876543210fedcba9876543210"))
+		otherKeyProvider, err := NewLocalKeyProvider(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = NewEnvelopeEncryptor(otherKeyProvider).Decrypt(ciphertext)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("when the key file does not contain a valid key", func() {
+		It("returns the proper error", func() {
+			writeKey([]byte("short-key"))
+
+			_, err := NewLocalKeyProvider(keyFile)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must contain a 16, 24 or 32 bytes key"))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package fakes
+
+import (
+	"errors"
+	"strings"
+)
+
+const fakeCiphertextPrefix = "encrypted:"
+
+type FakeEncryptor struct {
+	EncryptCalled    bool
+	EncryptPlaintext string
+	EncryptError     error
+
+	DecryptCalled     bool
+	DecryptCiphertext string
+	DecryptError      error
+}
+
+func (f *FakeEncryptor) Encrypt(plaintext string) (string, error) {
+	f.EncryptCalled = true
+	f.EncryptPlaintext = plaintext
+
+	if f.EncryptError != nil {
+		return "", f.EncryptError
+	}
+
+	return fakeCiphertextPrefix + plaintext, nil
+}
+
+func (f *FakeEncryptor) Decrypt(ciphertext string) (string, error) {
+	f.DecryptCalled = true
+	f.DecryptCiphertext = ciphertext
+
+	if f.DecryptError != nil {
+		return "", f.DecryptError
+	}
+
+	if !strings.HasPrefix(ciphertext, fakeCiphertextPrefix) {
+		return "", errors.New("invalid ciphertext")
+	}
+
+	return strings.TrimPrefix(ciphertext, fakeCiphertextPrefix), nil
+}
@@ -0,0 +1,54 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+const dataKeyLength = 32
+
+type LocalKeyProvider struct {
+	masterKey []byte
+}
+
+func NewLocalKeyProvider(keyFile string) (*LocalKeyProvider, error) {
+	contents, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	masterKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, fmt.Errorf("Key file '%s' must contain a base64 encoded key: %s", keyFile, err)
+	}
+
+	switch len(masterKey) {
+	case 16, 24, 32:
+	default:
+		return nil, fmt.Errorf("Key file '%s' must contain a 16, 24 or 32 bytes key", keyFile)
+	}
+
+	return &LocalKeyProvider{masterKey: masterKey}, nil
+}
+
+func (p *LocalKeyProvider) GenerateDataKey() ([]byte, []byte, error) {
+	dataKey := make([]byte, dataKeyLength)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	encryptedDataKey, err := seal(p.masterKey, dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataKey, encryptedDataKey, nil
+}
+
+func (p *LocalKeyProvider) DecryptDataKey(encryptedDataKey []byte) ([]byte, error) {
+	return open(p.masterKey, encryptedDataKey)
+}
@@ -7,17 +7,26 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/frodenas/brokerapi"
 	"github.com/pivotal-golang/lager"
 
+	"github.com/cloudfoundry-community/pe-rds-broker/adminapi"
 	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	"github.com/cloudfoundry-community/pe-rds-broker/encryption"
 	"github.com/cloudfoundry-community/pe-rds-broker/rdsbroker"
 	"github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+)
+
+const (
+	credentialsRotationInterval = time.Hour
+	operationsInterval          = 30 * time.Second
 )
 
 var (
@@ -66,10 +75,21 @@ func main() {
 	rdssvc := rds.New(awsSession)
 	dbInstance := awsrds.NewRDSDBInstance(config.RDSConfig.Region, iamsvc, rdssvc, logger)
 	dbCluster := awsrds.NewRDSDBCluster(config.RDSConfig.Region, iamsvc, rdssvc, logger)
+	dbEvents := awsrds.NewRDSDBEvents(rdssvc, logger)
 
 	sqlProvider := sqlengine.NewProviderService(logger)
 
-	serviceBroker := rdsbroker.New(config.RDSConfig, dbInstance, dbCluster, sqlProvider, logger)
+	stateStore, err := statestore.New(config.StateStore, logger)
+	if err != nil {
+		log.Fatalf("Error opening state store: %s", err)
+	}
+
+	encryptor, err := encryption.New(config.Encryption, logger)
+	if err != nil {
+		log.Fatalf("Error loading encryption key: %s", err)
+	}
+
+	serviceBroker := rdsbroker.New(config.RDSConfig, dbInstance, dbCluster, dbEvents, sqlProvider, stateStore, encryptor, logger)
 
 	credentials := brokerapi.BrokerCredentials{
 		Username: config.Username,
@@ -79,6 +99,30 @@ func main() {
 	brokerAPI := brokerapi.New(serviceBroker, logger, credentials)
 	http.Handle("/", brokerAPI)
 
+	if config.AdminAPIEnabled() {
+		adminCredentials := brokerapi.BrokerCredentials{
+			Username: config.AdminUsername,
+			Password: config.AdminPassword,
+		}
+
+		adminAPI := adminapi.New(serviceBroker, logger, adminCredentials)
+		http.Handle("/admin/", adminAPI)
+	} else {
+		logger.Info("admin-api-disabled")
+	}
+
+	go func() {
+		for range time.Tick(credentialsRotationInterval) {
+			serviceBroker.RotateExpiredBindingCredentials()
+		}
+	}()
+
+	go func() {
+		for range time.Tick(operationsInterval) {
+			serviceBroker.RunOperations()
+		}
+	}()
+
 	fmt.Println("RDS Service Broker started on port " + port + "...")
 	http.ListenAndServe(":"+port, nil)
 }
@@ -0,0 +1,193 @@
+package operation
+
+import (
+	"fmt"
+
+	"github.com/pivotal-golang/lager"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+)
+
+const (
+	StateInProgress = "in progress"
+	StateSucceeded  = "succeeded"
+	StateFailed     = "failed"
+)
+
+type Step struct {
+	Name        string
+	Description string
+	// Wait steps poll AWS until a resource reaches the expected status, so they are not run when starting an operation
+	Wait bool
+	// Run performs the step and returns whether it is done or must be run again later
+	Run func() (bool, error)
+	// Compensate undoes the step when the operation fails (optional)
+	Compensate func() error
+	// ReadyToCompensate polls AWS until the step can be compensated, once the steps after it are (optional). The
+	// operation stays in progress while it is not ready, and the rollback is resumed like a Wait step
+	ReadyToCompensate func() (bool, error)
+}
+
+type SaveFunc func(operationDetails statestore.OperationDetails) error
+
+type Runner struct {
+	maxAttempts      int
+	isTransientError func(err error) bool
+	logger           lager.Logger
+}
+
+func NewRunner(maxAttempts int, isTransientError func(err error) bool, logger lager.Logger) *Runner {
+	return &Runner{
+		maxAttempts:      maxAttempts,
+		isTransientError: isTransientError,
+		logger:           logger.Session("operation-runner"),
+	}
+}
+
+// Start runs the steps of a new operation until the first Wait step
+func (r *Runner) Start(operationDetails statestore.OperationDetails, steps []Step, save SaveFunc) (statestore.OperationDetails, error) {
+	operationDetails.State = StateInProgress
+	operationDetails.Step = ""
+	operationDetails.Attempts = 0
+
+	return r.run(operationDetails, steps, save, false)
+}
+
+// Resume runs the remaining steps of an operation in progress, or the remaining compensations of an operation rolling back
+func (r *Runner) Resume(operationDetails statestore.OperationDetails, steps []Step, save SaveFunc) (statestore.OperationDetails, error) {
+	if operationDetails.State != StateInProgress {
+		return operationDetails, nil
+	}
+
+	if operationDetails.RollbackStep != "" {
+		index, err := stepIndex(steps, operationDetails.RollbackStep)
+		if err != nil {
+			// The compensations cannot be resumed, so the operation is failed as it is
+			operationDetails.Description = fmt.Sprintf("%s (rolling back failed: %s)", operationDetails.Description, err)
+			index = -1
+		}
+		return r.rollback(operationDetails, steps, index, save), nil
+	}
+
+	return r.run(operationDetails, steps, save, true)
+}
+
+func (r *Runner) run(operationDetails statestore.OperationDetails, steps []Step, save SaveFunc, wait bool) (statestore.OperationDetails, error) {
+	index, err := stepIndex(steps, operationDetails.Step)
+	if err != nil {
+		return r.fail(operationDetails, steps, 0, err, save)
+	}
+
+	for ; index < len(steps); index++ {
+		step := steps[index]
+		if step.Wait && !wait {
+			break
+		}
+
+		if operationDetails.Step != step.Name {
+			operationDetails.Step = step.Name
+			operationDetails.Attempts = 0
+		}
+		operationDetails.Description = step.Description
+
+		r.logger.Debug("run-step", lager.Data{"operation": operationDetails.Type, "step": step.Name, "attempts": operationDetails.Attempts})
+
+		done, err := step.Run()
+		if err != nil {
+			operationDetails.Attempts++
+			if r.isTransientError(err) && operationDetails.Attempts < r.maxAttempts {
+				r.logger.Error("step-transient-error", err, lager.Data{"step": step.Name, "attempts": operationDetails.Attempts})
+				operationDetails.Description = fmt.Sprintf("%s (retrying after error: %s)", step.Description, err)
+				return operationDetails, save(operationDetails)
+			}
+			return r.fail(operationDetails, steps, index, err, save)
+		}
+
+		if !done {
+			return operationDetails, save(operationDetails)
+		}
+	}
+
+	if index == len(steps) {
+		operationDetails.State = StateSucceeded
+		operationDetails.Step = ""
+		operationDetails.Attempts = 0
+		operationDetails.Description = ""
+	} else {
+		operationDetails.Step = steps[index].Name
+		operationDetails.Attempts = 0
+		operationDetails.Description = steps[index].Description
+	}
+
+	return operationDetails, save(operationDetails)
+}
+
+// fail compensates the steps already done in reverse order and returns the step error. The operation stays in
+// progress until they are all compensated
+func (r *Runner) fail(operationDetails statestore.OperationDetails, steps []Step, index int, err error, save SaveFunc) (statestore.OperationDetails, error) {
+	r.logger.Error("step-error", err, lager.Data{"operation": operationDetails.Type, "step": operationDetails.Step})
+
+	if operationDetails.Description != "" {
+		operationDetails.Description = fmt.Sprintf("%s failed: %s", operationDetails.Description, err)
+	} else {
+		operationDetails.Description = err.Error()
+	}
+
+	return r.rollback(operationDetails, steps, index-1, save), err
+}
+
+// rollback compensates the steps from index down to the first one. When a step is not ready to be compensated yet,
+// the operation is saved in progress with the step to resume the rollback from
+func (r *Runner) rollback(operationDetails statestore.OperationDetails, steps []Step, index int, save SaveFunc) statestore.OperationDetails {
+	for i := index; i >= 0; i-- {
+		step := steps[i]
+		if step.Compensate == nil {
+			continue
+		}
+
+		if step.ReadyToCompensate != nil {
+			ready, err := step.ReadyToCompensate()
+			if err != nil && !r.isTransientError(err) {
+				r.logger.Error("compensate-error", err, lager.Data{"step": step.Name})
+				operationDetails.Description = fmt.Sprintf("%s (rolling back step '%s' failed: %s)", operationDetails.Description, step.Name, err)
+				continue
+			}
+			if !ready {
+				operationDetails.State = StateInProgress
+				operationDetails.RollbackStep = step.Name
+				operationDetails.Attempts = 0
+				if saveErr := save(operationDetails); saveErr != nil {
+					r.logger.Error("save-error", saveErr)
+				}
+				return operationDetails
+			}
+		}
+
+		if compensateErr := step.Compensate(); compensateErr != nil {
+			r.logger.Error("compensate-error", compensateErr, lager.Data{"step": step.Name})
+			operationDetails.Description = fmt.Sprintf("%s (rolling back step '%s' failed: %s)", operationDetails.Description, step.Name, compensateErr)
+		}
+	}
+
+	operationDetails.State = StateFailed
+	operationDetails.RollbackStep = ""
+	if saveErr := save(operationDetails); saveErr != nil {
+		r.logger.Error("save-error", saveErr)
+	}
+
+	return operationDetails
+}
+
+func stepIndex(steps []Step, name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+
+	for index, step := range steps {
+		if step.Name == name {
+			return index, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Operation step '%s' not found", name)
+}
@@ -0,0 +1,306 @@
+package operation_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/operation"
+
+	"github.com/pivotal-golang/lager"
+	"github.com/pivotal-golang/lager/lagertest"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		runner *Runner
+
+		calls       []string
+		waitDone    bool
+		waitError   error
+		stepError   error
+		transient   error
+		saved       []statestore.OperationDetails
+		saveError   error
+		steps       []Step
+		operationIn statestore.OperationDetails
+
+		logger lager.Logger
+	)
+
+	BeforeEach(func() {
+		logger = lager.NewLogger("operation_test")
+		logger.RegisterSink(lagertest.NewTestSink())
+
+		transient = errors.New("Throttling: Rate exceeded")
+		runner = NewRunner(3, func(err error) bool { return err == transient }, logger)
+
+		calls = []string{}
+		waitDone = false
+		waitError = nil
+		stepError = nil
+		saved = []statestore.OperationDetails{}
+		saveError = nil
+		operationIn = statestore.OperationDetails{Type: statestore.OperationProvision}
+	})
+
+	JustBeforeEach(func() {
+		steps = []Step{
+			{
+				Name:        "create",
+				Description: "Creating",
+				Run: func() (bool, error) {
+					calls = append(calls, "create")
+					return true, nil
+				},
+				Compensate: func() error {
+					calls = append(calls, "undo-create")
+					return nil
+				},
+			},
+			{
+				Name:        "configure",
+				Description: "Configuring",
+				Run: func() (bool, error) {
+					calls = append(calls, "configure")
+					return true, stepError
+				},
+				Compensate: func() error {
+					calls = append(calls, "undo-configure")
+					return nil
+				},
+			},
+			{
+				Name:        "wait",
+				Description: "Waiting",
+				Wait:        true,
+				Run: func() (bool, error) {
+					calls = append(calls, "wait")
+					return waitDone, waitError
+				},
+			},
+		}
+	})
+
+	save := func(operationDetails statestore.OperationDetails) error {
+		saved = append(saved, operationDetails)
+		return saveError
+	}
+
+	Describe("Start", func() {
+		It("runs the steps until the first Wait step", func() {
+			operationDetails, err := runner.Start(operationIn, steps, save)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"create", "configure"}))
+			Expect(operationDetails.State).To(Equal(StateInProgress))
+			Expect(operationDetails.Step).To(Equal("wait"))
+			Expect(operationDetails.Description).To(Equal("Waiting"))
+			Expect(saved).To(Equal([]statestore.OperationDetails{operationDetails}))
+		})
+
+		Context("when a step fails", func() {
+			BeforeEach(func() {
+				stepError = errors.New("operation failed")
+			})
+
+			It("compensates the steps already done and returns the error", func() {
+				operationDetails, err := runner.Start(operationIn, steps, save)
+				Expect(err).To(Equal(stepError))
+				Expect(calls).To(Equal([]string{"create", "configure", "undo-create"}))
+				Expect(operationDetails.State).To(Equal(StateFailed))
+				Expect(operationDetails.Description).To(Equal("Configuring failed: operation failed"))
+				Expect(saved).To(Equal([]statestore.OperationDetails{operationDetails}))
+			})
+		})
+
+		Context("when a step fails with a transient error", func() {
+			BeforeEach(func() {
+				stepError = transient
+			})
+
+			It("keeps the operation in progress to retry the step", func() {
+				operationDetails, err := runner.Start(operationIn, steps, save)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(calls).To(Equal([]string{"create", "configure"}))
+				Expect(operationDetails.State).To(Equal(StateInProgress))
+				Expect(operationDetails.Step).To(Equal("configure"))
+				Expect(operationDetails.Attempts).To(Equal(1))
+				Expect(operationDetails.Description).To(Equal("Configuring (retrying after error: Throttling: Rate exceeded)"))
+			})
+		})
+
+		Context("when saving the operation fails", func() {
+			BeforeEach(func() {
+				saveError = errors.New("save failed")
+			})
+
+			It("returns the proper error", func() {
+				_, err := runner.Start(operationIn, steps, save)
+				Expect(err).To(Equal(saveError))
+			})
+		})
+	})
+
+	Describe("Resume", func() {
+		BeforeEach(func() {
+			operationIn.State = StateInProgress
+			operationIn.Step = "wait"
+		})
+
+		It("runs the current step", func() {
+			operationDetails, err := runner.Resume(operationIn, steps, save)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"wait"}))
+			Expect(operationDetails.State).To(Equal(StateInProgress))
+			Expect(operationDetails.Step).To(Equal("wait"))
+		})
+
+		Context("when the last step is done", func() {
+			BeforeEach(func() {
+				waitDone = true
+			})
+
+			It("marks the operation as succeeded", func() {
+				operationDetails, err := runner.Resume(operationIn, steps, save)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(operationDetails.State).To(Equal(StateSucceeded))
+				Expect(operationDetails.Step).To(BeEmpty())
+				Expect(saved).To(Equal([]statestore.OperationDetails{operationDetails}))
+			})
+		})
+
+		Context("when a step keeps failing with a transient error", func() {
+			BeforeEach(func() {
+				operationIn.Step = "configure"
+				operationIn.Attempts = 2
+				stepError = transient
+			})
+
+			It("fails the operation once the attempts are exhausted", func() {
+				operationDetails, err := runner.Resume(operationIn, steps, save)
+				Expect(err).To(Equal(transient))
+				Expect(calls).To(Equal([]string{"configure", "undo-create"}))
+				Expect(operationDetails.State).To(Equal(StateFailed))
+				Expect(operationDetails.Attempts).To(Equal(3))
+			})
+		})
+
+		Context("when the current step fails", func() {
+			BeforeEach(func() {
+				waitError = errors.New("operation failed")
+			})
+
+			It("compensates the steps already done and returns the error", func() {
+				operationDetails, err := runner.Resume(operationIn, steps, save)
+				Expect(err).To(Equal(waitError))
+				Expect(calls).To(Equal([]string{"wait", "undo-configure", "undo-create"}))
+				Expect(operationDetails.State).To(Equal(StateFailed))
+				Expect(operationDetails.Description).To(Equal("Waiting failed: operation failed"))
+			})
+		})
+
+		Context("when a step is not ready to be compensated", func() {
+			var (
+				readyToCompensate bool
+				readyError        error
+			)
+
+			BeforeEach(func() {
+				waitError = errors.New("operation failed")
+				readyToCompensate = false
+				readyError = nil
+			})
+
+			JustBeforeEach(func() {
+				steps[0].ReadyToCompensate = func() (bool, error) {
+					calls = append(calls, "ready-create")
+					return readyToCompensate, readyError
+				}
+			})
+
+			It("keeps the operation in progress until it is ready", func() {
+				operationDetails, err := runner.Resume(operationIn, steps, save)
+				Expect(err).To(Equal(waitError))
+				Expect(calls).To(Equal([]string{"wait", "undo-configure", "ready-create"}))
+				Expect(operationDetails.State).To(Equal(StateInProgress))
+				Expect(operationDetails.Step).To(Equal("wait"))
+				Expect(operationDetails.RollbackStep).To(Equal("create"))
+				Expect(operationDetails.Description).To(Equal("Waiting failed: operation failed"))
+				Expect(saved).To(Equal([]statestore.OperationDetails{operationDetails}))
+			})
+
+			Context("and the rollback is resumed once it is ready", func() {
+				BeforeEach(func() {
+					operationIn.RollbackStep = "create"
+					operationIn.Description = "Waiting failed: operation failed"
+					readyToCompensate = true
+				})
+
+				It("compensates the remaining steps without running the current step again", func() {
+					operationDetails, err := runner.Resume(operationIn, steps, save)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(calls).To(Equal([]string{"ready-create", "undo-create"}))
+					Expect(operationDetails.State).To(Equal(StateFailed))
+					Expect(operationDetails.RollbackStep).To(BeEmpty())
+					Expect(operationDetails.Description).To(Equal("Waiting failed: operation failed"))
+				})
+			})
+
+			Context("and checking whether it is ready fails with a transient error", func() {
+				BeforeEach(func() {
+					readyError = transient
+				})
+
+				It("keeps the operation in progress to check again", func() {
+					operationDetails, _ := runner.Resume(operationIn, steps, save)
+					Expect(operationDetails.State).To(Equal(StateInProgress))
+					Expect(operationDetails.RollbackStep).To(Equal("create"))
+				})
+			})
+
+			Context("and checking whether it is ready fails", func() {
+				BeforeEach(func() {
+					readyError = errors.New("not ready")
+				})
+
+				It("fails the operation without compensating the step", func() {
+					operationDetails, _ := runner.Resume(operationIn, steps, save)
+					Expect(calls).To(Equal([]string{"wait", "undo-configure", "ready-create"}))
+					Expect(operationDetails.State).To(Equal(StateFailed))
+					Expect(operationDetails.Description).To(Equal("Waiting failed: operation failed (rolling back step 'create' failed: not ready)"))
+				})
+			})
+		})
+
+		Context("when the current step is unknown", func() {
+			BeforeEach(func() {
+				operationIn.Step = "unknown"
+			})
+
+			It("fails the operation without compensating", func() {
+				operationDetails, err := runner.Resume(operationIn, steps, save)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Operation step 'unknown' not found"))
+				Expect(calls).To(BeEmpty())
+				Expect(operationDetails.State).To(Equal(StateFailed))
+			})
+		})
+
+		Context("when the operation is not in progress", func() {
+			BeforeEach(func() {
+				operationIn.State = StateFailed
+			})
+
+			It("does not run any step", func() {
+				operationDetails, err := runner.Resume(operationIn, steps, save)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(calls).To(BeEmpty())
+				Expect(saved).To(BeEmpty())
+				Expect(operationDetails).To(Equal(operationIn))
+			})
+		})
+	})
+})
@@ -3,68 +3,86 @@ package rdsbroker
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/frodenas/brokerapi"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pivotal-golang/lager"
 
+	"github.com/cloudfoundry-community/pe-rds-broker/adminapi"
 	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	"github.com/cloudfoundry-community/pe-rds-broker/encryption"
+	"github.com/cloudfoundry-community/pe-rds-broker/operation"
 	"github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
 	"github.com/cloudfoundry-community/pe-rds-broker/utils"
 )
 
 const defaultUsernameLength = 16
 const defaultPasswordLength = 32
+const oracleMasterPasswordLength = 30
 
 const instanceIDLogKey = "instance-id"
 const bindingIDLogKey = "binding-id"
 const detailsLogKey = "details"
 const acceptsIncompleteLogKey = "acceptsIncomplete"
 
-var rdsStatus2State = map[string]string{
-	"available":                    brokerapi.LastOperationSucceeded,
-	"backing-up":                   brokerapi.LastOperationInProgress,
-	"creating":                     brokerapi.LastOperationInProgress,
-	"deleting":                     brokerapi.LastOperationInProgress,
-	"maintenance":                  brokerapi.LastOperationInProgress,
-	"modifying":                    brokerapi.LastOperationInProgress,
-	"rebooting":                    brokerapi.LastOperationInProgress,
-	"renaming":                     brokerapi.LastOperationInProgress,
-	"resetting-master-credentials": brokerapi.LastOperationInProgress,
-	"upgrading":                    brokerapi.LastOperationInProgress,
+const operationMaxAttempts = 5
+
+const dbStoppedLoggerAction = "db-stopped"
+
+// dbEventCategoryRelevance ranks the RDS event categories that best explain why an operation is slow or failed
+var dbEventCategoryRelevance = map[string]int{
+	"failure":      3,
+	"low storage":  2,
+	"notification": 1,
 }
 
 type RDSBroker struct {
-	dbPrefix                     string
-	allowUserProvisionParameters bool
-	allowUserUpdateParameters    bool
-	allowUserBindParameters      bool
-	catalog                      Catalog
-	dbInstance                   awsrds.DBInstance
-	dbCluster                    awsrds.DBCluster
-	sqlProvider                  sqlengine.Provider
-	logger                       lager.Logger
+	dbPrefix        string
+	catalog         Catalog
+	dbInstance      awsrds.DBInstance
+	dbCluster       awsrds.DBCluster
+	dbEvents        awsrds.DBEvents
+	statusPolicy    map[string]string
+	sqlProvider     sqlengine.Provider
+	stateStore      statestore.Store
+	encryptor       encryption.Encryptor
+	operationRunner *operation.Runner
+	logger          lager.Logger
+
+	// operationsLock serializes the operations of this process only: running several brokers against the same
+	// state store is not supported, as they could run the same operation step at once
+	operationsLock sync.Mutex
 }
 
 func New(
 	config Config,
 	dbInstance awsrds.DBInstance,
 	dbCluster awsrds.DBCluster,
+	dbEvents awsrds.DBEvents,
 	sqlProvider sqlengine.Provider,
+	stateStore statestore.Store,
+	encryptor encryption.Encryptor,
 	logger lager.Logger,
 ) *RDSBroker {
 	return &RDSBroker{
-		dbPrefix:                     config.DBPrefix,
-		allowUserProvisionParameters: config.AllowUserProvisionParameters,
-		allowUserUpdateParameters:    config.AllowUserUpdateParameters,
-		allowUserBindParameters:      config.AllowUserBindParameters,
-		catalog:                      config.Catalog,
-		dbInstance:                   dbInstance,
-		dbCluster:                    dbCluster,
-		sqlProvider:                  sqlProvider,
-		logger:                       logger.Session("broker"),
+		dbPrefix:        config.DBPrefix,
+		catalog:         config.Catalog,
+		dbInstance:      dbInstance,
+		dbCluster:       dbCluster,
+		dbEvents:        dbEvents,
+		statusPolicy:    config.StatusPolicy,
+		sqlProvider:     sqlProvider,
+		stateStore:      stateStore,
+		encryptor:       encryptor,
+		operationRunner: operation.NewRunner(operationMaxAttempts, awsrds.IsTransientError, logger),
+		logger:          logger.Session("broker"),
 	}
 }
 
@@ -83,6 +101,14 @@ func (b *RDSBroker) Services() brokerapi.CatalogResponse {
 		return catalogResponse
 	}
 
+	for i, service := range apiCatalog.Services {
+		for j, plan := range service.Plans {
+			if servicePlan, ok := b.catalog.FindServicePlan(plan.ID); ok {
+				apiCatalog.Services[i].Plans[j].Schemas = servicePlan.Schemas()
+			}
+		}
+	}
+
 	catalogResponse.Services = apiCatalog.Services
 
 	return catalogResponse
@@ -101,374 +127,1957 @@ func (b *RDSBroker) Provision(instanceID string, details brokerapi.ProvisionDeta
 		return provisioningResponse, false, brokerapi.ErrAsyncRequired
 	}
 
-	provisionParameters := ProvisionParameters{}
-	if b.allowUserProvisionParameters {
-		if err := mapstructure.Decode(details.Parameters, &provisionParameters); err != nil {
-			return provisioningResponse, false, err
+	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
+	if !ok {
+		return provisioningResponse, false, fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+	}
+
+	provisionParameters := ProvisionParameters{}
+	if err := decodeParameters(details.Parameters, servicePlan.Schemas().Instance.Create.Parameters, &provisionParameters); err != nil {
+		return provisioningResponse, false, err
+	}
+
+	if provisionParameters.DBName != "" {
+		if err := sqlengine.ValidateDBName(provisionParameters.DBName); err != nil {
+			return provisioningResponse, false, invalidParametersError(err)
+		}
+	}
+
+	if _, err := b.parseRestoreParameters(provisionParameters); err != nil {
+		return provisioningResponse, false, invalidParametersError(err)
+	}
+
+	if provisionParameters.DBName != "" {
+		if isSQLServerEngine(servicePlan.RDSProperties.Engine) {
+			return provisioningResponse, false, invalidParametersError(fmt.Errorf("This broker does not support the DBName parameter for RDS engine '%s'", servicePlan.RDSProperties.Engine))
+		}
+
+		if isOracleEngine(servicePlan.RDSProperties.Engine) {
+			if err := sqlengine.ValidateOracleDBName(provisionParameters.DBName); err != nil {
+				return provisioningResponse, false, invalidParametersError(err)
+			}
+		}
+	}
+
+	if provisionParameters.RestoreFromSnapshot != "" {
+		if err := b.checkSnapshot(provisionParameters.RestoreFromSnapshot, servicePlan, details); err != nil {
+			return provisioningResponse, false, err
+		}
+	}
+
+	if provisionParameters.SourceInstanceID != "" {
+		if err := b.checkSourceInstance(provisionParameters.SourceInstanceID, servicePlan, details); err != nil {
+			return provisioningResponse, false, err
+		}
+	}
+
+	if err := b.checkReadReplicaParameters(servicePlan, provisionParameters); err != nil {
+		return provisioningResponse, false, err
+	}
+
+	if err := b.checkScalingParameters(servicePlan, provisionParameters.ScalingParameters); err != nil {
+		return provisioningResponse, false, invalidParametersError(err)
+	}
+
+	if provisionParameters.ReadReplicaOf != "" {
+		if err := b.checkSourceInstance(provisionParameters.ReadReplicaOf, servicePlan, details); err != nil {
+			return provisioningResponse, false, err
+		}
+	}
+
+	masterPassword := b.generateMasterPassword(servicePlan)
+	encryptedMasterPassword, err := b.encryptor.Encrypt(masterPassword)
+	if err != nil {
+		return provisioningResponse, false, err
+	}
+
+	instance := statestore.InstanceDetails{
+		ID:               instanceID,
+		ServiceID:        details.ServiceID,
+		OrganizationGUID: details.OrganizationGUID,
+		SpaceGUID:        details.SpaceGUID,
+		Parameters:       details.Parameters,
+		Context:          details.Context,
+		MasterPassword:   encryptedMasterPassword,
+		CreatedAt:        time.Now(),
+	}
+	if provisionParameters.RestoreFromSnapshot != "" || provisionParameters.SourceInstanceID != "" {
+		// A restored DB keeps the master password of its source until it is reset
+		instance.MasterPassword = ""
+		instance.PendingMasterPassword = encryptedMasterPassword
+		instance.ResetMasterPassword = true
+	}
+	if provisionParameters.ReadReplicaOf != "" {
+		// A read replica uses the master password of its source
+		instance.MasterPassword = ""
+		instance.ReadReplicaOf = provisionParameters.ReadReplicaOf
+	}
+
+	token, err := b.startOperation(instance, servicePlan, statestore.OperationProvision, nil)
+	if err != nil {
+		return provisioningResponse, false, err
+	}
+
+	provisioningResponse.OperationData = token.String()
+
+	return provisioningResponse, true, nil
+}
+
+func (b *RDSBroker) Update(instanceID string, details brokerapi.UpdateDetails, acceptsIncomplete bool) (brokerapi.UpdateResponse, bool, error) {
+	b.logger.Debug("update", lager.Data{
+		instanceIDLogKey:        instanceID,
+		detailsLogKey:           details,
+		acceptsIncompleteLogKey: acceptsIncomplete,
+	})
+
+	updateResponse := brokerapi.UpdateResponse{}
+
+	if !acceptsIncomplete {
+		return updateResponse, false, brokerapi.ErrAsyncRequired
+	}
+
+	service, ok := b.catalog.FindService(details.ServiceID)
+	if !ok {
+		return updateResponse, false, fmt.Errorf("Service '%s' not found", details.ServiceID)
+	}
+
+	if !service.PlanUpdateable {
+		return updateResponse, false, brokerapi.ErrInstanceNotUpdateable
+	}
+
+	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
+	if !ok {
+		return updateResponse, false, fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+	}
+
+	updateParameters := UpdateParameters{}
+	if err := decodeParameters(details.Parameters, servicePlan.Schemas().Instance.Update.Parameters, &updateParameters); err != nil {
+		return updateResponse, false, err
+	}
+
+	instance, err := b.findInstanceState(instanceID)
+	if err != nil {
+		return updateResponse, false, err
+	}
+
+	if instance.LastOperation.State == operation.StateInProgress {
+		return updateResponse, false, brokerapi.ErrConcurrentAccess
+	}
+
+	if servicePlan.RDSProperties.ReadReplica != (instance.ReadReplicaOf != "") {
+		return updateResponse, false, fmt.Errorf("Service Instance '%s' cannot be updated between read replica and non read replica Service Plans", instanceID)
+	}
+
+	if previousServicePlan, err := b.servicePlan(instanceID, instance.PlanID); err == nil {
+		if isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) != isServerlessEngineMode(previousServicePlan.RDSProperties.EngineMode) {
+			return updateResponse, false, fmt.Errorf("Service Instance '%s' cannot be updated between serverless and provisioned Service Plans", instanceID)
+		}
+	}
+
+	if err := b.checkScalingParameters(servicePlan, updateParameters.ScalingParameters); err != nil {
+		return updateResponse, false, invalidParametersError(err)
+	}
+
+	if updateParameters.RotateMasterPassword && instance.ReadReplicaOf != "" {
+		return updateResponse, false, fmt.Errorf("Service Instance '%s' is a read replica and uses the master password of Service Instance '%s'", instanceID, instance.ReadReplicaOf)
+	}
+
+	if err := b.checkStateParameter(instance, servicePlan, updateParameters.State); err != nil {
+		return updateResponse, false, err
+	}
+
+	// RDS does not modify stopped DB Instances, so they must be started by the same request
+	if updateParameters.State != dbStateRunning {
+		if err := b.checkDBRunning(instanceID, servicePlan); err != nil {
+			return updateResponse, false, err
+		}
+	}
+
+	if updateParameters.RotateMasterPassword {
+		if instance.PendingMasterPassword, err = b.encryptor.Encrypt(b.generateMasterPassword(servicePlan)); err != nil {
+			return updateResponse, false, err
+		}
+	}
+
+	instance.ServiceID = details.ServiceID
+	if details.Context != nil {
+		instance.Context = details.Context
+	}
+	// A new map, so a rejected update does not leave its parameters in the loaded instance.
+	// Only instance configuration is kept: one-shot actions such as rotate_master_password
+	// and state are not provision parameters, and reach the operation through its own parameters.
+	parameters := make(map[string]interface{}, len(instance.Parameters)+len(details.Parameters))
+	for _, source := range []map[string]interface{}{instance.Parameters, details.Parameters} {
+		for key, value := range source {
+			if _, ok := provisionParameterDefinitions[key]; ok {
+				parameters[key] = value
+			}
+		}
+	}
+	instance.Parameters = parameters
+
+	token, err := b.startOperation(instance, servicePlan, statestore.OperationUpdate, details.Parameters)
+	if err != nil {
+		return updateResponse, false, err
+	}
+
+	updateResponse.OperationData = token.String()
+
+	return updateResponse, true, nil
+}
+
+func (b *RDSBroker) Deprovision(instanceID string, details brokerapi.DeprovisionDetails, acceptsIncomplete bool) (brokerapi.DeprovisionResponse, bool, error) {
+	b.logger.Debug("deprovision", lager.Data{
+		instanceIDLogKey:        instanceID,
+		detailsLogKey:           details,
+		acceptsIncompleteLogKey: acceptsIncomplete,
+	})
+
+	deprovisionResponse := brokerapi.DeprovisionResponse{}
+
+	if !acceptsIncomplete {
+		return deprovisionResponse, false, brokerapi.ErrAsyncRequired
+	}
+
+	servicePlan, err := b.servicePlan(instanceID, details.PlanID)
+	if err != nil {
+		return deprovisionResponse, false, err
+	}
+
+	instance, err := b.findInstanceState(instanceID)
+	if err != nil {
+		return deprovisionResponse, false, err
+	}
+
+	readReplicas, err := b.readReplicas(instanceID)
+	if err != nil {
+		return deprovisionResponse, false, err
+	}
+
+	if len(readReplicas) > 0 {
+		return deprovisionResponse, false, fmt.Errorf("Service Instance '%s' has read replicas (%s): deprovision them first", instanceID, strings.Join(readReplicas, ", "))
+	}
+
+	token, err := b.startOperation(instance, servicePlan, statestore.OperationDeprovision, nil)
+	if err != nil {
+		return deprovisionResponse, false, err
+	}
+
+	deprovisionResponse.OperationData = token.String()
+
+	return deprovisionResponse, true, nil
+}
+
+func (b *RDSBroker) GetInstance(instanceID string) (brokerapi.GetInstanceResponse, error) {
+	b.logger.Debug("get-instance", lager.Data{
+		instanceIDLogKey: instanceID,
+	})
+
+	getInstanceResponse := brokerapi.GetInstanceResponse{}
+
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		if err == statestore.ErrInstanceNotFound {
+			return getInstanceResponse, brokerapi.ErrInstanceDoesNotExist
+		}
+		return getInstanceResponse, err
+	}
+
+	// An instance does not exist for the platform until it is provisioned, nor once its deprovision started
+	if instance.LastOperation.Type == statestore.OperationDeprovision {
+		return getInstanceResponse, brokerapi.ErrInstanceDoesNotExist
+	}
+
+	if instance.LastOperation.State == operation.StateInProgress {
+		if instance.LastOperation.Type == statestore.OperationProvision {
+			return getInstanceResponse, brokerapi.ErrInstanceDoesNotExist
+		}
+		return getInstanceResponse, brokerapi.ErrConcurrentAccess
+	}
+
+	getInstanceResponse.ServiceID = instance.ServiceID
+	getInstanceResponse.PlanID = instance.PlanID
+	getInstanceResponse.Parameters = instance.Parameters
+
+	return getInstanceResponse, nil
+}
+
+func (b *RDSBroker) Bind(instanceID, bindingID string, details brokerapi.BindDetails, acceptsIncomplete bool) (brokerapi.BindingResponse, bool, error) {
+	b.logger.Debug("bind", lager.Data{
+		instanceIDLogKey:        instanceID,
+		bindingIDLogKey:         bindingID,
+		detailsLogKey:           details,
+		acceptsIncompleteLogKey: acceptsIncomplete,
+	})
+
+	bindingResponse := brokerapi.BindingResponse{}
+
+	service, ok := b.catalog.FindService(details.ServiceID)
+	if !ok {
+		return bindingResponse, false, fmt.Errorf("Service '%s' not found", details.ServiceID)
+	}
+
+	if !service.Bindable {
+		return bindingResponse, false, brokerapi.ErrInstanceNotBindable
+	}
+
+	servicePlan, err := b.servicePlan(instanceID, details.PlanID)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	bindParameters := BindParameters{}
+	if err := decodeParameters(details.Parameters, servicePlan.Schemas().Binding.Create.Parameters, &bindParameters); err != nil {
+		return bindingResponse, false, err
+	}
+
+	if bindParameters.DBName != "" {
+		if err := sqlengine.ValidateDBName(bindParameters.DBName); err != nil {
+			return bindingResponse, false, invalidParametersError(err)
+		}
+	}
+
+	if bindParameters.Role != "" {
+		if err := sqlengine.ValidateRole(bindParameters.Role); err != nil {
+			return bindingResponse, false, invalidParametersError(err)
+		}
+	}
+
+	writerInstanceID, err := b.writerInstanceID(instanceID)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	if err := b.checkDBRunning(writerInstanceID, servicePlan); err != nil {
+		return bindingResponse, false, err
+	}
+
+	if err := b.checkMasterPasswordRotation(writerInstanceID); err != nil {
+		return bindingResponse, false, err
+	}
+
+	dbAddress, dbPort, dbName, masterUsername, err := b.describeDBEndpoint(writerInstanceID, servicePlan)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	readerAddress, readerPort, err := b.describeReaderEndpoint(instanceID, writerInstanceID, servicePlan)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	sqlEngine, err := b.sqlProvider.GetSQLEngine(servicePlan.RDSProperties.Engine)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	masterPassword, legacyMasterPassword, err := b.masterPassword(writerInstanceID)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	if err = sqlEngine.Open(dbAddress, dbPort, dbName, masterUsername, masterPassword); err != nil {
+		return bindingResponse, false, err
+	}
+	defer sqlEngine.Close()
+
+	dbUsername := b.dbUsername(bindingID)
+	dbPassword := b.dbPassword()
+
+	if bindParameters.DBName != "" {
+		dbName = bindParameters.DBName
+		if err = sqlEngine.CreateDB(dbName); err != nil {
+			return bindingResponse, false, err
+		}
+	}
+
+	ownerRole := b.ownerRoleName(writerInstanceID)
+	if err = sqlEngine.CreateOwnerRole(dbName, ownerRole); err != nil {
+		return bindingResponse, false, err
+	}
+
+	if err = sqlEngine.CreateUser(dbUsername, dbPassword); err != nil {
+		return bindingResponse, false, err
+	}
+
+	role := b.bindingRole(servicePlan, bindParameters)
+	if err = sqlEngine.GrantPrivileges(dbName, dbUsername, role, ownerRole); err != nil {
+		return bindingResponse, false, err
+	}
+
+	encryptedDBPassword, err := b.encryptor.Encrypt(dbPassword)
+	if err != nil {
+		return bindingResponse, false, err
+	}
+
+	binding := statestore.BindingDetails{
+		ID:         bindingID,
+		InstanceID: instanceID,
+		AppGUID:    details.AppGUID,
+		Username:   dbUsername,
+		Password:   encryptedDBPassword,
+		DBName:     dbName,
+		Role:       role,
+		Parameters: details.Parameters,
+		CreatedAt:  time.Now(),
+	}
+	if err = b.stateStore.SaveBinding(binding); err != nil {
+		return bindingResponse, false, err
+	}
+
+	if legacyMasterPassword && writerInstanceID == instanceID {
+		b.migrateMasterPassword(instanceID, servicePlan)
+	}
+
+	bindingResponse.Credentials = b.bindingCredentials(sqlEngine, dbAddress, dbPort, readerAddress, readerPort, dbName, dbUsername, dbPassword)
+
+	return bindingResponse, false, nil
+}
+
+func (b *RDSBroker) Unbind(instanceID, bindingID string, details brokerapi.UnbindDetails, acceptsIncomplete bool) (brokerapi.UnbindResponse, bool, error) {
+	b.logger.Debug("unbind", lager.Data{
+		instanceIDLogKey:        instanceID,
+		bindingIDLogKey:         bindingID,
+		detailsLogKey:           details,
+		acceptsIncompleteLogKey: acceptsIncomplete,
+	})
+
+	unbindResponse := brokerapi.UnbindResponse{}
+
+	servicePlan, err := b.servicePlan(instanceID, details.PlanID)
+	if err != nil {
+		return unbindResponse, false, err
+	}
+
+	writerInstanceID, err := b.writerInstanceID(instanceID)
+	if err != nil {
+		return unbindResponse, false, err
+	}
+
+	if err := b.checkDBRunning(writerInstanceID, servicePlan); err != nil {
+		return unbindResponse, false, err
+	}
+
+	if err := b.checkMasterPasswordRotation(writerInstanceID); err != nil {
+		return unbindResponse, false, err
+	}
+
+	dbAddress, dbPort, dbName, masterUsername, err := b.describeDBEndpoint(writerInstanceID, servicePlan)
+	if err != nil {
+		return unbindResponse, false, err
+	}
+
+	sqlEngine, err := b.sqlProvider.GetSQLEngine(servicePlan.RDSProperties.Engine)
+	if err != nil {
+		return unbindResponse, false, err
+	}
+
+	masterPassword, legacyMasterPassword, err := b.masterPassword(writerInstanceID)
+	if err != nil {
+		return unbindResponse, false, err
+	}
+
+	if err = sqlEngine.Open(dbAddress, dbPort, dbName, masterUsername, masterPassword); err != nil {
+		return unbindResponse, false, err
+	}
+	defer sqlEngine.Close()
+
+	privileges, err := sqlEngine.Privileges()
+	if err != nil {
+		return unbindResponse, false, err
+	}
+
+	binding, err := b.stateStore.FindBinding(instanceID, bindingID)
+	if err != nil && err != statestore.ErrBindingNotFound {
+		return unbindResponse, false, err
+	}
+
+	userDB := binding.DBName
+	dbUsername := b.dbUsername(bindingID)
+	if userDB == "" {
+		for privDBName, userNames := range privileges {
+			for _, userName := range userNames {
+				if userName == dbUsername {
+					userDB = privDBName
+					break
+				}
+			}
+		}
+	}
+
+	if userDB != "" {
+		if err = sqlEngine.RevokePrivileges(userDB, dbUsername); err != nil {
+			return unbindResponse, false, err
+		}
+	}
+
+	// The owner role is only created on Bind; instances bound before it existed have none,
+	// in which case the engines reassign the ownership to the master user instead
+	ownerRole := b.ownerRoleName(writerInstanceID)
+	for _, userDBName := range b.userDatabases(dbName, userDB, dbUsername, privileges) {
+		if err = sqlEngine.ReassignOwnership(userDBName, dbUsername, ownerRole); err != nil {
+			return unbindResponse, false, err
+		}
+	}
+
+	if userDB != "" && userDB != dbName {
+		inUse, err := b.databaseInUse(instanceID, bindingID, userDB, dbUsername, privileges)
+		if err != nil {
+			return unbindResponse, false, err
+		}
+
+		if !inUse {
+			if err = sqlEngine.DropDB(userDB); err != nil {
+				return unbindResponse, false, err
+			}
+		}
+	}
+
+	if err = sqlEngine.DropUser(dbUsername); err != nil {
+		return unbindResponse, false, err
+	}
+
+	if err = b.stateStore.DeleteBinding(instanceID, bindingID); err != nil && err != statestore.ErrBindingNotFound {
+		return unbindResponse, false, err
+	}
+
+	if legacyMasterPassword && writerInstanceID == instanceID {
+		b.migrateMasterPassword(instanceID, servicePlan)
+	}
+
+	return unbindResponse, false, nil
+}
+
+func (b *RDSBroker) FetchBinding(instanceID, bindingID string) (brokerapi.BindingResponse, error) {
+	b.logger.Debug("fetch-binding", lager.Data{
+		instanceIDLogKey: instanceID,
+		bindingIDLogKey:  bindingID,
+	})
+
+	bindingResponse := brokerapi.BindingResponse{}
+
+	binding, err := b.findBinding(instanceID, bindingID)
+	if err != nil {
+		return bindingResponse, err
+	}
+
+	if bindingResponse.Credentials, err = b.storedBindingCredentials(instanceID, binding); err != nil {
+		return bindingResponse, err
+	}
+
+	return bindingResponse, nil
+}
+
+func (b *RDSBroker) GetBinding(instanceID, bindingID string) (brokerapi.GetBindingResponse, error) {
+	b.logger.Debug("get-binding", lager.Data{
+		instanceIDLogKey: instanceID,
+		bindingIDLogKey:  bindingID,
+	})
+
+	getBindingResponse := brokerapi.GetBindingResponse{}
+
+	binding, err := b.findBinding(instanceID, bindingID)
+	if err != nil {
+		return getBindingResponse, err
+	}
+
+	if getBindingResponse.Credentials, err = b.storedBindingCredentials(instanceID, binding); err != nil {
+		return getBindingResponse, err
+	}
+	getBindingResponse.Parameters = binding.Parameters
+
+	return getBindingResponse, nil
+}
+
+func (b *RDSBroker) RotateBindingCredentials(instanceID string) error {
+	b.logger.Debug("rotate-binding-credentials", lager.Data{
+		instanceIDLogKey: instanceID,
+	})
+
+	servicePlan, err := b.instanceServicePlan(instanceID)
+	if err != nil {
+		return err
+	}
+
+	return b.rotateBindingCredentials(instanceID, servicePlan)
+}
+
+func (b *RDSBroker) RotateExpiredBindingCredentials() {
+	instances, err := b.stateStore.ListInstances()
+	if err != nil {
+		b.logger.Error("state-store-error", err)
+		return
+	}
+
+	for _, instance := range instances {
+		if instance.LastOperation.Type == statestore.OperationDeprovision {
+			continue
+		}
+
+		servicePlan, err := b.servicePlan(instance.ID, instance.PlanID)
+		if err != nil {
+			b.logger.Error("rotate-binding-credentials-error", err, lager.Data{instanceIDLogKey: instance.ID})
+			continue
+		}
+
+		if servicePlan.RDSProperties.CredentialsRotationDays <= 0 {
+			continue
+		}
+
+		bindings, err := b.stateStore.ListBindings(instance.ID)
+		if err != nil {
+			b.logger.Error("state-store-error", err, lager.Data{instanceIDLogKey: instance.ID})
+			continue
+		}
+
+		rotationPeriod := time.Duration(servicePlan.RDSProperties.CredentialsRotationDays) * 24 * time.Hour
+		for _, binding := range bindings {
+			if time.Since(b.bindingCredentialsRotatedAt(binding)) >= rotationPeriod {
+				b.logger.Info("rotate-binding-credentials", lager.Data{instanceIDLogKey: instance.ID})
+				if err := b.rotateBindingCredentials(instance.ID, servicePlan); err != nil {
+					b.logger.Error("rotate-binding-credentials-error", err, lager.Data{instanceIDLogKey: instance.ID})
+				}
+				break
+			}
+		}
+	}
+}
+
+func (b *RDSBroker) RotateMasterPassword(instanceID string) error {
+	b.logger.Debug("rotate-master-password", lager.Data{
+		instanceIDLogKey: instanceID,
+	})
+
+	instance, servicePlan, err := b.findInstanceStateAndServicePlan(instanceID)
+	if err != nil {
+		return err
+	}
+
+	if instance.LastOperation.State == operation.StateInProgress {
+		return brokerapi.ErrConcurrentAccess
+	}
+
+	if instance.ReadReplicaOf != "" {
+		return fmt.Errorf("Service Instance '%s' is a read replica and uses the master password of Service Instance '%s'", instanceID, instance.ReadReplicaOf)
+	}
+
+	if instance.PendingMasterPassword, err = b.encryptor.Encrypt(b.generateMasterPassword(servicePlan)); err != nil {
+		return err
+	}
+
+	_, err = b.startOperation(instance, servicePlan, statestore.OperationRotateMasterPassword, nil)
+
+	return err
+}
+
+func (b *RDSBroker) CreateSnapshot(instanceID string) (adminapi.Snapshot, error) {
+	b.logger.Debug("create-snapshot", lager.Data{
+		instanceIDLogKey: instanceID,
+	})
+
+	snapshot := adminapi.Snapshot{}
+
+	engine, err := b.describeEngine(instanceID)
+	if err != nil {
+		return snapshot, err
+	}
+
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil && err != statestore.ErrInstanceNotFound {
+		return snapshot, err
+	}
+	tags := b.dbTags("Created", instance.ServiceID, instance.PlanID, instance.OrganizationGUID, instance.SpaceGUID, instance.Context)
+
+	if awsrds.IsClusterEngine(engine) {
+		dbClusterIdentifier := b.dbClusterIdentifier(instanceID)
+		snapshot.ID = b.dbSnapshotIdentifier(dbClusterIdentifier)
+		err = b.dbCluster.CreateSnapshot(dbClusterIdentifier, snapshot.ID, tags)
+	} else {
+		snapshot.ID = b.dbSnapshotIdentifier(b.dbInstanceIdentifier(instanceID))
+		err = b.dbInstance.CreateSnapshot(b.dbInstanceIdentifier(instanceID), snapshot.ID, tags)
+	}
+	if err != nil {
+		if err == awsrds.ErrDBInstanceDoesNotExist || err == awsrds.ErrDBClusterDoesNotExist {
+			return snapshot, brokerapi.ErrInstanceDoesNotExist
+		}
+		return snapshot, err
+	}
+
+	snapshot.Status = "creating"
+	snapshot.Type = "manual"
+	snapshot.CreatedAt = time.Now()
+
+	return snapshot, nil
+}
+
+func (b *RDSBroker) ListSnapshots(instanceID string) ([]adminapi.Snapshot, error) {
+	b.logger.Debug("list-snapshots", lager.Data{
+		instanceIDLogKey: instanceID,
+	})
+
+	snapshots := []adminapi.Snapshot{}
+
+	engine, err := b.describeEngine(instanceID)
+	if err != nil {
+		return snapshots, err
+	}
+
+	var dbSnapshots []awsrds.DBSnapshotDetails
+	if awsrds.IsClusterEngine(engine) {
+		dbSnapshots, err = b.dbCluster.ListSnapshots(b.dbClusterIdentifier(instanceID))
+	} else {
+		dbSnapshots, err = b.dbInstance.ListSnapshots(b.dbInstanceIdentifier(instanceID))
+	}
+	if err != nil {
+		return snapshots, err
+	}
+
+	for _, dbSnapshot := range dbSnapshots {
+		snapshots = append(snapshots, adminapi.Snapshot{
+			ID:        dbSnapshot.Identifier,
+			Status:    dbSnapshot.Status,
+			Type:      dbSnapshot.SnapshotType,
+			CreatedAt: dbSnapshot.CreateTime,
+		})
+	}
+
+	return snapshots, nil
+}
+
+func (b *RDSBroker) LastOperation(instanceID string, details brokerapi.PollDetails) (brokerapi.LastOperationResponse, error) {
+	b.logger.Debug("last-operation", lager.Data{
+		instanceIDLogKey: instanceID,
+		detailsLogKey:    details,
+	})
+
+	lastOperationResponse := brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}
+
+	token, err := parseOperationToken(details.OperationData)
+	if err != nil {
+		b.logger.Error("invalid-operation-token", err, lager.Data{instanceIDLogKey: instanceID})
+	}
+
+	operationDetails, err := b.resumeOperation(instanceID)
+	if err != nil {
+		return lastOperationResponse, err
+	}
+
+	if token.Type != "" && !token.matches(operationDetails) {
+		// The state store has no record of the polled operation, so its state is only known from AWS
+		b.logger.Info("unknown-operation", lager.Data{
+			instanceIDLogKey: instanceID,
+			"operation":      token.String(),
+		})
+		operationDetails = token.operationDetails()
+	}
+
+	switch operationDetails.State {
+	case operation.StateInProgress:
+		lastOperationResponse.State = brokerapi.LastOperationInProgress
+		lastOperationResponse.Description = operationDetails.Description
+		return lastOperationResponse, nil
+	case operation.StateFailed:
+		lastOperationResponse.Description = operationDetails.Description
+		return lastOperationResponse, nil
+	case operation.StateSucceeded:
+		if operationDetails.Type == statestore.OperationDeprovision {
+			b.forgetDeprovisionedInstance(instanceID)
+			return lastOperationResponse, brokerapi.ErrInstanceDoesNotExist
+		}
+	}
+
+	lastOperationResponse, dbEventSources, err := b.dbLastOperation(instanceID, operationDetails)
+	if err != nil {
+		return lastOperationResponse, err
+	}
+	lastOperationResponse = b.withDBEvents(lastOperationResponse, operationDetails, dbEventSources...)
+
+	if lastOperationResponse.State == brokerapi.LastOperationSucceeded && operationDetails.Type != statestore.OperationDeprovision {
+		resetting, err := b.resetRestoredMasterPassword(instanceID)
+		if err != nil {
+			return lastOperationResponse, err
+		}
+
+		if resetting {
+			lastOperationResponse.State = brokerapi.LastOperationInProgress
+			lastOperationResponse.Description = fmt.Sprintf("Resetting the master password of DB Instance '%s'", b.dbInstanceIdentifier(instanceID))
+			return lastOperationResponse, nil
+		}
+
+		b.promotePendingMasterPassword(instanceID)
+	}
+
+	return lastOperationResponse, nil
+}
+
+// LastBindingOperation reports bindings as succeeded once they exist, as they are created synchronously
+func (b *RDSBroker) LastBindingOperation(instanceID, bindingID string, details brokerapi.PollDetails) (brokerapi.LastOperationResponse, error) {
+	b.logger.Debug("last-binding-operation", lager.Data{
+		instanceIDLogKey: instanceID,
+		bindingIDLogKey:  bindingID,
+		detailsLogKey:    details,
+	})
+
+	lastOperationResponse := brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}
+
+	if _, err := b.findBinding(instanceID, bindingID); err != nil {
+		return lastOperationResponse, err
+	}
+
+	lastOperationResponse.State = brokerapi.LastOperationSucceeded
+
+	return lastOperationResponse, nil
+}
+
+// dbLastOperation reports the state of an operation from the status of the DB Instance (or DB Cluster) of an instance,
+// and the sources of the events that may explain it. Describing the events is left to callers that show the description
+func (b *RDSBroker) dbLastOperation(instanceID string, operationDetails statestore.OperationDetails) (brokerapi.LastOperationResponse, []dbEventSource, error) {
+	dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
+	if err != nil {
+		if err == awsrds.ErrDBInstanceDoesNotExist {
+			dbClusterDetails, serverless, err := b.serverlessDBCluster(instanceID)
+			if err != nil {
+				return brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}, nil, err
+			}
+			if serverless {
+				lastOperationResponse, dbEventSources := b.dbServerlessClusterLastOperation(dbClusterDetails, operationDetails)
+				return lastOperationResponse, dbEventSources, nil
+			}
+
+			lastOperationResponse, err := b.dbGoneLastOperation(instanceID, operationDetails, fmt.Sprintf("DB Instance '%s' does not exist", b.dbInstanceIdentifier(instanceID)))
+			return lastOperationResponse, nil, err
+		}
+		return brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}, nil, err
+	}
+
+	if awsrds.IsClusterEngine(dbInstanceDetails.Engine) {
+		return b.dbClusterLastOperation(instanceID, dbInstanceDetails, operationDetails)
+	}
+
+	lastOperationResponse, dbEventSources := b.dbInstanceLastOperation(instanceID, dbInstanceDetails, operationDetails)
+	return lastOperationResponse, dbEventSources, nil
+}
+
+// dbGoneLastOperation reports an operation whose DB Instance (or DB Cluster) does not exist: the expected outcome
+// of a deprovision, but a failure of any other operation
+func (b *RDSBroker) dbGoneLastOperation(instanceID string, operationDetails statestore.OperationDetails, description string) (brokerapi.LastOperationResponse, error) {
+	lastOperationResponse := brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}
+
+	switch operationDetails.Type {
+	case statestore.OperationProvision, statestore.OperationUpdate, statestore.OperationRotateMasterPassword:
+		lastOperationResponse.Description = description
+		return lastOperationResponse, nil
+	}
+
+	b.forgetDeprovisionedInstance(instanceID)
+	return lastOperationResponse, brokerapi.ErrInstanceDoesNotExist
+}
+
+func (b *RDSBroker) dbInstanceLastOperation(instanceID string, dbInstanceDetails awsrds.DBInstanceDetails, operationDetails statestore.OperationDetails) (brokerapi.LastOperationResponse, []dbEventSource) {
+	lastOperationResponse := brokerapi.LastOperationResponse{}
+
+	status := b.rdsStatus(dbInstanceDetails.Status)
+	lastOperationResponse.State = operationState(operationDetails, status, dbInstanceDetails.PendingModifications)
+	lastOperationResponse.Description = dbInstanceStatusDescription(b.dbInstanceIdentifier(instanceID), status, dbInstanceDetails.PendingModifications, operationDetails)
+
+	return lastOperationResponse, []dbEventSource{{awsrds.DBEventSourceTypeDBInstance, b.dbInstanceIdentifier(instanceID)}}
+}
+
+func (b *RDSBroker) dbServerlessClusterLastOperation(dbClusterDetails awsrds.DBClusterDetails, operationDetails statestore.OperationDetails) (brokerapi.LastOperationResponse, []dbEventSource) {
+	lastOperationResponse := brokerapi.LastOperationResponse{}
+
+	status := b.rdsStatus(dbClusterDetails.Status)
+	lastOperationResponse.State = operationState(operationDetails, status, false)
+	lastOperationResponse.Description = status.describe("DB Cluster", dbClusterDetails.Identifier)
+
+	return lastOperationResponse, []dbEventSource{{awsrds.DBEventSourceTypeDBCluster, dbClusterDetails.Identifier}}
+}
+
+func (b *RDSBroker) dbClusterLastOperation(instanceID string, dbInstanceDetails awsrds.DBInstanceDetails, operationDetails statestore.OperationDetails) (brokerapi.LastOperationResponse, []dbEventSource, error) {
+	lastOperationResponse := brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}
+
+	dbClusterIdentifier := dbInstanceDetails.DBClusterIdentifier
+	if dbClusterIdentifier == "" {
+		dbClusterIdentifier = b.dbClusterIdentifier(instanceID)
+	}
+
+	dbClusterDetails, err := b.dbCluster.Describe(dbClusterIdentifier)
+	if err != nil {
+		if err == awsrds.ErrDBClusterDoesNotExist {
+			lastOperationResponse, err := b.dbGoneLastOperation(instanceID, operationDetails, fmt.Sprintf("DB Cluster '%s' does not exist", dbClusterIdentifier))
+			return lastOperationResponse, nil, err
+		}
+		return lastOperationResponse, nil, err
+	}
+
+	dbInstancesDetails := []awsrds.DBInstanceDetails{dbInstanceDetails}
+	for _, dbClusterMember := range dbClusterDetails.DBClusterMembers {
+		if dbClusterMember == dbInstanceDetails.Identifier {
+			continue
+		}
+
+		dbMemberDetails, err := b.dbInstance.Describe(dbClusterMember)
+		if err != nil {
+			if err == awsrds.ErrDBInstanceDoesNotExist {
+				continue
+			}
+			return lastOperationResponse, nil, err
+		}
+		dbInstancesDetails = append(dbInstancesDetails, dbMemberDetails)
+	}
+
+	status := b.rdsStatus(dbClusterDetails.Status)
+	states := []string{operationState(operationDetails, status, false)}
+	descriptions := []string{status.describe("DB Cluster", dbClusterIdentifier)}
+	dbEventSources := []dbEventSource{{awsrds.DBEventSourceTypeDBCluster, dbClusterIdentifier}}
+	for _, dbMemberDetails := range dbInstancesDetails {
+		memberStatus := b.rdsStatus(dbMemberDetails.Status)
+		states = append(states, operationState(operationDetails, memberStatus, dbMemberDetails.PendingModifications))
+		descriptions = append(descriptions, dbInstanceStatusDescription(dbMemberDetails.Identifier, memberStatus, dbMemberDetails.PendingModifications, operationDetails))
+		dbEventSources = append(dbEventSources, dbEventSource{awsrds.DBEventSourceTypeDBInstance, dbMemberDetails.Identifier})
+	}
+
+	lastOperationResponse.State = compositeState(states)
+	lastOperationResponse.Description = strings.Join(descriptions, ", ")
+
+	return lastOperationResponse, dbEventSources, nil
+}
+
+// dbEventSource identifies a DB Instance or DB Cluster whose events may explain the state of an operation
+type dbEventSource struct {
+	Type string
+	ID   string
+}
+
+// withDBEvents appends the message of the most relevant event since the operation started (the most recent event
+// of the most relevant category) to the description of an operation that did not succeed
+func (b *RDSBroker) withDBEvents(lastOperationResponse brokerapi.LastOperationResponse, operationDetails statestore.OperationDetails, sources ...dbEventSource) brokerapi.LastOperationResponse {
+	if lastOperationResponse.State == brokerapi.LastOperationSucceeded {
+		return lastOperationResponse
+	}
+
+	var relevantEvent *awsrds.DBEventDetails
+	for _, source := range sources {
+		for _, dbEvent := range b.describeDBEvents(source, operationDetails.StartedAt) {
+			dbEvent := dbEvent
+			if relevantEvent == nil || dbEventRelevance(dbEvent) > dbEventRelevance(*relevantEvent) ||
+				(dbEventRelevance(dbEvent) == dbEventRelevance(*relevantEvent) && dbEvent.Date.After(relevantEvent.Date)) {
+				relevantEvent = &dbEvent
+			}
+		}
+	}
+
+	if relevantEvent != nil {
+		lastOperationResponse.Description = fmt.Sprintf("%s: %s", lastOperationResponse.Description, relevantEvent.Message)
+	}
+
+	return lastOperationResponse
+}
+
+// describeDBEvents returns the events of a DB Instance or DB Cluster since a time. Events only explain the
+// operation state, so errors are logged but not returned
+func (b *RDSBroker) describeDBEvents(source dbEventSource, startTime time.Time) []awsrds.DBEventDetails {
+	dbEvents, err := b.dbEvents.Describe(source.Type, source.ID, startTime)
+	if err != nil {
+		b.logger.Error("describe-db-events-error", err, lager.Data{"source-id": source.ID})
+		return nil
+	}
+
+	recentEvents := []awsrds.DBEventDetails{}
+	for _, dbEvent := range dbEvents {
+		if !dbEvent.Date.Before(startTime) {
+			recentEvents = append(recentEvents, dbEvent)
+		}
+	}
+
+	return recentEvents
+}
+
+func dbEventRelevance(dbEvent awsrds.DBEventDetails) int {
+	relevance := 0
+	for _, category := range dbEvent.EventCategories {
+		if dbEventCategoryRelevance[category] > relevance {
+			relevance = dbEventCategoryRelevance[category]
+		}
+	}
+
+	return relevance
+}
+
+// rdsStatus returns how a DB Instance or DB Cluster status is reported with the configured status policy
+func (b *RDSBroker) rdsStatus(name string) rdsStatus {
+	status, ok := rdsStatus2State(name, b.statusPolicy)
+	if !ok {
+		b.logger.Info("unknown-rds-status", lager.Data{"status": name})
+	}
+
+	return status
+}
+
+// operationState interprets the status of a DB Instance or DB Cluster for an operation. Operations of an unknown type
+// (not polled with an operation token, nor recorded in the state store) only rely on the status
+func operationState(operationDetails statestore.OperationDetails, status rdsStatus, pendingModifications bool) string {
+	state := status.State
+
+	switch operationDetails.Type {
+	case statestore.OperationProvision, statestore.OperationUpdate, statestore.OperationRotateMasterPassword:
+		if status.Name == "deleting" {
+			return brokerapi.LastOperationFailed
+		}
+	case statestore.OperationDeprovision:
+		// A DB that still exists once its deprovision steps are done was not deleted
+		if state == brokerapi.LastOperationSucceeded {
+			return brokerapi.LastOperationFailed
+		}
+		return state
+	}
+
+	// A stopped DB is the expected outcome of stopping it, and a transient status while starting it
+	if status.Name == "stopped" {
+		switch requestedDBState(operationDetails) {
+		case dbStateStopped:
+			return brokerapi.LastOperationSucceeded
+		case dbStateRunning:
+			return brokerapi.LastOperationInProgress
+		}
+	}
+
+	if state == brokerapi.LastOperationSucceeded && pendingModifications && !deferredModifications(operationDetails) {
+		return brokerapi.LastOperationInProgress
+	}
+
+	return state
+}
+
+// requestedDBState returns the state an update operation stops or starts the DB to, if any
+func requestedDBState(operationDetails statestore.OperationDetails) string {
+	if operationDetails.Type != statestore.OperationUpdate {
+		return ""
+	}
+
+	updateParameters := UpdateParameters{}
+	if err := mapstructure.Decode(operationDetails.Parameters, &updateParameters); err != nil {
+		return ""
+	}
+
+	return updateParameters.State
+}
+
+// deferredModifications returns whether an operation leaves its modifications pending until the next maintenance window
+func deferredModifications(operationDetails statestore.OperationDetails) bool {
+	if operationDetails.Type != statestore.OperationUpdate {
+		return false
+	}
+
+	updateParameters := UpdateParameters{}
+	if err := mapstructure.Decode(operationDetails.Parameters, &updateParameters); err != nil {
+		return false
+	}
+
+	return !updateParameters.ApplyImmediately
+}
+
+func dbInstanceStatusDescription(dbInstanceIdentifier string, status rdsStatus, pendingModifications bool, operationDetails statestore.OperationDetails) string {
+	if status.State == brokerapi.LastOperationSucceeded && pendingModifications && operationDetails.Type != statestore.OperationDeprovision {
+		if deferredModifications(operationDetails) {
+			return fmt.Sprintf("DB Instance '%s' has pending modifications that will be applied during the next maintenance window", dbInstanceIdentifier)
+		}
+		return fmt.Sprintf("DB Instance '%s' has pending modifications", dbInstanceIdentifier)
+	}
+
+	return status.describe("DB Instance", dbInstanceIdentifier)
+}
+
+func compositeState(states []string) string {
+	compositeState := brokerapi.LastOperationSucceeded
+
+	for _, state := range states {
+		switch state {
+		case brokerapi.LastOperationSucceeded:
+		case brokerapi.LastOperationInProgress:
+			compositeState = brokerapi.LastOperationInProgress
+		default:
+			return brokerapi.LastOperationFailed
+		}
+	}
+
+	return compositeState
+}
+
+func (b *RDSBroker) rotateBindingCredentials(instanceID string, servicePlan ServicePlan) error {
+	bindings, err := b.stateStore.ListBindings(instanceID)
+	if err != nil {
+		return err
+	}
+
+	if len(bindings) == 0 {
+		return nil
+	}
+
+	writerInstanceID, err := b.writerInstanceID(instanceID)
+	if err != nil {
+		return err
+	}
+
+	dbAddress, dbPort, dbName, masterUsername, err := b.describeDBEndpoint(writerInstanceID, servicePlan)
+	if err != nil {
+		return err
+	}
+
+	sqlEngine, err := b.sqlProvider.GetSQLEngine(servicePlan.RDSProperties.Engine)
+	if err != nil {
+		return err
+	}
+
+	masterPassword, _, err := b.masterPassword(writerInstanceID)
+	if err != nil {
+		return err
+	}
+
+	if err = sqlEngine.Open(dbAddress, dbPort, dbName, masterUsername, masterPassword); err != nil {
+		return err
+	}
+	defer sqlEngine.Close()
+
+	for _, binding := range bindings {
+		if err = b.rotateBindingPassword(sqlEngine, binding); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rotateBindingPassword stores the new password as pending before changing it in the database, so a
+// password the database already uses is never lost, and reverts the change if it cannot be stored as active.
+func (b *RDSBroker) rotateBindingPassword(sqlEngine sqlengine.SQLEngine, binding statestore.BindingDetails) error {
+	dbPassword := b.dbPassword()
+	encryptedDBPassword, err := b.encryptor.Encrypt(dbPassword)
+	if err != nil {
+		return err
+	}
+
+	previousBinding := binding
+	previousBinding.PendingPassword = ""
+
+	binding.PendingPassword = encryptedDBPassword
+	if err = b.stateStore.SaveBinding(binding); err != nil {
+		return err
+	}
+
+	if err = sqlEngine.AlterUserPassword(binding.Username, dbPassword); err != nil {
+		if saveErr := b.stateStore.SaveBinding(previousBinding); saveErr != nil {
+			b.logger.Error("state-store-error", saveErr)
+		}
+		return err
+	}
+
+	binding.Password = encryptedDBPassword
+	binding.PendingPassword = ""
+	binding.RotatedAt = time.Now()
+	if err = b.stateStore.SaveBinding(binding); err != nil {
+		b.revertBindingPassword(sqlEngine, previousBinding)
+		return err
+	}
+
+	return nil
+}
+
+func (b *RDSBroker) revertBindingPassword(sqlEngine sqlengine.SQLEngine, binding statestore.BindingDetails) {
+	logData := lager.Data{instanceIDLogKey: binding.InstanceID, bindingIDLogKey: binding.ID}
+
+	previousPassword, err := b.encryptor.Decrypt(binding.Password)
+	if err != nil {
+		b.logger.Error("revert-binding-password", err, logData)
+		return
+	}
+
+	if err = sqlEngine.AlterUserPassword(binding.Username, previousPassword); err != nil {
+		b.logger.Error("revert-binding-password", err, logData)
+	}
+}
+
+func (b *RDSBroker) bindingCredentialsRotatedAt(binding statestore.BindingDetails) time.Time {
+	if binding.RotatedAt.After(binding.CreatedAt) {
+		return binding.RotatedAt
+	}
+
+	return binding.CreatedAt
+}
+
+func (b *RDSBroker) describeDBEndpoint(instanceID string, servicePlan ServicePlan) (string, int64, string, string, error) {
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instanceID))
+		if err != nil {
+			if err == awsrds.ErrDBInstanceDoesNotExist || err == awsrds.ErrDBClusterDoesNotExist {
+				return "", 0, "", "", brokerapi.ErrInstanceDoesNotExist
+			}
+			return "", 0, "", "", err
+		}
+
+		dbName := dbClusterDetails.DatabaseName
+		if dbName == "" {
+			dbName = b.dbName(instanceID)
+		}
+
+		return dbClusterDetails.Endpoint, dbClusterDetails.Port, dbName, dbClusterDetails.MasterUsername, nil
+	}
+
+	dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
+	if err != nil {
+		if err == awsrds.ErrDBInstanceDoesNotExist {
+			return "", 0, "", "", brokerapi.ErrInstanceDoesNotExist
+		}
+		return "", 0, "", "", err
+	}
+
+	dbName := dbInstanceDetails.DBName
+	if dbName == "" {
+		dbName = b.dbName(instanceID)
+	}
+
+	return dbInstanceDetails.Address, dbInstanceDetails.Port, dbName, dbInstanceDetails.MasterUsername, nil
+}
+
+// describeDBStatus returns the status of the DB Instance of an instance or, as Aurora DB Clusters are stopped and
+// started as a whole, of its DB Cluster
+func (b *RDSBroker) describeDBStatus(instanceID string, servicePlan ServicePlan) (string, error) {
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) && !servicePlan.RDSProperties.ReadReplica {
+		dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instanceID))
+		if err != nil {
+			if err == awsrds.ErrDBInstanceDoesNotExist || err == awsrds.ErrDBClusterDoesNotExist {
+				return "", brokerapi.ErrInstanceDoesNotExist
+			}
+			return "", err
+		}
+
+		return dbClusterDetails.Status, nil
+	}
+
+	dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
+	if err != nil {
+		if err == awsrds.ErrDBInstanceDoesNotExist {
+			return "", brokerapi.ErrInstanceDoesNotExist
+		}
+		return "", err
+	}
+
+	return dbInstanceDetails.Status, nil
+}
+
+// checkDBRunning fails when the DB of an instance is stopped (or being stopped), as it does not accept connections nor modifications
+func (b *RDSBroker) checkDBRunning(instanceID string, servicePlan ServicePlan) error {
+	status, err := b.describeDBStatus(instanceID, servicePlan)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case "stopped", "stopping":
+		err := fmt.Errorf("Service Instance '%s' is %s: update it with the 'state' parameter set to '%s' first", instanceID, status, dbStateRunning)
+		return brokerapi.NewFailureResponse(err, http.StatusUnprocessableEntity, dbStoppedLoggerAction)
+	}
+
+	return nil
+}
+
+// checkMasterPasswordRotation refuses to log in while the master password of an instance is being changed, as RDS
+// only accepts the new one once it has finished resetting the master credentials
+func (b *RDSBroker) checkMasterPasswordRotation(instanceID string) error {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		if err == statestore.ErrInstanceNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if instance.LastOperation.Type == statestore.OperationRotateMasterPassword && instance.LastOperation.State == operation.StateInProgress {
+		return brokerapi.ErrConcurrentAccess
+	}
+
+	return nil
+}
+
+func (b *RDSBroker) describeReaderEndpoint(instanceID string, writerInstanceID string, servicePlan ServicePlan) (string, int64, error) {
+	if instanceID == writerInstanceID {
+		if !awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) || isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) {
+			return "", 0, nil
+		}
+
+		dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instanceID))
+		if err != nil {
+			if err == awsrds.ErrDBClusterDoesNotExist {
+				return "", 0, brokerapi.ErrInstanceDoesNotExist
+			}
+			return "", 0, err
+		}
+
+		return dbClusterDetails.ReaderEndpoint, dbClusterDetails.Port, nil
+	}
+
+	dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
+	if err != nil {
+		if err == awsrds.ErrDBInstanceDoesNotExist {
+			return "", 0, brokerapi.ErrInstanceDoesNotExist
+		}
+		return "", 0, err
+	}
+
+	return dbInstanceDetails.Address, dbInstanceDetails.Port, nil
+}
+
+// describeEngine returns the RDS engine of an instance, from its DB Instance or, as Aurora Serverless
+// DB Clusters have no DB Instances, from its DB Cluster
+func (b *RDSBroker) describeEngine(instanceID string) (string, error) {
+	dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
+	if err == nil {
+		return dbInstanceDetails.Engine, nil
+	}
+	if err != awsrds.ErrDBInstanceDoesNotExist {
+		return "", err
+	}
+
+	dbClusterDetails, serverless, err := b.serverlessDBCluster(instanceID)
+	if err != nil {
+		return "", err
+	}
+	if !serverless {
+		return "", brokerapi.ErrInstanceDoesNotExist
+	}
+
+	return dbClusterDetails.Engine, nil
+}
+
+// serverlessDBCluster returns the DB Cluster of an instance and whether it is an Aurora Serverless one
+func (b *RDSBroker) serverlessDBCluster(instanceID string) (awsrds.DBClusterDetails, bool, error) {
+	dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instanceID))
+	if err != nil {
+		if err == awsrds.ErrDBClusterDoesNotExist {
+			return dbClusterDetails, false, nil
+		}
+		return dbClusterDetails, false, err
+	}
+
+	return dbClusterDetails, isServerlessEngineMode(dbClusterDetails.EngineMode), nil
+}
+
+// writerInstanceID returns the ID of the service instance where the users of instanceID
+// bindings are managed: the source service instance when instanceID is a read replica
+func (b *RDSBroker) writerInstanceID(instanceID string) (string, error) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		if err == statestore.ErrInstanceNotFound {
+			return instanceID, nil
+		}
+		return "", err
+	}
+
+	if instance.ReadReplicaOf != "" {
+		return instance.ReadReplicaOf, nil
+	}
+
+	return instanceID, nil
+}
+
+func (b *RDSBroker) readReplicas(instanceID string) ([]string, error) {
+	instances, err := b.stateStore.ListInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	readReplicas := []string{}
+	for _, instance := range instances {
+		if instance.ReadReplicaOf == instanceID {
+			readReplicas = append(readReplicas, instance.ID)
+		}
+	}
+	sort.Strings(readReplicas)
+
+	return readReplicas, nil
+}
+
+func (b *RDSBroker) checkReadReplicaParameters(servicePlan ServicePlan, provisionParameters ProvisionParameters) error {
+	if !servicePlan.RDSProperties.ReadReplica {
+		if provisionParameters.ReadReplicaOf != "" {
+			return fmt.Errorf("Service Plan '%s' does not support read replicas", servicePlan.ID)
+		}
+		return nil
+	}
+
+	if provisionParameters.ReadReplicaOf == "" {
+		return fmt.Errorf("Service Plan '%s' requires a 'read_replica_of' parameter", servicePlan.ID)
+	}
+
+	if provisionParameters.RestoreFromSnapshot != "" || provisionParameters.SourceInstanceID != "" {
+		return fmt.Errorf("Parameter 'read_replica_of' cannot be used together with 'restore_from_snapshot' or 'source_instance_id'")
+	}
+
+	return nil
+}
+
+func (b *RDSBroker) checkSnapshot(snapshotID string, servicePlan ServicePlan, details brokerapi.ProvisionDetails) error {
+	var dbSnapshotDetails awsrds.DBSnapshotDetails
+	var err error
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		dbSnapshotDetails, err = b.dbCluster.DescribeSnapshot(snapshotID)
+	} else {
+		dbSnapshotDetails, err = b.dbInstance.DescribeSnapshot(snapshotID)
+	}
+	if err != nil {
+		if err == awsrds.ErrDBSnapshotDoesNotExist {
+			return fmt.Errorf("DB Snapshot '%s' not found", snapshotID)
+		}
+		return err
+	}
+
+	if details.OrganizationGUID == "" || details.SpaceGUID == "" ||
+		dbSnapshotDetails.Tags["Organization ID"] != details.OrganizationGUID ||
+		dbSnapshotDetails.Tags["Space ID"] != details.SpaceGUID {
+		return fmt.Errorf("DB Snapshot '%s' does not belong to the same organization and space", snapshotID)
+	}
+
+	if strings.ToLower(dbSnapshotDetails.Engine) != strings.ToLower(servicePlan.RDSProperties.Engine) {
+		return fmt.Errorf("DB Snapshot '%s' engine '%s' does not match the Service Plan engine '%s'", snapshotID, dbSnapshotDetails.Engine, servicePlan.RDSProperties.Engine)
+	}
+
+	if dbSnapshotDetails.Status != "available" {
+		return fmt.Errorf("DB Snapshot '%s' is not available (status '%s')", snapshotID, dbSnapshotDetails.Status)
+	}
+
+	return nil
+}
+
+func (b *RDSBroker) parseRestoreParameters(provisionParameters ProvisionParameters) (time.Time, error) {
+	if provisionParameters.SourceInstanceID != "" && provisionParameters.RestoreFromSnapshot != "" {
+		return time.Time{}, fmt.Errorf("Parameters 'source_instance_id' and 'restore_from_snapshot' cannot be used together")
+	}
+
+	if provisionParameters.RestoreTime == "" {
+		return time.Time{}, nil
+	}
+
+	if provisionParameters.SourceInstanceID == "" {
+		return time.Time{}, fmt.Errorf("Parameter 'restore_time' requires a 'source_instance_id'")
+	}
+
+	restoreTime, err := time.Parse(time.RFC3339, provisionParameters.RestoreTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Parameter 'restore_time' is not valid: %s", err)
+	}
+
+	return restoreTime, nil
+}
+
+func (b *RDSBroker) checkSourceInstance(sourceInstanceID string, servicePlan ServicePlan, details brokerapi.ProvisionDetails) error {
+	if details.OrganizationGUID == "" || details.SpaceGUID == "" {
+		return fmt.Errorf("Service Instance '%s' does not belong to the same organization and space", sourceInstanceID)
+	}
+
+	var engine string
+	var tags map[string]string
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(sourceInstanceID))
+		if err != nil {
+			if err == awsrds.ErrDBClusterDoesNotExist {
+				return fmt.Errorf("Service Instance '%s' not found", sourceInstanceID)
+			}
+			return err
+		}
+		engine = dbClusterDetails.Engine
+
+		if tags, err = b.dbCluster.DescribeTags(b.dbClusterIdentifier(sourceInstanceID)); err != nil {
+			return err
+		}
+	} else {
+		dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(sourceInstanceID))
+		if err != nil {
+			if err == awsrds.ErrDBInstanceDoesNotExist {
+				return fmt.Errorf("Service Instance '%s' not found", sourceInstanceID)
+			}
+			return err
+		}
+		engine = dbInstanceDetails.Engine
+
+		if tags, err = b.dbInstance.DescribeTags(b.dbInstanceIdentifier(sourceInstanceID)); err != nil {
+			return err
+		}
+	}
+
+	if tags["Organization ID"] != details.OrganizationGUID || tags["Space ID"] != details.SpaceGUID {
+		return fmt.Errorf("Service Instance '%s' does not belong to the same organization and space", sourceInstanceID)
+	}
+
+	if strings.ToLower(engine) != strings.ToLower(servicePlan.RDSProperties.Engine) {
+		return fmt.Errorf("Service Instance '%s' engine '%s' does not match the Service Plan engine '%s'", sourceInstanceID, engine, servicePlan.RDSProperties.Engine)
+	}
+
+	return nil
+}
+
+// checkStateParameter fails for instances RDS cannot stop or start
+func (b *RDSBroker) checkStateParameter(instance statestore.InstanceDetails, servicePlan ServicePlan, state string) error {
+	if state == "" {
+		return nil
+	}
+
+	if isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) {
+		return invalidParametersError(fmt.Errorf("Service Instance '%s' is an Aurora Serverless DB Cluster and cannot be stopped or started", instance.ID))
+	}
+
+	if instance.ReadReplicaOf != "" {
+		return invalidParametersError(fmt.Errorf("Service Instance '%s' is a read replica and cannot be stopped or started", instance.ID))
+	}
+
+	if state == dbStateStopped {
+		readReplicas, err := b.readReplicas(instance.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(readReplicas) > 0 {
+			return invalidParametersError(fmt.Errorf("Service Instance '%s' has read replicas (%s) and cannot be stopped", instance.ID, strings.Join(readReplicas, ", ")))
+		}
+	}
+
+	return nil
+}
+
+func (b *RDSBroker) checkScalingParameters(servicePlan ServicePlan, scalingParameters ScalingParameters) error {
+	if scalingParameters == (ScalingParameters{}) {
+		return nil
+	}
+
+	if !isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) {
+		return fmt.Errorf("Service Plan '%s' is not serverless: parameters 'min_capacity', 'max_capacity', 'auto_pause' and 'seconds_until_auto_pause' are not supported", servicePlan.ID)
+	}
+
+	return validateScalingConfiguration(*b.scalingConfiguration(servicePlan, scalingParameters))
+}
+
+// scalingConfiguration returns the capacity range of a serverless Service Plan, overridden by the user parameters
+func (b *RDSBroker) scalingConfiguration(servicePlan ServicePlan, scalingParameters ScalingParameters) *awsrds.ScalingConfiguration {
+	if !isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) {
+		return nil
+	}
+
+	scalingConfiguration := servicePlan.RDSProperties.scalingConfiguration()
+
+	if scalingParameters.MinCapacity > 0 {
+		scalingConfiguration.MinCapacity = scalingParameters.MinCapacity
+	}
+
+	if scalingParameters.MaxCapacity > 0 {
+		scalingConfiguration.MaxCapacity = scalingParameters.MaxCapacity
+	}
+
+	if scalingParameters.AutoPause != nil {
+		scalingConfiguration.AutoPause = *scalingParameters.AutoPause
+	}
+
+	if scalingParameters.SecondsUntilAutoPause > 0 {
+		scalingConfiguration.SecondsUntilAutoPause = scalingParameters.SecondsUntilAutoPause
+	}
+
+	return &scalingConfiguration
+}
+
+func (b *RDSBroker) bindingRole(servicePlan ServicePlan, bindParameters BindParameters) string {
+	if bindParameters.Role != "" {
+		return bindParameters.Role
+	}
+
+	if servicePlan.RDSProperties.DefaultBindingRole != "" {
+		return servicePlan.RDSProperties.DefaultBindingRole
+	}
+
+	return sqlengine.RoleOwner
+}
+
+func (b *RDSBroker) userDatabases(dbName, userDB, dbUsername string, privileges map[string][]string) []string {
+	dbNames := []string{dbName}
+	if userDB != "" && userDB != dbName {
+		dbNames = append(dbNames, userDB)
+	}
+
+	privDBNames := []string{}
+	for privDBName, userNames := range privileges {
+		if privDBName == dbName || privDBName == userDB {
+			continue
+		}
+		for _, userName := range userNames {
+			if userName == dbUsername {
+				privDBNames = append(privDBNames, privDBName)
+				break
+			}
+		}
+	}
+	sort.Strings(privDBNames)
+
+	return append(dbNames, privDBNames...)
+}
+
+func (b *RDSBroker) databaseInUse(instanceID, bindingID, dbName, dbUsername string, privileges map[string][]string) (bool, error) {
+	for _, userName := range privileges[dbName] {
+		if userName != dbUsername {
+			return true, nil
 		}
 	}
 
-	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
-	if !ok {
-		return provisioningResponse, false, fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+	// Users with restricted roles might not show up at the engine privileges, so look also at the stored bindings
+	bindings, err := b.stateStore.ListBindings(instanceID)
+	if err != nil {
+		return false, err
 	}
 
-	var err error
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
-		createDBCluster := b.createDBCluster(instanceID, servicePlan, provisionParameters, details)
-		if err = b.dbCluster.Create(b.dbClusterIdentifier(instanceID), *createDBCluster); err != nil {
-			return provisioningResponse, false, err
+	for _, binding := range bindings {
+		if binding.ID != bindingID && binding.DBName == dbName {
+			return true, nil
 		}
-		defer func() {
-			if err != nil {
-				b.dbCluster.Delete(b.dbClusterIdentifier(instanceID), servicePlan.RDSProperties.SkipFinalSnapshot)
-			}
-		}()
-	}
-
-	createDBInstance := b.createDBInstance(instanceID, servicePlan, provisionParameters, details)
-	if err = b.dbInstance.Create(b.dbInstanceIdentifier(instanceID), *createDBInstance); err != nil {
-		return provisioningResponse, false, err
 	}
 
-	return provisioningResponse, true, nil
+	return false, nil
 }
 
-func (b *RDSBroker) Update(instanceID string, details brokerapi.UpdateDetails, acceptsIncomplete bool) (bool, error) {
-	b.logger.Debug("update", lager.Data{
-		instanceIDLogKey:        instanceID,
-		detailsLogKey:           details,
-		acceptsIncompleteLogKey: acceptsIncomplete,
-	})
+func (b *RDSBroker) bindingCredentials(sqlEngine sqlengine.SQLEngine, dbAddress string, dbPort int64, readerAddress string, readerPort int64, dbName string, dbUsername string, dbPassword string) *Credentials {
+	credentials := &Credentials{
+		Host:     dbAddress,
+		Port:     dbPort,
+		Name:     dbName,
+		Username: dbUsername,
+		Password: dbPassword,
+		URI:      sqlEngine.URI(dbAddress, dbPort, dbName, dbUsername, dbPassword),
+		JDBCURI:  sqlEngine.JDBCURI(dbAddress, dbPort, dbName, dbUsername, dbPassword),
+	}
 
-	if !acceptsIncomplete {
-		return false, brokerapi.ErrAsyncRequired
+	if readerAddress != "" {
+		credentials.ReaderEndpoint = readerAddress
+		credentials.ReaderPort = readerPort
+		credentials.ReaderURI = sqlEngine.URI(readerAddress, readerPort, dbName, dbUsername, dbPassword)
+		credentials.ReaderJDBCURI = sqlEngine.JDBCURI(readerAddress, readerPort, dbName, dbUsername, dbPassword)
 	}
 
-	updateParameters := UpdateParameters{}
-	if b.allowUserUpdateParameters {
-		if err := mapstructure.Decode(details.Parameters, &updateParameters); err != nil {
-			return false, err
+	return credentials
+}
+
+func (b *RDSBroker) instanceServicePlan(instanceID string) (ServicePlan, error) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		if err == statestore.ErrInstanceNotFound {
+			return ServicePlan{}, brokerapi.ErrInstanceDoesNotExist
 		}
+		return ServicePlan{}, err
 	}
 
-	service, ok := b.catalog.FindService(details.ServiceID)
-	if !ok {
-		return false, fmt.Errorf("Service '%s' not found", details.ServiceID)
-	}
+	return b.servicePlan(instanceID, instance.PlanID)
+}
 
-	if !service.PlanUpdateable {
-		return false, brokerapi.ErrInstanceNotUpdateable
+func (b *RDSBroker) servicePlan(instanceID string, planID string) (ServicePlan, error) {
+	if servicePlan, ok := b.catalog.FindServicePlan(planID); ok {
+		return servicePlan, nil
 	}
 
-	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
-	if !ok {
-		return false, fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil || instance.PlanID != planID || len(instance.RDSProperties) == 0 {
+		return ServicePlan{}, fmt.Errorf("Service Plan '%s' not found", planID)
 	}
 
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
-		modifyDBCluster := b.modifyDBCluster(instanceID, servicePlan, updateParameters, details)
-		if err := b.dbCluster.Modify(b.dbClusterIdentifier(instanceID), *modifyDBCluster, updateParameters.ApplyImmediately); err != nil {
-			return false, err
-		}
+	servicePlan := ServicePlan{ID: instance.PlanID}
+	if err = json.Unmarshal(instance.RDSProperties, &servicePlan.RDSProperties); err != nil {
+		return servicePlan, err
 	}
 
-	modifyDBInstance := b.modifyDBInstance(instanceID, servicePlan, updateParameters, details)
-	if err := b.dbInstance.Modify(b.dbInstanceIdentifier(instanceID), *modifyDBInstance, updateParameters.ApplyImmediately); err != nil {
-		if err == awsrds.ErrDBInstanceDoesNotExist {
-			return false, brokerapi.ErrInstanceDoesNotExist
+	return servicePlan, nil
+}
+
+func (b *RDSBroker) findBinding(instanceID, bindingID string) (statestore.BindingDetails, error) {
+	binding, err := b.stateStore.FindBinding(instanceID, bindingID)
+	if err != nil {
+		if err == statestore.ErrBindingNotFound {
+			return binding, brokerapi.ErrBindingDoesNotExist
 		}
-		return false, err
+		return binding, err
 	}
 
-	return true, nil
+	return binding, nil
 }
 
-func (b *RDSBroker) Deprovision(instanceID string, details brokerapi.DeprovisionDetails, acceptsIncomplete bool) (bool, error) {
-	b.logger.Debug("deprovision", lager.Data{
-		instanceIDLogKey:        instanceID,
-		detailsLogKey:           details,
-		acceptsIncompleteLogKey: acceptsIncomplete,
-	})
-
-	if !acceptsIncomplete {
-		return false, brokerapi.ErrAsyncRequired
+func (b *RDSBroker) storedBindingCredentials(instanceID string, binding statestore.BindingDetails) (*Credentials, error) {
+	if binding.Password == "" {
+		return nil, fmt.Errorf("Credentials for Service Binding '%s' are not available", binding.ID)
 	}
 
-	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
-	if !ok {
-		return false, fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+	servicePlan, err := b.instanceServicePlan(instanceID)
+	if err != nil {
+		return nil, err
 	}
 
-	skipDBInstanceFinalSnapshot := servicePlan.RDSProperties.SkipFinalSnapshot
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
-		skipDBInstanceFinalSnapshot = true
+	writerInstanceID, err := b.writerInstanceID(instanceID)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := b.dbInstance.Delete(b.dbInstanceIdentifier(instanceID), skipDBInstanceFinalSnapshot); err != nil {
-		if err == awsrds.ErrDBInstanceDoesNotExist {
-			return false, brokerapi.ErrInstanceDoesNotExist
-		}
-		return false, err
+	dbAddress, dbPort, _, _, err := b.describeDBEndpoint(writerInstanceID, servicePlan)
+	if err != nil {
+		return nil, err
 	}
 
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
-		b.dbCluster.Delete(b.dbClusterIdentifier(instanceID), servicePlan.RDSProperties.SkipFinalSnapshot)
+	readerAddress, readerPort, err := b.describeReaderEndpoint(instanceID, writerInstanceID, servicePlan)
+	if err != nil {
+		return nil, err
 	}
 
-	return true, nil
-}
+	sqlEngine, err := b.sqlProvider.GetSQLEngine(servicePlan.RDSProperties.Engine)
+	if err != nil {
+		return nil, err
+	}
 
-func (b *RDSBroker) Bind(instanceID, bindingID string, details brokerapi.BindDetails) (brokerapi.BindingResponse, error) {
-	b.logger.Debug("bind", lager.Data{
-		instanceIDLogKey: instanceID,
-		bindingIDLogKey:  bindingID,
-		detailsLogKey:    details,
-	})
+	dbPassword, err := b.encryptor.Decrypt(binding.Password)
+	if err != nil {
+		return nil, err
+	}
 
-	bindingResponse := brokerapi.BindingResponse{}
+	return b.bindingCredentials(sqlEngine, dbAddress, dbPort, readerAddress, readerPort, binding.DBName, binding.Username, dbPassword), nil
+}
 
-	bindParameters := BindParameters{}
-	if b.allowUserBindParameters {
-		if err := mapstructure.Decode(details.Parameters, &bindParameters); err != nil {
-			return bindingResponse, err
+func (b *RDSBroker) findInstanceState(instanceID string) (statestore.InstanceDetails, error) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		if err != statestore.ErrInstanceNotFound {
+			return instance, err
 		}
-	}
 
-	service, ok := b.catalog.FindService(details.ServiceID)
-	if !ok {
-		return bindingResponse, fmt.Errorf("Service '%s' not found", details.ServiceID)
+		instance = statestore.InstanceDetails{
+			ID:        instanceID,
+			CreatedAt: time.Now(),
+		}
 	}
 
-	if !service.Bindable {
-		return bindingResponse, brokerapi.ErrInstanceNotBindable
-	}
+	return instance, nil
+}
 
-	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
-	if !ok {
-		return bindingResponse, fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+// findInstanceStateAndServicePlan returns the state of an instance and its Service Plan. Instances missing from the
+// state store, such as the ones created by previous versions of the broker, are described from the tags of their
+// DB Instance (or DB Cluster)
+func (b *RDSBroker) findInstanceStateAndServicePlan(instanceID string) (statestore.InstanceDetails, ServicePlan, error) {
+	instance, err := b.findInstanceState(instanceID)
+	if err != nil {
+		return instance, ServicePlan{}, err
 	}
 
-	var dbAddress, dbName, masterUsername string
-	var dbPort int64
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
-		dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instanceID))
+	if instance.PlanID == "" {
+		tags, err := b.describeInstanceTags(instanceID)
 		if err != nil {
-			if err == awsrds.ErrDBInstanceDoesNotExist {
-				return bindingResponse, brokerapi.ErrInstanceDoesNotExist
-			}
-			return bindingResponse, err
+			return instance, ServicePlan{}, err
 		}
 
-		dbAddress = dbClusterDetails.Endpoint
-		dbPort = dbClusterDetails.Port
-		masterUsername = dbClusterDetails.MasterUsername
-		if dbClusterDetails.DatabaseName != "" {
-			dbName = dbClusterDetails.DatabaseName
-		} else {
-			dbName = b.dbName(instanceID)
-		}
-	} else {
-		dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
-		if err != nil {
-			if err == awsrds.ErrDBInstanceDoesNotExist {
-				return bindingResponse, brokerapi.ErrInstanceDoesNotExist
-			}
-			return bindingResponse, err
-		}
+		instance.ServiceID = tags["Service ID"]
+		instance.PlanID = tags["Plan ID"]
+		instance.OrganizationGUID = tags["Organization ID"]
+		instance.SpaceGUID = tags["Space ID"]
+	}
 
-		dbAddress = dbInstanceDetails.Address
-		dbPort = dbInstanceDetails.Port
-		masterUsername = dbInstanceDetails.MasterUsername
-		if dbInstanceDetails.DBName != "" {
-			dbName = dbInstanceDetails.DBName
-		} else {
-			dbName = b.dbName(instanceID)
-		}
+	servicePlan, err := b.servicePlan(instanceID, instance.PlanID)
+	if err != nil {
+		return instance, servicePlan, err
 	}
 
-	sqlEngine, err := b.sqlProvider.GetSQLEngine(servicePlan.RDSProperties.Engine)
+	return instance, servicePlan, nil
+}
+
+// describeInstanceTags returns the tags of the DB Instance (or DB Cluster) of an instance
+func (b *RDSBroker) describeInstanceTags(instanceID string) (map[string]string, error) {
+	engine, err := b.describeEngine(instanceID)
 	if err != nil {
-		return bindingResponse, err
+		return nil, err
 	}
 
-	if err = sqlEngine.Open(dbAddress, dbPort, dbName, masterUsername, b.masterPassword(instanceID)); err != nil {
-		return bindingResponse, err
+	if awsrds.IsClusterEngine(engine) {
+		return b.dbCluster.DescribeTags(b.dbClusterIdentifier(instanceID))
 	}
-	defer sqlEngine.Close()
 
-	dbUsername := b.dbUsername(bindingID)
-	dbPassword := b.dbPassword()
+	return b.dbInstance.DescribeTags(b.dbInstanceIdentifier(instanceID))
+}
 
-	if bindParameters.DBName != "" {
-		dbName = bindParameters.DBName
-		if err = sqlEngine.CreateDB(dbName); err != nil {
-			return bindingResponse, err
-		}
+func (b *RDSBroker) saveInstanceState(instance statestore.InstanceDetails, servicePlan ServicePlan, operation string) error {
+	rdsProperties, err := json.Marshal(servicePlan.RDSProperties)
+	if err != nil {
+		return err
 	}
 
-	if err = sqlEngine.CreateUser(dbUsername, dbPassword); err != nil {
-		return bindingResponse, err
+	instance.PlanID = servicePlan.ID
+	instance.RDSProperties = rdsProperties
+	instance.LastOperation = statestore.OperationDetails{
+		Type:      operation,
+		StartedAt: time.Now(),
 	}
+	instance.UpdatedAt = time.Now()
 
-	if err = sqlEngine.GrantPrivileges(dbName, dbUsername); err != nil {
-		return bindingResponse, err
+	return b.stateStore.SaveInstance(instance)
+}
+
+func (b *RDSBroker) forgetDeprovisionedInstance(instanceID string) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		return
 	}
 
-	bindingResponse.Credentials = &brokerapi.CredentialsHash{
-		Host:     dbAddress,
-		Port:     dbPort,
-		Name:     dbName,
-		Username: dbUsername,
-		Password: dbPassword,
-		URI:      sqlEngine.URI(dbAddress, dbPort, dbName, dbUsername, dbPassword),
-		JDBCURI:  sqlEngine.JDBCURI(dbAddress, dbPort, dbName, dbUsername, dbPassword),
+	if instance.LastOperation.Type == statestore.OperationDeprovision {
+		if err = b.stateStore.DeleteInstance(instanceID); err != nil {
+			b.logger.Error("state-store-error", err)
+		}
 	}
+}
 
-	return bindingResponse, nil
+func (b *RDSBroker) dbClusterIdentifier(instanceID string) string {
+	return fmt.Sprintf("%s-%s", b.dbPrefix, strings.Replace(instanceID, "_", "-", -1))
 }
 
-func (b *RDSBroker) Unbind(instanceID, bindingID string, details brokerapi.UnbindDetails) error {
-	b.logger.Debug("unbind", lager.Data{
-		instanceIDLogKey: instanceID,
-		bindingIDLogKey:  bindingID,
-		detailsLogKey:    details,
-	})
+// dbClusterInstanceCount returns the number of DB Instances of the DB Cluster of a Service Plan
+func (b *RDSBroker) dbClusterInstanceCount(servicePlan ServicePlan) int {
+	// Aurora Serverless DB Clusters have no DB Instances
+	if isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) {
+		return 0
+	}
 
-	servicePlan, ok := b.catalog.FindServicePlan(details.PlanID)
-	if !ok {
-		return fmt.Errorf("Service Plan '%s' not found", details.PlanID)
+	if servicePlan.RDSProperties.InstanceCount > 1 {
+		return int(servicePlan.RDSProperties.InstanceCount)
 	}
+	return 1
+}
 
-	var dbAddress, dbName, masterUsername string
-	var dbPort int64
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
-		dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instanceID))
-		if err != nil {
-			if err == awsrds.ErrDBInstanceDoesNotExist {
-				return brokerapi.ErrInstanceDoesNotExist
-			}
-			return err
-		}
+// dbClusterMemberIdentifier returns the DB Instance identifier of the index-th member of a DB Cluster
+func (b *RDSBroker) dbClusterMemberIdentifier(instanceID string, index int) string {
+	if index == 0 {
+		return b.dbInstanceIdentifier(instanceID)
+	}
+	return fmt.Sprintf("%s-%d", b.dbInstanceIdentifier(instanceID), index)
+}
 
-		dbAddress = dbClusterDetails.Endpoint
-		dbPort = dbClusterDetails.Port
-		masterUsername = dbClusterDetails.MasterUsername
-		if dbClusterDetails.DatabaseName != "" {
-			dbName = dbClusterDetails.DatabaseName
-		} else {
-			dbName = b.dbName(instanceID)
+// dbClusterMemberIndexes returns the indexes of the additional DB Cluster members created by the broker,
+// ignoring the first DB Instance and the members of the DB Cluster owned by its read replicas
+func (b *RDSBroker) dbClusterMemberIndexes(instanceID string, dbClusterDetails awsrds.DBClusterDetails) []int {
+	indexes := []int{}
+	prefix := b.dbInstanceIdentifier(instanceID) + "-"
+	for _, dbClusterMember := range dbClusterDetails.DBClusterMembers {
+		if !strings.HasPrefix(dbClusterMember, prefix) {
+			continue
 		}
-	} else {
-		dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
-		if err != nil {
-			if err == awsrds.ErrDBInstanceDoesNotExist {
-				return brokerapi.ErrInstanceDoesNotExist
-			}
-			return err
+		index, err := strconv.Atoi(strings.TrimPrefix(dbClusterMember, prefix))
+		if err != nil || index < 1 || b.dbClusterMemberIdentifier(instanceID, index) != dbClusterMember {
+			continue
 		}
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
 
-		dbAddress = dbInstanceDetails.Address
-		dbPort = dbInstanceDetails.Port
-		masterUsername = dbInstanceDetails.MasterUsername
-		if dbInstanceDetails.DBName != "" {
-			dbName = dbInstanceDetails.DBName
-		} else {
-			dbName = b.dbName(instanceID)
-		}
+	return indexes
+}
+
+func (b *RDSBroker) dbInstanceIdentifier(instanceID string) string {
+	return fmt.Sprintf("%s-%s", b.dbPrefix, strings.Replace(instanceID, "_", "-", -1))
+}
+
+func (b *RDSBroker) masterUsername() string {
+	return utils.RandomAlphaNum(defaultUsernameLength)
+}
+
+func (b *RDSBroker) generateMasterPassword(servicePlan ServicePlan) string {
+	if isOracleEngine(servicePlan.RDSProperties.Engine) {
+		return utils.RandomAlphaNum(oracleMasterPasswordLength)
 	}
 
-	sqlEngine, err := b.sqlProvider.GetSQLEngine(servicePlan.RDSProperties.Engine)
-	if err != nil {
-		return err
+	return utils.RandomAlphaNum(defaultPasswordLength)
+}
+
+func (b *RDSBroker) masterPassword(instanceID string) (string, bool, error) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil && err != statestore.ErrInstanceNotFound {
+		return "", false, err
 	}
 
-	if err = sqlEngine.Open(dbAddress, dbPort, dbName, masterUsername, b.masterPassword(instanceID)); err != nil {
-		return err
+	if instance.MasterPassword == "" {
+		return b.legacyMasterPassword(instanceID), true, nil
 	}
-	defer sqlEngine.Close()
 
-	privileges, err := sqlEngine.Privileges()
+	masterPassword, err := b.encryptor.Decrypt(instance.MasterPassword)
 	if err != nil {
-		return err
+		return "", false, err
 	}
 
-	var userDB string
-	dbUsername := b.dbUsername(bindingID)
-	for privDBName, userNames := range privileges {
-		for _, userName := range userNames {
-			if userName == dbUsername {
-				userDB = privDBName
-				break
-			}
-		}
-	}
+	return masterPassword, false, nil
+}
 
-	if userDB != "" {
-		if err = sqlEngine.RevokePrivileges(userDB, dbUsername); err != nil {
-			return err
-		}
+func (b *RDSBroker) legacyMasterPassword(instanceID string) string {
+	return utils.GetMD5B64(instanceID, defaultPasswordLength)
+}
 
-		if userDB != dbName {
-			users := privileges[userDB]
-			if len(users) == 1 {
-				if err = sqlEngine.DropDB(userDB); err != nil {
-					return err
-				}
-			}
+// migrateMasterPassword replaces the legacy master password of an instance with a random one. It runs as a master
+// password rotation operation, so binds wait until RDS has finished resetting the master credentials
+func (b *RDSBroker) migrateMasterPassword(instanceID string, servicePlan ServicePlan) {
+	b.logger.Info("migrate-master-password", lager.Data{instanceIDLogKey: instanceID})
+
+	instance, err := b.findInstanceState(instanceID)
+	if err == nil && instance.LastOperation.State != operation.StateInProgress {
+		if instance.PendingMasterPassword, err = b.encryptor.Encrypt(b.generateMasterPassword(servicePlan)); err == nil {
+			_, err = b.startOperation(instance, servicePlan, statestore.OperationRotateMasterPassword, nil)
 		}
 	}
 
-	if err = sqlEngine.DropUser(dbUsername); err != nil {
-		return err
+	if err != nil {
+		b.logger.Error("migrate-master-password-error", err, lager.Data{instanceIDLogKey: instanceID})
 	}
-
-	return nil
 }
 
-func (b *RDSBroker) LastOperation(instanceID string) (brokerapi.LastOperationResponse, error) {
-	b.logger.Debug("last-operation", lager.Data{
-		instanceIDLogKey: instanceID,
-	})
+func (b *RDSBroker) modifyMasterPassword(instanceID string, servicePlan ServicePlan, masterPassword string) error {
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		return b.dbCluster.ModifyMasterPassword(b.dbClusterIdentifier(instanceID), masterPassword)
+	}
 
-	lastOperationResponse := brokerapi.LastOperationResponse{State: brokerapi.LastOperationFailed}
+	return b.dbInstance.ModifyMasterPassword(b.dbInstanceIdentifier(instanceID), masterPassword)
+}
 
-	dbInstanceDetails, err := b.dbInstance.Describe(b.dbInstanceIdentifier(instanceID))
-	if err != nil {
-		if err == awsrds.ErrDBInstanceDoesNotExist {
-			return lastOperationResponse, brokerapi.ErrInstanceDoesNotExist
-		}
-		return lastOperationResponse, err
+func (b *RDSBroker) resetRestoredMasterPassword(instanceID string) (bool, error) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil || !instance.ResetMasterPassword {
+		return false, nil
 	}
 
-	lastOperationResponse.Description = fmt.Sprintf("DB Instance '%s' status is '%s'", b.dbInstanceIdentifier(instanceID), dbInstanceDetails.Status)
-
-	if state, ok := rdsStatus2State[dbInstanceDetails.Status]; ok {
-		lastOperationResponse.State = state
+	servicePlan, err := b.servicePlan(instanceID, instance.PlanID)
+	if err != nil {
+		return false, err
 	}
 
-	if lastOperationResponse.State == brokerapi.LastOperationSucceeded && dbInstanceDetails.PendingModifications {
-		lastOperationResponse.State = brokerapi.LastOperationInProgress
-		lastOperationResponse.Description = fmt.Sprintf("DB Instance '%s' has pending modifications", b.dbInstanceIdentifier(instanceID))
+	masterPassword, err := b.encryptor.Decrypt(instance.PendingMasterPassword)
+	if err != nil {
+		return false, err
 	}
 
-	return lastOperationResponse, nil
-}
+	if err = b.modifyMasterPassword(instanceID, servicePlan, masterPassword); err != nil {
+		return false, err
+	}
 
-func (b *RDSBroker) dbClusterIdentifier(instanceID string) string {
-	return fmt.Sprintf("%s-%s", b.dbPrefix, strings.Replace(instanceID, "_", "-", -1))
-}
+	instance.ResetMasterPassword = false
+	instance.UpdatedAt = time.Now()
+	if err = b.stateStore.SaveInstance(instance); err != nil {
+		return false, err
+	}
 
-func (b *RDSBroker) dbInstanceIdentifier(instanceID string) string {
-	return fmt.Sprintf("%s-%s", b.dbPrefix, strings.Replace(instanceID, "_", "-", -1))
+	return true, nil
 }
 
-func (b *RDSBroker) masterUsername() string {
-	return utils.RandomAlphaNum(defaultUsernameLength)
-}
+func (b *RDSBroker) promotePendingMasterPassword(instanceID string) {
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil || instance.PendingMasterPassword == "" {
+		return
+	}
 
-func (b *RDSBroker) masterPassword(instanceID string) string {
-	return utils.GetMD5B64(instanceID, defaultPasswordLength)
+	instance.MasterPassword = instance.PendingMasterPassword
+	instance.PendingMasterPassword = ""
+	instance.UpdatedAt = time.Now()
+	if err = b.stateStore.SaveInstance(instance); err != nil {
+		b.logger.Error("state-store-error", err)
+	}
 }
 
 func (b *RDSBroker) dbUsername(bindingID string) string {
@@ -483,11 +2092,19 @@ func (b *RDSBroker) dbName(instanceID string) string {
 	return fmt.Sprintf("%s_%s", b.dbPrefix, strings.Replace(instanceID, "-", "_", -1))
 }
 
-func (b *RDSBroker) createDBCluster(instanceID string, servicePlan ServicePlan, provisionParameters ProvisionParameters, details brokerapi.ProvisionDetails) *awsrds.DBClusterDetails {
+func (b *RDSBroker) dbSnapshotIdentifier(ID string) string {
+	return fmt.Sprintf("rds-broker-%s-%s", ID, time.Now().Format("2006-01-02-15-04-05"))
+}
+
+func (b *RDSBroker) ownerRoleName(instanceID string) string {
+	return b.dbName(instanceID) + "_owner"
+}
+
+func (b *RDSBroker) createDBCluster(instanceID string, masterPassword string, servicePlan ServicePlan, provisionParameters ProvisionParameters, details brokerapi.ProvisionDetails) *awsrds.DBClusterDetails {
 	dbClusterDetails := b.dbClusterFromPlan(servicePlan)
 	dbClusterDetails.DatabaseName = b.dbName(instanceID)
 	dbClusterDetails.MasterUsername = b.masterUsername()
-	dbClusterDetails.MasterUserPassword = b.masterPassword(instanceID)
+	dbClusterDetails.MasterUserPassword = masterPassword
 
 	if provisionParameters.BackupRetentionPeriod > 0 {
 		dbClusterDetails.BackupRetentionPeriod = provisionParameters.BackupRetentionPeriod
@@ -505,7 +2122,9 @@ func (b *RDSBroker) createDBCluster(instanceID string, servicePlan ServicePlan,
 		dbClusterDetails.PreferredMaintenanceWindow = provisionParameters.PreferredMaintenanceWindow
 	}
 
-	dbClusterDetails.Tags = b.dbTags("Created", details.ServiceID, details.PlanID, details.OrganizationGUID, details.SpaceGUID)
+	dbClusterDetails.ScalingConfiguration = b.scalingConfiguration(servicePlan, provisionParameters.ScalingParameters)
+
+	dbClusterDetails.Tags = b.dbTags("Created", details.ServiceID, details.PlanID, details.OrganizationGUID, details.SpaceGUID, details.Context)
 
 	return dbClusterDetails
 }
@@ -525,14 +2144,17 @@ func (b *RDSBroker) modifyDBCluster(instanceID string, servicePlan ServicePlan,
 		dbClusterDetails.PreferredMaintenanceWindow = updateParameters.PreferredMaintenanceWindow
 	}
 
-	dbClusterDetails.Tags = b.dbTags("Updated", details.ServiceID, details.PlanID, "", "")
+	dbClusterDetails.ScalingConfiguration = b.scalingConfiguration(servicePlan, updateParameters.ScalingParameters)
+
+	dbClusterDetails.Tags = b.dbTags("Updated", details.ServiceID, details.PlanID, "", "", details.Context)
 
 	return dbClusterDetails
 }
 
 func (b *RDSBroker) dbClusterFromPlan(servicePlan ServicePlan) *awsrds.DBClusterDetails {
 	dbClusterDetails := &awsrds.DBClusterDetails{
-		Engine: servicePlan.RDSProperties.Engine,
+		Engine:     servicePlan.RDSProperties.Engine,
+		EngineMode: strings.ToLower(servicePlan.RDSProperties.EngineMode),
 	}
 
 	if servicePlan.RDSProperties.AvailabilityZone != "" {
@@ -574,15 +2196,19 @@ func (b *RDSBroker) dbClusterFromPlan(servicePlan ServicePlan) *awsrds.DBCluster
 	return dbClusterDetails
 }
 
-func (b *RDSBroker) createDBInstance(instanceID string, servicePlan ServicePlan, provisionParameters ProvisionParameters, details brokerapi.ProvisionDetails) *awsrds.DBInstanceDetails {
+func (b *RDSBroker) createDBInstance(instanceID string, masterPassword string, servicePlan ServicePlan, provisionParameters ProvisionParameters, details brokerapi.ProvisionDetails) *awsrds.DBInstanceDetails {
 	dbInstanceDetails := b.dbInstanceFromPlan(servicePlan)
 
-	if strings.ToLower(servicePlan.RDSProperties.Engine) == "aurora" {
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
 		dbInstanceDetails.DBClusterIdentifier = b.dbClusterIdentifier(instanceID)
 	} else {
-		dbInstanceDetails.DBName = b.dbName(instanceID)
 		dbInstanceDetails.MasterUsername = b.masterUsername()
-		dbInstanceDetails.MasterUserPassword = b.masterPassword(instanceID)
+		dbInstanceDetails.MasterUserPassword = masterPassword
+
+		// SQL Server DB Instances have no initial database, and Oracle ones default to the ORCL SID
+		if !isSQLServerEngine(servicePlan.RDSProperties.Engine) && !isOracleEngine(servicePlan.RDSProperties.Engine) {
+			dbInstanceDetails.DBName = b.dbName(instanceID)
+		}
 
 		if provisionParameters.BackupRetentionPeriod > 0 {
 			dbInstanceDetails.BackupRetentionPeriod = provisionParameters.BackupRetentionPeriod
@@ -605,7 +2231,7 @@ func (b *RDSBroker) createDBInstance(instanceID string, servicePlan ServicePlan,
 		dbInstanceDetails.PreferredMaintenanceWindow = provisionParameters.PreferredMaintenanceWindow
 	}
 
-	dbInstanceDetails.Tags = b.dbTags("Created", details.ServiceID, details.PlanID, details.OrganizationGUID, details.SpaceGUID)
+	dbInstanceDetails.Tags = b.dbTags("Created", details.ServiceID, details.PlanID, details.OrganizationGUID, details.SpaceGUID, details.Context)
 
 	return dbInstanceDetails
 }
@@ -613,7 +2239,7 @@ func (b *RDSBroker) createDBInstance(instanceID string, servicePlan ServicePlan,
 func (b *RDSBroker) modifyDBInstance(instanceID string, servicePlan ServicePlan, updateParameters UpdateParameters, details brokerapi.UpdateDetails) *awsrds.DBInstanceDetails {
 	dbInstanceDetails := b.dbInstanceFromPlan(servicePlan)
 
-	if strings.ToLower(servicePlan.RDSProperties.Engine) != "aurora" {
+	if !awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
 		if updateParameters.BackupRetentionPeriod > 0 {
 			dbInstanceDetails.BackupRetentionPeriod = updateParameters.BackupRetentionPeriod
 		}
@@ -627,7 +2253,7 @@ func (b *RDSBroker) modifyDBInstance(instanceID string, servicePlan ServicePlan,
 		dbInstanceDetails.PreferredMaintenanceWindow = updateParameters.PreferredMaintenanceWindow
 	}
 
-	dbInstanceDetails.Tags = b.dbTags("Updated", details.ServiceID, details.PlanID, "", "")
+	dbInstanceDetails.Tags = b.dbTags("Updated", details.ServiceID, details.PlanID, "", "", details.Context)
 
 	return dbInstanceDetails
 }
@@ -668,7 +2294,7 @@ func (b *RDSBroker) dbInstanceFromPlan(servicePlan ServicePlan) *awsrds.DBInstan
 
 	dbInstanceDetails.PubliclyAccessible = servicePlan.RDSProperties.PubliclyAccessible
 
-	if strings.ToLower(servicePlan.RDSProperties.Engine) != "aurora" {
+	if !awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
 		if servicePlan.RDSProperties.AllocatedStorage > 0 {
 			dbInstanceDetails.AllocatedStorage = servicePlan.RDSProperties.AllocatedStorage
 		}
@@ -721,8 +2347,17 @@ func (b *RDSBroker) dbInstanceFromPlan(servicePlan ServicePlan) *awsrds.DBInstan
 	return dbInstanceDetails
 }
 
-func (b *RDSBroker) dbTags(action, serviceID, planID, organizationID, spaceID string) map[string]string {
-	tags := make(map[string]string)
+func (b *RDSBroker) dbTags(action, serviceID, planID, organizationID, spaceID string, context map[string]interface{}) map[string]string {
+	requestContext := NewRequestContext(context)
+	tags := requestContext.tags()
+
+	if organizationID == "" {
+		organizationID = requestContext.OrganizationGUID
+	}
+
+	if spaceID == "" {
+		spaceID = requestContext.SpaceGUID
+	}
 
 	tags["Owner"] = "Cloud Foundry"
 
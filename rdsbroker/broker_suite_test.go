@@ -1,6 +1,9 @@
 package rdsbroker_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
@@ -11,3 +14,28 @@ func TestRDSBroker(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "RDS Broker Suite")
 }
+
+// The Oracle and SQL Server drivers are only compiled in with the "oracle" and "sqlserver" build tags,
+// so stand-ins are registered to validate the plans using those engines
+func init() {
+	registered := map[string]bool{}
+	for _, name := range sql.Drivers() {
+		registered[name] = true
+	}
+
+	for _, name := range []string{"oci8", "mssql"} {
+		if !registered[name] {
+			sql.Register(name, fakeDriver{})
+		}
+	}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("fake driver")
+}
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
@@ -2,19 +2,27 @@ package rdsbroker_test
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	. "github.com/cloudfoundry-community/pe-rds-broker/rdsbroker"
 
-	"github.com/frodenas/brokerapi"
 	"github.com/pivotal-golang/lager"
 	"github.com/pivotal-golang/lager/lagertest"
 
+	"github.com/cloudfoundry-community/pe-rds-broker/adminapi"
 	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
 	rdsfake "github.com/cloudfoundry-community/pe-rds-broker/awsrds/fakes"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	encryptionfake "github.com/cloudfoundry-community/pe-rds-broker/encryption/fakes"
+	"github.com/cloudfoundry-community/pe-rds-broker/operation"
 	sqlfake "github.com/cloudfoundry-community/pe-rds-broker/sqlengine/fakes"
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+	storefake "github.com/cloudfoundry-community/pe-rds-broker/statestore/fakes"
 )
 
 var _ = Describe("RDS Broker", func() {
@@ -31,21 +39,27 @@ var _ = Describe("RDS Broker", func() {
 
 		dbInstance *rdsfake.FakeDBInstance
 		dbCluster  *rdsfake.FakeDBCluster
+		dbEvents   *rdsfake.FakeDBEvents
 
 		sqlProvider *sqlfake.FakeProvider
 		sqlEngine   *sqlfake.FakeSQLEngine
 
+		stateStore *storefake.FakeStore
+
+		encryptor *encryptionfake.FakeEncryptor
+
 		testSink *lagertest.TestSink
 		logger   lager.Logger
 
 		rdsBroker *RDSBroker
 
-		allowUserProvisionParameters bool
-		allowUserUpdateParameters    bool
-		allowUserBindParameters      bool
-		serviceBindable              bool
-		planUpdateable               bool
-		skipFinalSnapshot            bool
+		provisionParameters map[string]ParameterConstraints
+		updateParameters    map[string]ParameterConstraints
+		bindParameters      map[string]ParameterConstraints
+		serviceBindable     bool
+		planUpdateable      bool
+		skipFinalSnapshot   bool
+		statusPolicy        map[string]string
 
 		instanceID           = "instance-id"
 		bindingID            = "binding-id"
@@ -57,20 +71,51 @@ var _ = Describe("RDS Broker", func() {
 	)
 
 	BeforeEach(func() {
-		allowUserProvisionParameters = true
-		allowUserUpdateParameters = true
-		allowUserBindParameters = true
+		provisionParameters = map[string]ParameterConstraints{
+			"auto_pause":                   {},
+			"backup_retention_period":      {},
+			"character_set_name":           {},
+			"dbname":                       {},
+			"max_capacity":                 {},
+			"min_capacity":                 {},
+			"preferred_backup_window":      {},
+			"preferred_maintenance_window": {},
+			"read_replica_of":              {},
+			"restore_from_snapshot":        {},
+			"restore_time":                 {},
+			"seconds_until_auto_pause":     {},
+			"source_instance_id":           {},
+		}
+		updateParameters = map[string]ParameterConstraints{
+			"apply_immediately":            {},
+			"backup_retention_period":      {},
+			"max_capacity":                 {},
+			"preferred_backup_window":      {},
+			"preferred_maintenance_window": {},
+			"rotate_master_password":       {},
+			"state":                        {},
+		}
+		bindParameters = map[string]ParameterConstraints{
+			"dbname": {},
+			"role":   {},
+		}
 		serviceBindable = true
 		planUpdateable = true
 		skipFinalSnapshot = true
+		statusPolicy = nil
 
 		dbInstance = &rdsfake.FakeDBInstance{}
 		dbCluster = &rdsfake.FakeDBCluster{}
+		dbEvents = &rdsfake.FakeDBEvents{}
 
 		sqlProvider = &sqlfake.FakeProvider{}
 		sqlEngine = &sqlfake.FakeSQLEngine{}
 		sqlProvider.GetSQLEngineSQLEngine = sqlEngine
 
+		stateStore = storefake.NewFakeStore()
+
+		encryptor = &encryptionfake.FakeEncryptor{}
+
 		rdsProperties1 = RDSProperties{
 			DBInstanceClass:   "db.m1.test",
 			Engine:            "test-engine-1",
@@ -90,16 +135,22 @@ var _ = Describe("RDS Broker", func() {
 
 	JustBeforeEach(func() {
 		plan1 = ServicePlan{
-			ID:            "Plan-1",
-			Name:          "Plan 1",
-			Description:   "This is the Plan 1",
-			RDSProperties: rdsProperties1,
+			ID:                  "Plan-1",
+			Name:                "Plan 1",
+			Description:         "This is the Plan 1",
+			RDSProperties:       rdsProperties1,
+			ProvisionParameters: provisionParameters,
+			UpdateParameters:    updateParameters,
+			BindParameters:      bindParameters,
 		}
 		plan2 = ServicePlan{
-			ID:            "Plan-2",
-			Name:          "Plan 2",
-			Description:   "This is the Plan 2",
-			RDSProperties: rdsProperties2,
+			ID:                  "Plan-2",
+			Name:                "Plan 2",
+			Description:         "This is the Plan 2",
+			RDSProperties:       rdsProperties2,
+			ProvisionParameters: provisionParameters,
+			UpdateParameters:    updateParameters,
+			BindParameters:      bindParameters,
 		}
 
 		service1 = Service{
@@ -124,19 +175,17 @@ var _ = Describe("RDS Broker", func() {
 		}
 
 		config = Config{
-			Region:                       "rds-region",
-			DBPrefix:                     "cf",
-			AllowUserProvisionParameters: allowUserProvisionParameters,
-			AllowUserUpdateParameters:    allowUserUpdateParameters,
-			AllowUserBindParameters:      allowUserBindParameters,
-			Catalog:                      catalog,
+			Region:       "rds-region",
+			DBPrefix:     "cf",
+			Catalog:      catalog,
+			StatusPolicy: statusPolicy,
 		}
 
 		logger = lager.NewLogger("rdsbroker_test")
 		testSink = lagertest.NewTestSink()
 		logger.RegisterSink(testSink)
 
-		rdsBroker = New(config, dbInstance, dbCluster, sqlProvider, logger)
+		rdsBroker = New(config, dbInstance, dbCluster, dbEvents, sqlProvider, stateStore, encryptor, logger)
 	})
 
 	var _ = Describe("Services", func() {
@@ -180,6 +229,9 @@ var _ = Describe("RDS Broker", func() {
 		})
 
 		It("returns the proper CatalogResponse", func() {
+			properCatalogResponse.Services[0].Plans[0].Schemas = plan1.Schemas()
+			properCatalogResponse.Services[1].Plans[0].Schemas = plan2.Schemas()
+
 			brokerCatalog := rdsBroker.Services()
 			Expect(brokerCatalog).To(Equal(properCatalogResponse))
 		})
@@ -204,11 +256,13 @@ var _ = Describe("RDS Broker", func() {
 			}
 			acceptsIncomplete = true
 
-			properProvisioningResponse = brokerapi.ProvisioningResponse{}
 		})
 
 		It("returns the proper response", func() {
 			provisioningResponse, asynch, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+			properProvisioningResponse = brokerapi.ProvisioningResponse{
+				OperationData: fmt.Sprintf("provision:%d", stateStore.Instances[instanceID].LastOperation.StartedAt.Unix()),
+			}
 			Expect(provisioningResponse).To(Equal(properProvisioningResponse))
 			Expect(asynch).To(BeTrue())
 			Expect(err).ToNot(HaveOccurred())
@@ -222,7 +276,8 @@ var _ = Describe("RDS Broker", func() {
 			Expect(dbInstance.CreateDBInstanceDetails.Engine).To(Equal("test-engine-1"))
 			Expect(dbInstance.CreateDBInstanceDetails.DBName).To(Equal(dbName))
 			Expect(dbInstance.CreateDBInstanceDetails.MasterUsername).ToNot(BeEmpty())
-			Expect(dbInstance.CreateDBInstanceDetails.MasterUserPassword).To(Equal(masterUserPassword))
+			Expect(dbInstance.CreateDBInstanceDetails.MasterUserPassword).To(HaveLen(32))
+			Expect(dbInstance.CreateDBInstanceDetails.MasterUserPassword).ToNot(Equal(masterUserPassword))
 			Expect(dbInstance.CreateDBInstanceDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
 			Expect(dbInstance.CreateDBInstanceDetails.Tags["Created by"]).To(Equal("AWS RDS Service Broker"))
 			Expect(dbInstance.CreateDBInstanceDetails.Tags).To(HaveKey("Created at"))
@@ -230,9 +285,60 @@ var _ = Describe("RDS Broker", func() {
 			Expect(dbInstance.CreateDBInstanceDetails.Tags["Plan ID"]).To(Equal("Plan-1"))
 			Expect(dbInstance.CreateDBInstanceDetails.Tags["Organization ID"]).To(Equal("organization-id"))
 			Expect(dbInstance.CreateDBInstanceDetails.Tags["Space ID"]).To(Equal("space-id"))
+			Expect(dbInstance.CreateDBInstanceDetails.Tags).ToNot(HaveKey("Platform"))
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		Context("when has a Cloud Foundry context", func() {
+			BeforeEach(func() {
+				provisionDetails.Context = map[string]interface{}{
+					"platform":          "cloudfoundry",
+					"organization_guid": "organization-id",
+					"organization_name": "my-org",
+					"space_guid":        "space-id",
+					"space_name":        "my-space",
+					"instance_name":     "my-db (prod)",
+				}
+			})
+
+			It("tags the DB Instance with the context", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Platform"]).To(Equal("cloudfoundry"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Organization Name"]).To(Equal("my-org"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Space Name"]).To(Equal("my-space"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Instance Name"]).To(Equal("my-db _prod_"))
+			})
+
+			It("saves the context", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stateStore.Instances[instanceID].Context).To(Equal(provisionDetails.Context))
+			})
+		})
+
+		Context("when has a Kubernetes context", func() {
+			BeforeEach(func() {
+				provisionDetails.OrganizationGUID = ""
+				provisionDetails.SpaceGUID = ""
+				provisionDetails.Context = map[string]interface{}{
+					"platform":  "kubernetes",
+					"namespace": "my-namespace",
+					"clusterid": "cluster-id",
+				}
+			})
+
+			It("tags the DB Instance with the context", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Platform"]).To(Equal("kubernetes"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Namespace"]).To(Equal("my-namespace"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags["Cluster ID"]).To(Equal("cluster-id"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags).ToNot(HaveKey("Organization ID"))
+				Expect(dbInstance.CreateDBInstanceDetails.Tags).ToNot(HaveKey("Space ID"))
+			})
+		})
+
 		Context("when has AllocatedStorage", func() {
 			BeforeEach(func() {
 				rdsProperties1.AllocatedStorage = int64(100)
@@ -409,6 +515,19 @@ var _ = Describe("RDS Broker", func() {
 				provisionDetails.Parameters = map[string]interface{}{"dbname": "test-dbname"}
 			})
 
+			Context("and it is not a valid database name", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters = map[string]interface{}{"dbname": "test`; DROP DATABASE mysql; --"}
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("is not valid"))
+					Expect(dbInstance.CreateCalled).To(BeFalse())
+				})
+			})
+
 			It("makes the proper calls", func() {
 				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 				Expect(dbInstance.CreateDBInstanceDetails.DBName).To(Equal("test-dbname"))
@@ -830,531 +949,972 @@ var _ = Describe("RDS Broker", func() {
 			})
 		})
 
-		Context("when request does not accept incomplete", func() {
+		Context("when has RestoreFromSnapshot parameter", func() {
 			BeforeEach(func() {
-				acceptsIncomplete = false
+				provisionDetails.Parameters = map[string]interface{}{"restore_from_snapshot": "snapshot-id"}
+				dbInstance.DescribeSnapshotDBSnapshotDetails = awsrds.DBSnapshotDetails{
+					Identifier: "snapshot-id",
+					Status:     "available",
+					Engine:     "test-engine-1",
+					Tags: map[string]string{
+						"Organization ID": "organization-id",
+						"Space ID":        "space-id",
+					},
+				}
 			})
 
-			It("returns the proper error", func() {
+			It("makes the proper calls", func() {
 				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(brokerapi.ErrAsyncRequired))
-			})
-		})
-
-		Context("when Parameters are not valid", func() {
-			BeforeEach(func() {
-				provisionDetails.Parameters = map[string]interface{}{"backup_retention_period": "invalid"}
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DescribeSnapshotCalled).To(BeTrue())
+				Expect(dbInstance.DescribeSnapshotSnapshotID).To(Equal("snapshot-id"))
+				Expect(dbInstance.RestoreFromSnapshotCalled).To(BeTrue())
+				Expect(dbInstance.RestoreFromSnapshotID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.RestoreFromSnapshotSnapshotID).To(Equal("snapshot-id"))
+				Expect(dbInstance.RestoreFromSnapshotDBInstanceDetails.DBInstanceClass).To(Equal("db.m1.test"))
+				Expect(dbInstance.RestoreFromSnapshotDBInstanceDetails.Tags["Space ID"]).To(Equal("space-id"))
+				Expect(dbInstance.CreateCalled).To(BeFalse())
 			})
 
-			It("returns the proper error", func() {
+			It("saves the master password to be reset", func() {
 				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("'backup_retention_period' expected type 'int64', got unconvertible type 'string'"))
+				Expect(err).ToNot(HaveOccurred())
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.MasterPassword).To(BeEmpty())
+				Expect(instance.PendingMasterPassword).To(Equal("encrypted:" + dbInstance.RestoreFromSnapshotDBInstanceDetails.MasterUserPassword))
+				Expect(instance.ResetMasterPassword).To(BeTrue())
 			})
 
-			Context("and user provision parameters are not allowed", func() {
+			Context("and the DB Snapshot does not exist", func() {
 				BeforeEach(func() {
-					allowUserProvisionParameters = false
+					dbInstance.DescribeSnapshotError = awsrds.ErrDBSnapshotDoesNotExist
 				})
 
-				It("does not return an error", func() {
+				It("returns the proper error", func() {
 					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-					Expect(err).ToNot(HaveOccurred())
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("DB Snapshot 'snapshot-id' not found"))
+					Expect(dbInstance.RestoreFromSnapshotCalled).To(BeFalse())
 				})
 			})
-		})
 
-		Context("when Service Plan is not found", func() {
-			BeforeEach(func() {
-				provisionDetails.PlanID = "unknown"
+			Context("and the DB Snapshot belongs to another space", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeSnapshotDBSnapshotDetails.Tags["Space ID"] = "other-space-id"
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("DB Snapshot 'snapshot-id' does not belong to the same organization and space"))
+					Expect(dbInstance.RestoreFromSnapshotCalled).To(BeFalse())
+				})
 			})
 
-			It("returns the proper error", func() {
-				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+			Context("and the DB Snapshot engine does not match", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeSnapshotDBSnapshotDetails.Engine = "test-engine-2"
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("DB Snapshot 'snapshot-id' engine 'test-engine-2' does not match the Service Plan engine 'test-engine-1'"))
+				})
 			})
-		})
 
-		Context("when creating the DB Instance fails", func() {
-			BeforeEach(func() {
-				dbInstance.CreateError = errors.New("operation failed")
+			Context("and the DB Snapshot is not available", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeSnapshotDBSnapshotDetails.Status = "creating"
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("DB Snapshot 'snapshot-id' is not available (status 'creating')"))
+				})
 			})
 
-			It("returns the proper error", func() {
-				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("operation failed"))
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties1.Engine = "aurora"
+					dbCluster.DescribeSnapshotDBSnapshotDetails = dbInstance.DescribeSnapshotDBSnapshotDetails
+					dbCluster.DescribeSnapshotDBSnapshotDetails.Engine = "aurora"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.DescribeSnapshotCalled).To(BeTrue())
+					Expect(dbCluster.RestoreFromSnapshotCalled).To(BeTrue())
+					Expect(dbCluster.RestoreFromSnapshotID).To(Equal(dbClusterIdentifier))
+					Expect(dbCluster.RestoreFromSnapshotSnapshotID).To(Equal("snapshot-id"))
+					Expect(dbCluster.CreateCalled).To(BeFalse())
+					Expect(dbInstance.CreateCalled).To(BeTrue())
+					Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal(dbClusterIdentifier))
+					Expect(dbInstance.RestoreFromSnapshotCalled).To(BeFalse())
+				})
 			})
 		})
 
-		Context("when Engine is Aurora", func() {
+		Context("when has SourceInstanceID parameter", func() {
 			BeforeEach(func() {
-				rdsProperties1.Engine = "aurora"
+				provisionDetails.Parameters = map[string]interface{}{
+					"source_instance_id": "source-instance-id",
+					"restore_time":       "2016-01-02T03:04:05Z",
+				}
+				dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+					Identifier: "cf-source-instance-id",
+					Engine:     "test-engine-1",
+				}
+				dbInstance.DescribeTagsTags = map[string]string{
+					"Organization ID": "organization-id",
+					"Space ID":        "space-id",
+				}
 			})
 
 			It("makes the proper calls", func() {
 				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-				Expect(dbCluster.CreateCalled).To(BeTrue())
-				Expect(dbCluster.CreateID).To(Equal(dbClusterIdentifier))
-				Expect(dbCluster.CreateDBClusterDetails.Engine).To(Equal("aurora"))
-				Expect(dbCluster.CreateDBClusterDetails.DatabaseName).To(Equal(dbName))
-				Expect(dbCluster.CreateDBClusterDetails.MasterUsername).ToNot(BeEmpty())
-				Expect(dbCluster.CreateDBClusterDetails.MasterUserPassword).To(Equal(masterUserPassword))
-				Expect(dbCluster.CreateDBClusterDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
-				Expect(dbCluster.CreateDBClusterDetails.Tags["Created by"]).To(Equal("AWS RDS Service Broker"))
-				Expect(dbCluster.CreateDBClusterDetails.Tags).To(HaveKey("Created at"))
-				Expect(dbCluster.CreateDBClusterDetails.Tags["Service ID"]).To(Equal("Service-1"))
-				Expect(dbCluster.CreateDBClusterDetails.Tags["Plan ID"]).To(Equal("Plan-1"))
-				Expect(dbCluster.CreateDBClusterDetails.Tags["Organization ID"]).To(Equal("organization-id"))
-				Expect(dbCluster.CreateDBClusterDetails.Tags["Space ID"]).To(Equal("space-id"))
-				Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal(dbClusterIdentifier))
-				Expect(dbCluster.DeleteCalled).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DescribeID).To(Equal("cf-source-instance-id"))
+				Expect(dbInstance.DescribeTagsID).To(Equal("cf-source-instance-id"))
+				Expect(dbInstance.RestoreToPointInTimeCalled).To(BeTrue())
+				Expect(dbInstance.RestoreToPointInTimeID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.RestoreToPointInTimeSourceID).To(Equal("cf-source-instance-id"))
+				Expect(dbInstance.RestoreToPointInTimeRestoreTime).To(Equal(time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)))
+				Expect(dbInstance.RestoreToPointInTimeDBInstanceDetails.DBInstanceClass).To(Equal("db.m1.test"))
+				Expect(dbInstance.RestoreToPointInTimeDBInstanceDetails.Tags["Space ID"]).To(Equal("space-id"))
+				Expect(dbInstance.CreateCalled).To(BeFalse())
+			})
+
+			It("saves the master password to be reset", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.MasterPassword).To(BeEmpty())
+				Expect(instance.PendingMasterPassword).ToNot(BeEmpty())
+				Expect(instance.ResetMasterPassword).To(BeTrue())
 			})
 
-			Context("when has DBClusterParameterGroupName", func() {
+			Context("and has no RestoreTime parameter", func() {
 				BeforeEach(func() {
-					rdsProperties1.DBClusterParameterGroupName = "test-db-cluster-parameter-group-name"
+					provisionDetails.Parameters = map[string]interface{}{"source_instance_id": "source-instance-id"}
 				})
 
-				It("makes the proper calls", func() {
+				It("restores to the latest restorable time", func() {
 					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
-					Expect(dbCluster.CreateDBClusterDetails.DBClusterParameterGroupName).To(Equal("test-db-cluster-parameter-group-name"))
 					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.RestoreToPointInTimeRestoreTime.IsZero()).To(BeTrue())
 				})
 			})
 
-			Context("when creating the DB Instance fails", func() {
+			Context("and the RestoreTime parameter is not valid", func() {
 				BeforeEach(func() {
-					dbInstance.CreateError = errors.New("operation failed")
+					provisionDetails.Parameters["restore_time"] = "yesterday"
 				})
 
-				It("deletes the DB Cluster", func() {
+				It("returns the proper error", func() {
 					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 					Expect(err).To(HaveOccurred())
-					Expect(dbCluster.DeleteCalled).To(BeTrue())
-					Expect(dbCluster.DeleteID).To(Equal(dbClusterIdentifier))
+					Expect(err.Error()).To(ContainSubstring("Parameter 'restore_time' is not valid"))
+					Expect(dbInstance.RestoreToPointInTimeCalled).To(BeFalse())
 				})
 			})
-		})
-	})
 
-	var _ = Describe("Update", func() {
-		var (
-			updateDetails     brokerapi.UpdateDetails
-			acceptsIncomplete bool
-		)
+			Context("and has a RestoreFromSnapshot parameter", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters["restore_from_snapshot"] = "snapshot-id"
+				})
 
-		BeforeEach(func() {
-			updateDetails = brokerapi.UpdateDetails{
-				ServiceID:  "Service-2",
-				PlanID:     "Plan-2",
-				Parameters: map[string]interface{}{},
-				PreviousValues: brokerapi.PreviousValues{
-					PlanID:         "Plan-1",
-					ServiceID:      "Service-1",
-					OrganizationID: "organization-id",
-					SpaceID:        "space-id",
-				},
-			}
-			acceptsIncomplete = true
-		})
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters 'source_instance_id' and 'restore_from_snapshot' cannot be used together"))
+				})
+			})
 
-		It("returns the proper response", func() {
-			asynch, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-			Expect(asynch).To(BeTrue())
-			Expect(err).ToNot(HaveOccurred())
-		})
+			Context("and the source DB Instance does not exist", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+				})
 
-		It("makes the proper calls", func() {
-			_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-			Expect(dbInstance.ModifyCalled).To(BeTrue())
-			Expect(dbInstance.ModifyID).To(Equal(dbInstanceIdentifier))
-			Expect(dbInstance.ModifyDBInstanceDetails.DBInstanceClass).To(Equal("db.m2.test"))
-			Expect(dbInstance.ModifyDBInstanceDetails.Engine).To(Equal("test-engine-2"))
-			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
-			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Updated by"]).To(Equal("AWS RDS Service Broker"))
-			Expect(dbInstance.ModifyDBInstanceDetails.Tags).To(HaveKey("Updated at"))
-			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Service ID"]).To(Equal("Service-2"))
-			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Plan ID"]).To(Equal("Plan-2"))
-			Expect(err).ToNot(HaveOccurred())
-		})
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Instance 'source-instance-id' not found"))
+					Expect(dbInstance.RestoreToPointInTimeCalled).To(BeFalse())
+				})
+			})
 
-		Context("when has AllocatedStorage", func() {
-			BeforeEach(func() {
-				rdsProperties2.AllocatedStorage = int64(100)
+			Context("and the source DB Instance belongs to another organization", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeTagsTags["Organization ID"] = "other-organization-id"
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Instance 'source-instance-id' does not belong to the same organization and space"))
+					Expect(dbInstance.RestoreToPointInTimeCalled).To(BeFalse())
+				})
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.AllocatedStorage).To(Equal(int64(100)))
-				Expect(err).ToNot(HaveOccurred())
+			Context("and the source DB Instance engine does not match", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeDBInstanceDetails.Engine = "test-engine-2"
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Instance 'source-instance-id' engine 'test-engine-2' does not match the Service Plan engine 'test-engine-1'"))
+				})
 			})
 
 			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					rdsProperties1.Engine = "aurora"
+					dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+						Identifier: "cf-source-instance-id",
+						Engine:     "aurora",
+					}
+					dbCluster.DescribeTagsTags = dbInstance.DescribeTagsTags
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.AllocatedStorage).To(Equal(int64(0)))
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.DescribeID).To(Equal("cf-source-instance-id"))
+					Expect(dbCluster.DescribeTagsID).To(Equal("cf-source-instance-id"))
+					Expect(dbCluster.RestoreToPointInTimeCalled).To(BeTrue())
+					Expect(dbCluster.RestoreToPointInTimeID).To(Equal(dbClusterIdentifier))
+					Expect(dbCluster.RestoreToPointInTimeSourceID).To(Equal("cf-source-instance-id"))
+					Expect(dbCluster.CreateCalled).To(BeFalse())
+					Expect(dbInstance.CreateCalled).To(BeTrue())
+					Expect(dbInstance.RestoreToPointInTimeCalled).To(BeFalse())
 				})
 			})
 		})
 
-		Context("when has AutoMinorVersionUpgrade", func() {
+		Context("when has RestoreTime parameter without SourceInstanceID", func() {
 			BeforeEach(func() {
-				rdsProperties2.AutoMinorVersionUpgrade = true
+				provisionDetails.Parameters = map[string]interface{}{"restore_time": "2016-01-02T03:04:05Z"}
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.AutoMinorVersionUpgrade).To(BeTrue())
-				Expect(err).ToNot(HaveOccurred())
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Parameter 'restore_time' requires a 'source_instance_id'"))
 			})
 		})
 
-		Context("when has AvailabilityZone", func() {
+		Context("when request does not accept incomplete", func() {
 			BeforeEach(func() {
-				rdsProperties2.AvailabilityZone = "test-az"
+				acceptsIncomplete = false
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.AvailabilityZone).To(Equal("test-az"))
-				Expect(err).ToNot(HaveOccurred())
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrAsyncRequired))
 			})
+		})
 
-			Context("when Engine is Aurora", func() {
+		Context("when Parameters are not valid", func() {
+			BeforeEach(func() {
+				provisionDetails.Parameters = map[string]interface{}{"backup_retention_period": "invalid"}
+			})
+
+			It("returns a bad request error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Parameters are not valid: backup_retention_period: Invalid type. Expected: integer, given: string"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
+				Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
+			})
+
+			Context("and provision parameters are not allowed by the Service Plan", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					provisionParameters = nil
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.AvailabilityZone).To(Equal("test-az"))
-					Expect(dbCluster.ModifyDBClusterDetails.AvailabilityZones).To(Equal([]string{"test-az"}))
-					Expect(err).ToNot(HaveOccurred())
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: (root): Additional property backup_retention_period is not allowed"))
+					Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
 				})
 			})
 		})
 
-		Context("when has BackupRetentionPeriod", func() {
+		Context("when has RDS parameters", func() {
 			BeforeEach(func() {
-				rdsProperties2.BackupRetentionPeriod = int64(7)
+				provisionParameters["allocated_storage"] = ParameterConstraints{Min: int64Pointer(100), Max: int64Pointer(500)}
+				provisionParameters["multi_az"] = ParameterConstraints{}
+				provisionParameters["storage_type"] = ParameterConstraints{Enum: []string{"gp2", "io1"}}
+				provisionDetails.Parameters = map[string]interface{}{
+					"allocated_storage": float64(300),
+					"multi_az":          true,
+					"storage_type":      "gp2",
+				}
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(7)))
+			It("overrides the Service Plan RDS Properties", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.CreateDBInstanceDetails.AllocatedStorage).To(Equal(int64(300)))
+				Expect(dbInstance.CreateDBInstanceDetails.MultiAZ).To(BeTrue())
+				Expect(dbInstance.CreateDBInstanceDetails.StorageType).To(Equal("gp2"))
+				Expect(dbInstance.CreateDBInstanceDetails.DBInstanceClass).To(Equal("db.m1.test"))
 			})
 
-			Context("when Engine is Aurora", func() {
+			Context("and a parameter is lower than its minimum", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					provisionDetails.Parameters["allocated_storage"] = float64(50)
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.BackupRetentionPeriod).To(Equal(int64(7)))
-					Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(0)))
-					Expect(err).ToNot(HaveOccurred())
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: allocated_storage: Must be greater than or equal to 100"))
+					Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
+					Expect(dbInstance.CreateCalled).To(BeFalse())
 				})
 			})
 
-			Context("but has BackupRetentionPeriod Parameter", func() {
+			Context("and a parameter is greater than its maximum", func() {
 				BeforeEach(func() {
-					updateDetails.Parameters = map[string]interface{}{"backup_retention_period": 12}
+					provisionDetails.Parameters["allocated_storage"] = float64(1000)
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(12)))
-					Expect(err).ToNot(HaveOccurred())
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: allocated_storage: Must be less than or equal to 500"))
 				})
+			})
 
-				Context("when Engine is Aurora", func() {
-					BeforeEach(func() {
-						rdsProperties2.Engine = "aurora"
-					})
+			Context("and a parameter is not an integer", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters["allocated_storage"] = 150.5
+				})
 
-					It("makes the proper calls", func() {
-						_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-						Expect(dbCluster.ModifyDBClusterDetails.BackupRetentionPeriod).To(Equal(int64(12)))
-						Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(0)))
-						Expect(err).ToNot(HaveOccurred())
-					})
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: allocated_storage: Invalid type. Expected: integer, given: number"))
 				})
 			})
-		})
 
-		Context("when has CharacterSetName", func() {
-			BeforeEach(func() {
-				rdsProperties2.CharacterSetName = "test-characterset-name"
-			})
+			Context("and a parameter is not one of its allowed values", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters["storage_type"] = "standard"
+				})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.CharacterSetName).To(Equal("test-characterset-name"))
-				Expect(err).ToNot(HaveOccurred())
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal(`Parameters are not valid: storage_type: storage_type must be one of the following: "gp2", "io1"`))
+				})
 			})
 
-			Context("when Engine is Aurora", func() {
+			Context("and a boolean parameter is not a boolean", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					provisionDetails.Parameters["multi_az"] = "yes"
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.CharacterSetName).To(Equal(""))
-					Expect(err).ToNot(HaveOccurred())
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: multi_az: Invalid type. Expected: boolean, given: string"))
 				})
 			})
 		})
 
-		Context("when has CopyTagsToSnapshot", func() {
+		Context("when Service Plan is not found", func() {
 			BeforeEach(func() {
-				rdsProperties2.CopyTagsToSnapshot = true
+				provisionDetails.PlanID = "unknown"
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.CopyTagsToSnapshot).To(BeTrue())
-				Expect(err).ToNot(HaveOccurred())
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
 			})
 		})
 
-		Context("when has DBParameterGroupName", func() {
+		It("saves the instance state", func() {
+			_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stateStore.Instances).To(HaveKey(instanceID))
+			instance := stateStore.Instances[instanceID]
+			Expect(instance.ServiceID).To(Equal("Service-1"))
+			Expect(instance.PlanID).To(Equal("Plan-1"))
+			Expect(instance.OrganizationGUID).To(Equal("organization-id"))
+			Expect(instance.SpaceGUID).To(Equal("space-id"))
+			Expect(instance.RDSProperties).ToNot(BeEmpty())
+			Expect(instance.LastOperation.Type).To(Equal(statestore.OperationProvision))
+			Expect(instance.LastOperation.State).To(Equal(operation.StateInProgress))
+			Expect(instance.LastOperation.Step).To(Equal("wait-available"))
+		})
+
+		It("saves the encrypted master password", func() {
+			_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encryptor.EncryptCalled).To(BeTrue())
+			Expect(encryptor.EncryptPlaintext).To(Equal(dbInstance.CreateDBInstanceDetails.MasterUserPassword))
+			Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:" + dbInstance.CreateDBInstanceDetails.MasterUserPassword))
+		})
+
+		Context("when encrypting the master password fails", func() {
 			BeforeEach(func() {
-				rdsProperties2.DBParameterGroupName = "test-db-parameter-group-name"
+				encryptor.EncryptError = errors.New("Failed to encrypt")
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.DBParameterGroupName).To(Equal("test-db-parameter-group-name"))
-				Expect(err).ToNot(HaveOccurred())
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to encrypt"))
+				Expect(dbInstance.CreateCalled).To(BeFalse())
 			})
 		})
 
-		Context("when has DBSecurityGroups", func() {
+		Context("when saving the instance state fails", func() {
 			BeforeEach(func() {
-				rdsProperties2.DBSecurityGroups = []string{"test-db-security-group"}
+				stateStore.SaveInstanceError = errors.New("Failed to save instance")
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.DBSecurityGroups).To(Equal([]string{"test-db-security-group"}))
-				Expect(err).ToNot(HaveOccurred())
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to save instance"))
+				Expect(dbInstance.CreateCalled).To(BeFalse())
 			})
+		})
 
-			Context("when Engine is Aurora", func() {
-				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
-				})
+		Context("when creating the DB Instance fails", func() {
+			BeforeEach(func() {
+				dbInstance.CreateError = errors.New("operation failed")
+			})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.DBSecurityGroups).To(BeNil())
-					Expect(err).ToNot(HaveOccurred())
-				})
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			It("deletes the instance state", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
 			})
 		})
 
-		Context("when has DBSubnetGroupName", func() {
+		Context("when creating the DB Instance fails with a transient error", func() {
 			BeforeEach(func() {
-				rdsProperties2.DBSubnetGroupName = "test-db-subnet-group-name"
+				dbInstance.CreateError = errors.New("Throttling: Rate exceeded")
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.DBSubnetGroupName).To(Equal("test-db-subnet-group-name"))
+			It("retries the step later", func() {
+				_, asynch, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
-			})
-
-			Context("when Engine is Aurora", func() {
-				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
-				})
-
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.DBSubnetGroupName).To(Equal("test-db-subnet-group-name"))
-					Expect(err).ToNot(HaveOccurred())
-				})
+				Expect(asynch).To(BeTrue())
+				Expect(stateStore.Instances).To(HaveKey(instanceID))
+				lastOperation := stateStore.Instances[instanceID].LastOperation
+				Expect(lastOperation.State).To(Equal(operation.StateInProgress))
+				Expect(lastOperation.Step).To(Equal("create-db-instance"))
+				Expect(lastOperation.Attempts).To(Equal(1))
 			})
 		})
 
-		Context("when has EngineVersion", func() {
+		Context("when Engine is Aurora", func() {
 			BeforeEach(func() {
-				rdsProperties2.EngineVersion = "1.2.3"
+				rdsProperties1.Engine = "aurora"
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.EngineVersion).To(Equal("1.2.3"))
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(dbCluster.CreateCalled).To(BeTrue())
+				Expect(dbCluster.CreateID).To(Equal(dbClusterIdentifier))
+				Expect(dbCluster.CreateDBClusterDetails.Engine).To(Equal("aurora"))
+				Expect(dbCluster.CreateDBClusterDetails.DatabaseName).To(Equal(dbName))
+				Expect(dbCluster.CreateDBClusterDetails.MasterUsername).ToNot(BeEmpty())
+				Expect(dbCluster.CreateDBClusterDetails.MasterUserPassword).To(HaveLen(32))
+				Expect(dbCluster.CreateDBClusterDetails.MasterUserPassword).ToNot(Equal(masterUserPassword))
+				Expect(dbCluster.CreateDBClusterDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
+				Expect(dbCluster.CreateDBClusterDetails.Tags["Created by"]).To(Equal("AWS RDS Service Broker"))
+				Expect(dbCluster.CreateDBClusterDetails.Tags).To(HaveKey("Created at"))
+				Expect(dbCluster.CreateDBClusterDetails.Tags["Service ID"]).To(Equal("Service-1"))
+				Expect(dbCluster.CreateDBClusterDetails.Tags["Plan ID"]).To(Equal("Plan-1"))
+				Expect(dbCluster.CreateDBClusterDetails.Tags["Organization ID"]).To(Equal("organization-id"))
+				Expect(dbCluster.CreateDBClusterDetails.Tags["Space ID"]).To(Equal("space-id"))
+				Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal(dbClusterIdentifier))
+				Expect(dbInstance.CreateIDs).To(Equal([]string{dbInstanceIdentifier}))
+				Expect(dbCluster.DeleteCalled).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("when Engine is Aurora", func() {
+			Context("when has InstanceCount", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					rdsProperties1.InstanceCount = 3
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.EngineVersion).To(Equal("1.2.3"))
-					Expect(dbCluster.ModifyDBClusterDetails.EngineVersion).To(Equal("1.2.3"))
+				It("creates all DB Cluster members", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.CreateIDs).To(Equal([]string{dbInstanceIdentifier, dbInstanceIdentifier + "-1", dbInstanceIdentifier + "-2"}))
+					Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal(dbClusterIdentifier))
 				})
 			})
-		})
 
-		Context("when has Iops", func() {
-			BeforeEach(func() {
-				rdsProperties2.Iops = int64(1000)
+			Context("when EngineMode is serverless", func() {
+				BeforeEach(func() {
+					rdsProperties1.EngineMode = "serverless"
+					rdsProperties1.MinCapacity = 2
+					rdsProperties1.MaxCapacity = 8
+					rdsProperties1.AutoPause = true
+				})
+
+				It("creates a serverless DB Cluster without DB Instances", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.CreateCalled).To(BeTrue())
+					Expect(dbCluster.CreateDBClusterDetails.EngineMode).To(Equal("serverless"))
+					Expect(dbCluster.CreateDBClusterDetails.ScalingConfiguration).To(Equal(&awsrds.ScalingConfiguration{
+						MinCapacity: 2,
+						MaxCapacity: 8,
+						AutoPause:   true,
+					}))
+					Expect(dbInstance.CreateCalled).To(BeFalse())
+				})
+
+				Context("and has scaling parameters", func() {
+					BeforeEach(func() {
+						provisionDetails.Parameters = map[string]interface{}{
+							"max_capacity":             16,
+							"auto_pause":               false,
+							"seconds_until_auto_pause": 600,
+						}
+					})
+
+					It("overrides the Service Plan scaling configuration", func() {
+						_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dbCluster.CreateDBClusterDetails.ScalingConfiguration).To(Equal(&awsrds.ScalingConfiguration{
+							MinCapacity:           2,
+							MaxCapacity:           16,
+							AutoPause:             false,
+							SecondsUntilAutoPause: 600,
+						}))
+					})
+				})
+
+				Context("and has a MaxCapacity parameter lower than the MinCapacity", func() {
+					BeforeEach(func() {
+						provisionDetails.Parameters = map[string]interface{}{"max_capacity": 1}
+					})
+
+					It("returns the proper error", func() {
+						_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(Equal("MinCapacity 2 must not be greater than MaxCapacity 1"))
+						Expect(dbCluster.CreateCalled).To(BeFalse())
+					})
+				})
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.Iops).To(Equal(int64(1000)))
-				Expect(err).ToNot(HaveOccurred())
+			Context("when has scaling parameters and EngineMode is not serverless", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters = map[string]interface{}{"min_capacity": 2}
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("Service Plan 'Plan-1' is not serverless"))
+					Expect(dbCluster.CreateCalled).To(BeFalse())
+				})
 			})
 
-			Context("when Engine is Aurora", func() {
+			Context("when has DBClusterParameterGroupName", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					rdsProperties1.DBClusterParameterGroupName = "test-db-cluster-parameter-group-name"
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.Iops).To(Equal(int64(0)))
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(dbCluster.CreateDBClusterDetails.DBClusterParameterGroupName).To(Equal("test-db-cluster-parameter-group-name"))
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
-		})
 
-		Context("when has KmsKeyID", func() {
-			BeforeEach(func() {
-				rdsProperties2.KmsKeyID = "test-kms-key-id"
-			})
+			Context("when creating the DB Instance fails", func() {
+				BeforeEach(func() {
+					rdsProperties1.SkipFinalSnapshot = false
+					dbInstance.CreateError = errors.New("operation failed")
+				})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.KmsKeyID).To(Equal("test-kms-key-id"))
-				Expect(err).ToNot(HaveOccurred())
+				It("deletes the DB Cluster without a final snapshot", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(dbCluster.DeleteCalled).To(BeTrue())
+					Expect(dbCluster.DeleteID).To(Equal(dbClusterIdentifier))
+					Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeTrue())
+					Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
+				})
 			})
 
-			Context("when Engine is Aurora", func() {
+			Context("when creating a DB Cluster member fails after the first ones", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					rdsProperties1.InstanceCount = 3
+					dbInstance.CreateError = errors.New("operation failed")
+					dbInstance.CreateErrorAfter = 2
+					dbInstance.DescribeDBInstanceDetails.Status = "deleting"
+					dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+						Identifier:       dbClusterIdentifier,
+						Engine:           "aurora",
+						Status:           "available",
+						DBClusterMembers: []string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"},
+					}
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.KmsKeyID).To(Equal(""))
-					Expect(err).ToNot(HaveOccurred())
+				It("deletes the DB Cluster members already created", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+					Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier + "-1", dbInstanceIdentifier}))
 				})
-			})
-		})
 
-		Context("when has LicenseModel", func() {
-			BeforeEach(func() {
-				rdsProperties2.LicenseModel = "test-license-model"
-			})
+				It("does not delete the DB Cluster until its members are gone", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(dbCluster.DeleteCalled).To(BeFalse())
+					lastOperation := stateStore.Instances[instanceID].LastOperation
+					Expect(lastOperation.State).To(Equal(operation.StateInProgress))
+					Expect(lastOperation.RollbackStep).To(Equal("create-db-cluster"))
+					Expect(lastOperation.Description).To(Equal("Creating DB Instance '" + dbInstanceIdentifier + "-2' failed: operation failed"))
+				})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.LicenseModel).To(Equal("test-license-model"))
-				Expect(err).ToNot(HaveOccurred())
+				Context("and the DB Cluster members are gone", func() {
+					JustBeforeEach(func() {
+						_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{}
+					})
+
+					It("deletes the DB Cluster without a final snapshot in the background", func() {
+						rdsBroker.RunOperations()
+						Expect(dbCluster.DeleteCalled).To(BeTrue())
+						Expect(dbCluster.DeleteID).To(Equal(dbClusterIdentifier))
+						Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeTrue())
+						Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier + "-1", dbInstanceIdentifier}))
+						lastOperation := stateStore.Instances[instanceID].LastOperation
+						Expect(lastOperation.State).To(Equal(operation.StateFailed))
+						Expect(lastOperation.RollbackStep).To(BeEmpty())
+						Expect(lastOperation.Description).To(Equal("Creating DB Instance '" + dbInstanceIdentifier + "-2' failed: operation failed"))
+					})
+				})
 			})
 
-			Context("when Engine is Aurora", func() {
+			Context("when a DB Cluster with several members fails to become available", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					rdsProperties1.InstanceCount = 2
+					dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+						Identifier:       dbClusterIdentifier,
+						Engine:           "aurora",
+						Status:           "failed",
+						DBClusterMembers: []string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"},
+					}
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.LicenseModel).To(Equal(""))
+				JustBeforeEach(func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+						Identifier:          dbInstanceIdentifier,
+						Engine:              "aurora",
+						DBClusterIdentifier: dbClusterIdentifier,
+						Status:              "deleting",
+					}
+				})
+
+				It("deletes the DB Cluster members, waiting for them to be gone before deleting the DB Cluster", func() {
+					rdsBroker.RunOperations()
+					Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier + "-1", dbInstanceIdentifier}))
+					Expect(dbCluster.DeleteCalled).To(BeFalse())
+					lastOperation := stateStore.Instances[instanceID].LastOperation
+					Expect(lastOperation.State).To(Equal(operation.StateInProgress))
+					Expect(lastOperation.Step).To(Equal("wait-available"))
+					Expect(lastOperation.RollbackStep).To(Equal("create-db-cluster"))
+
+					dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{}
+					rdsBroker.RunOperations()
+					Expect(dbInstance.DeleteIDs).To(HaveLen(2))
+					Expect(dbCluster.DeleteCalled).To(BeTrue())
+					Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeTrue())
+					lastOperation = stateStore.Instances[instanceID].LastOperation
+					Expect(lastOperation.State).To(Equal(operation.StateFailed))
+					Expect(lastOperation.Description).To(HavePrefix("Waiting for DB Cluster '" + dbClusterIdentifier + "' to be available failed: DB Cluster '" + dbClusterIdentifier + "' status is 'failed'"))
+				})
+
+				It("reports the operation in progress while rolling back", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, brokerapi.PollDetails{ServiceID: "Service-1", PlanID: "Plan-1"})
 					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
 				})
 			})
 		})
 
-		Context("when has MultiAZ", func() {
+		Context("when Engine is Aurora PostgreSQL", func() {
 			BeforeEach(func() {
-				rdsProperties2.MultiAZ = true
+				rdsProperties1.Engine = "aurora-postgresql"
 			})
 
-			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.MultiAZ).To(BeTrue())
+			It("creates a DB Cluster", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.CreateCalled).To(BeTrue())
+				Expect(dbCluster.CreateDBClusterDetails.Engine).To(Equal("aurora-postgresql"))
+				Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal(dbClusterIdentifier))
 			})
+		})
 
-			Context("when Engine is Aurora", func() {
+		Context("when Engine is SQL Server", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "sqlserver-ex"
+			})
+
+			It("does not set a DBName", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.CreateDBInstanceDetails.DBName).To(BeEmpty())
+			})
+
+			Context("and has DBName parameter", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					provisionDetails.Parameters = map[string]interface{}{"dbname": "test-dbname"}
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.MultiAZ).To(BeFalse())
-					Expect(err).ToNot(HaveOccurred())
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("This broker does not support the DBName parameter for RDS engine 'sqlserver-ex'"))
+					Expect(dbInstance.CreateCalled).To(BeFalse())
 				})
 			})
 		})
 
-		Context("when has OptionGroupName", func() {
+		Context("when Engine is Oracle", func() {
 			BeforeEach(func() {
-				rdsProperties2.OptionGroupName = "test-option-group-name"
+				rdsProperties1.Engine = "oracle-se2"
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.OptionGroupName).To(Equal("test-option-group-name"))
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.CreateDBInstanceDetails.DBName).To(BeEmpty())
+				Expect(dbInstance.CreateDBInstanceDetails.MasterUserPassword).To(HaveLen(30))
+			})
+
+			Context("and has DBName parameter", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters = map[string]interface{}{"dbname": "ORCL1"}
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.CreateDBInstanceDetails.DBName).To(Equal("ORCL1"))
+				})
+			})
+
+			Context("and has a DBName parameter which is not a valid SID", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters = map[string]interface{}{"dbname": "test_dbname"}
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("Database name 'test_dbname' is not valid for Oracle"))
+				})
 			})
 		})
 
-		Context("when has Port", func() {
+		Context("when the Service Plan is a read replica plan", func() {
 			BeforeEach(func() {
-				rdsProperties2.Port = int64(3306)
+				rdsProperties1.ReadReplica = true
+				provisionDetails.Parameters = map[string]interface{}{"read_replica_of": "source-instance-id"}
+				dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+					Identifier: "cf-source-instance-id",
+					Engine:     "test-engine-1",
+				}
+				dbInstance.DescribeTagsTags = map[string]string{
+					"Organization ID": "organization-id",
+					"Space ID":        "space-id",
+				}
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.Port).To(Equal(int64(3306)))
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DescribeTagsID).To(Equal("cf-source-instance-id"))
+				Expect(dbInstance.CreateReadReplicaCalled).To(BeTrue())
+				Expect(dbInstance.CreateReadReplicaID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.CreateReadReplicaSourceID).To(Equal("cf-source-instance-id"))
+				Expect(dbInstance.CreateReadReplicaDBInstanceDetails.DBInstanceClass).To(Equal("db.m1.test"))
+				Expect(dbInstance.CreateReadReplicaDBInstanceDetails.Tags["Space ID"]).To(Equal("space-id"))
+				Expect(dbInstance.CreateCalled).To(BeFalse())
+			})
+
+			It("saves the instance state", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.ReadReplicaOf).To(Equal("source-instance-id"))
+				Expect(instance.MasterPassword).To(BeEmpty())
+			})
+
+			Context("and has no ReadReplicaOf parameter", func() {
+				BeforeEach(func() {
+					provisionDetails.Parameters = map[string]interface{}{}
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Plan 'Plan-1' requires a 'read_replica_of' parameter"))
+				})
+			})
+
+			Context("and the source DB Instance belongs to another space", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeTagsTags["Space ID"] = "other-space-id"
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Instance 'source-instance-id' does not belong to the same organization and space"))
+					Expect(dbInstance.CreateReadReplicaCalled).To(BeFalse())
+				})
 			})
 
 			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
+					rdsProperties1.Engine = "aurora"
+					dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+						Identifier: "cf-source-instance-id",
+						Engine:     "aurora",
+					}
+					dbCluster.DescribeTagsTags = dbInstance.DescribeTagsTags
 				})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.Port).To(Equal(int64(3306)))
-					Expect(dbInstance.ModifyDBInstanceDetails.Port).To(Equal(int64(0)))
+				It("adds a DB Instance to the source DB Cluster", func() {
+					_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
 					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.CreateCalled).To(BeFalse())
+					Expect(dbInstance.CreateCalled).To(BeTrue())
+					Expect(dbInstance.CreateID).To(Equal(dbInstanceIdentifier))
+					Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal("cf-source-instance-id"))
+					Expect(dbInstance.CreateReadReplicaCalled).To(BeFalse())
 				})
 			})
 		})
 
-		Context("when has PreferredBackupWindow", func() {
+		Context("when has ReadReplicaOf parameter but the Service Plan is not a read replica plan", func() {
 			BeforeEach(func() {
-				rdsProperties2.PreferredBackupWindow = "test-preferred-backup-window"
+				provisionDetails.Parameters = map[string]interface{}{"read_replica_of": "source-instance-id"}
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Provision(instanceID, provisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Plan 'Plan-1' does not support read replicas"))
+			})
+		})
+	})
+
+	var _ = Describe("Update", func() {
+		var (
+			updateDetails     brokerapi.UpdateDetails
+			acceptsIncomplete bool
+		)
+
+		BeforeEach(func() {
+			updateDetails = brokerapi.UpdateDetails{
+				ServiceID:  "Service-2",
+				PlanID:     "Plan-2",
+				Parameters: map[string]interface{}{},
+				PreviousValues: brokerapi.PreviousValues{
+					PlanID:         "Plan-1",
+					ServiceID:      "Service-1",
+					OrganizationID: "organization-id",
+					SpaceID:        "space-id",
+				},
+			}
+			acceptsIncomplete = true
+		})
+
+		It("returns the proper response", func() {
+			updateResponse, asynch, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+			Expect(updateResponse.OperationData).To(Equal(fmt.Sprintf("update:%d", stateStore.Instances[instanceID].LastOperation.StartedAt.Unix())))
+			Expect(asynch).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("makes the proper calls", func() {
+			_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+			Expect(dbInstance.ModifyCalled).To(BeTrue())
+			Expect(dbInstance.ModifyID).To(Equal(dbInstanceIdentifier))
+			Expect(dbInstance.ModifyDBInstanceDetails.DBInstanceClass).To(Equal("db.m2.test"))
+			Expect(dbInstance.ModifyDBInstanceDetails.Engine).To(Equal("test-engine-2"))
+			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
+			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Updated by"]).To(Equal("AWS RDS Service Broker"))
+			Expect(dbInstance.ModifyDBInstanceDetails.Tags).To(HaveKey("Updated at"))
+			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Service ID"]).To(Equal("Service-2"))
+			Expect(dbInstance.ModifyDBInstanceDetails.Tags["Plan ID"]).To(Equal("Plan-2"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when has a context", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:      instanceID,
+					PlanID:  "Plan-1",
+					Context: map[string]interface{}{"platform": "cloudfoundry", "space_name": "old-space"},
+				}
+				updateDetails.Context = map[string]interface{}{
+					"platform":          "cloudfoundry",
+					"organization_guid": "organization-id",
+					"space_guid":        "space-id",
+					"space_name":        "new-space",
+				}
+			})
+
+			It("tags the DB Instance with the context", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyDBInstanceDetails.Tags["Space Name"]).To(Equal("new-space"))
+				Expect(dbInstance.ModifyDBInstanceDetails.Tags["Organization ID"]).To(Equal("organization-id"))
+				Expect(dbInstance.ModifyDBInstanceDetails.Tags["Space ID"]).To(Equal("space-id"))
+			})
+
+			It("saves the context", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stateStore.Instances[instanceID].Context).To(Equal(updateDetails.Context))
+			})
+		})
+
+		Context("when has AllocatedStorage", func() {
+			BeforeEach(func() {
+				rdsProperties2.AllocatedStorage = int64(100)
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window"))
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.AllocatedStorage).To(Equal(int64(100)))
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1364,47 +1924,58 @@ var _ = Describe("RDS Broker", func() {
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window"))
-					Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal(""))
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.AllocatedStorage).To(Equal(int64(0)))
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
+		})
+
+		Context("when has AutoMinorVersionUpgrade", func() {
+			BeforeEach(func() {
+				rdsProperties2.AutoMinorVersionUpgrade = true
+			})
 
-			Context("but has PreferredBackupWindow Parameter", func() {
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.AutoMinorVersionUpgrade).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when has AvailabilityZone", func() {
+			BeforeEach(func() {
+				rdsProperties2.AvailabilityZone = "test-az"
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.AvailabilityZone).To(Equal("test-az"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					updateDetails.Parameters = map[string]interface{}{"preferred_backup_window": "test-preferred-backup-window-parameter"}
+					rdsProperties2.Engine = "aurora"
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window-parameter"))
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.AvailabilityZone).To(Equal("test-az"))
+					Expect(dbCluster.ModifyDBClusterDetails.AvailabilityZones).To(Equal([]string{"test-az"}))
 					Expect(err).ToNot(HaveOccurred())
 				})
-
-				Context("when Engine is Aurora", func() {
-					BeforeEach(func() {
-						rdsProperties2.Engine = "aurora"
-					})
-
-					It("makes the proper calls", func() {
-						_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-						Expect(dbCluster.ModifyDBClusterDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window-parameter"))
-						Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal(""))
-						Expect(err).ToNot(HaveOccurred())
-					})
-				})
 			})
 		})
 
-		Context("when has PreferredMaintenanceWindow", func() {
+		Context("when has BackupRetentionPeriod", func() {
 			BeforeEach(func() {
-				rdsProperties2.PreferredMaintenanceWindow = "test-preferred-maintenance-window"
+				rdsProperties2.BackupRetentionPeriod = int64(7)
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window"))
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(7)))
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1414,20 +1985,21 @@ var _ = Describe("RDS Broker", func() {
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window"))
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.BackupRetentionPeriod).To(Equal(int64(7)))
+					Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(0)))
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 
-			Context("but has PreferredMaintenanceWindow Parameter", func() {
+			Context("but has BackupRetentionPeriod Parameter", func() {
 				BeforeEach(func() {
-					updateDetails.Parameters = map[string]interface{}{"preferred_maintenance_window": "test-preferred-maintenance-window-parameter"}
+					updateDetails.Parameters = map[string]interface{}{"backup_retention_period": 12}
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window-parameter"))
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(12)))
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1437,58 +2009,71 @@ var _ = Describe("RDS Broker", func() {
 					})
 
 					It("makes the proper calls", func() {
-						_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-						Expect(dbCluster.ModifyDBClusterDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window-parameter"))
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+						Expect(dbCluster.ModifyDBClusterDetails.BackupRetentionPeriod).To(Equal(int64(12)))
+						Expect(dbInstance.ModifyDBInstanceDetails.BackupRetentionPeriod).To(Equal(int64(0)))
 						Expect(err).ToNot(HaveOccurred())
 					})
 				})
 			})
 		})
 
-		Context("when has PubliclyAccessible", func() {
+		Context("when has CharacterSetName", func() {
 			BeforeEach(func() {
-				rdsProperties2.PubliclyAccessible = true
+				rdsProperties2.CharacterSetName = "test-characterset-name"
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.PubliclyAccessible).To(BeTrue())
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.CharacterSetName).To(Equal("test-characterset-name"))
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.CharacterSetName).To(Equal(""))
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
 		})
 
-		Context("when has StorageEncrypted", func() {
+		Context("when has CopyTagsToSnapshot", func() {
 			BeforeEach(func() {
-				rdsProperties2.StorageEncrypted = true
+				rdsProperties2.CopyTagsToSnapshot = true
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.StorageEncrypted).To(BeTrue())
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.CopyTagsToSnapshot).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
 			})
+		})
 
-			Context("when Engine is Aurora", func() {
-				BeforeEach(func() {
-					rdsProperties2.Engine = "aurora"
-				})
+		Context("when has DBParameterGroupName", func() {
+			BeforeEach(func() {
+				rdsProperties2.DBParameterGroupName = "test-db-parameter-group-name"
+			})
 
-				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.StorageEncrypted).To(BeFalse())
-					Expect(err).ToNot(HaveOccurred())
-				})
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.DBParameterGroupName).To(Equal("test-db-parameter-group-name"))
+				Expect(err).ToNot(HaveOccurred())
 			})
 		})
 
-		Context("when has StorageType", func() {
+		Context("when has DBSecurityGroups", func() {
 			BeforeEach(func() {
-				rdsProperties2.StorageType = "test-storage-type"
+				rdsProperties2.DBSecurityGroups = []string{"test-db-security-group"}
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.StorageType).To(Equal("test-storage-type"))
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.DBSecurityGroups).To(Equal([]string{"test-db-security-group"}))
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1498,21 +2083,21 @@ var _ = Describe("RDS Broker", func() {
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbInstance.ModifyDBInstanceDetails.StorageType).To(Equal(""))
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.DBSecurityGroups).To(BeNil())
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
-		Context("when has VpcSecurityGroupIds", func() {
+		Context("when has DBSubnetGroupName", func() {
 			BeforeEach(func() {
-				rdsProperties2.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
+				rdsProperties2.DBSubnetGroupName = "test-db-subnet-group-name"
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbInstance.ModifyDBInstanceDetails.VpcSecurityGroupIds).To(Equal([]string{"test-vpc-security-group-ids"}))
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.DBSubnetGroupName).To(Equal("test-db-subnet-group-name"))
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1522,890 +2107,4341 @@ var _ = Describe("RDS Broker", func() {
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.VpcSecurityGroupIds).To(Equal([]string{"test-vpc-security-group-ids"}))
-					Expect(dbInstance.ModifyDBInstanceDetails.VpcSecurityGroupIds).To(BeNil())
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.DBSubnetGroupName).To(Equal("test-db-subnet-group-name"))
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
-		Context("when request does not accept incomplete", func() {
+		Context("when has EngineVersion", func() {
 			BeforeEach(func() {
-				acceptsIncomplete = false
+				rdsProperties2.EngineVersion = "1.2.3"
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(brokerapi.ErrAsyncRequired))
-			})
-		})
-
-		Context("when Parameters are not valid", func() {
-			BeforeEach(func() {
-				updateDetails.Parameters = map[string]interface{}{"backup_retention_period": "invalid"}
-			})
-
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("'backup_retention_period' expected type 'int64', got unconvertible type 'string'"))
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.EngineVersion).To(Equal("1.2.3"))
+				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("and user update parameters are not allowed", func() {
+			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					allowUserUpdateParameters = false
+					rdsProperties2.Engine = "aurora"
 				})
 
-				It("does not return an error", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.EngineVersion).To(Equal("1.2.3"))
+					Expect(dbCluster.ModifyDBClusterDetails.EngineVersion).To(Equal("1.2.3"))
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
-		Context("when Service is not found", func() {
+		Context("when has Iops", func() {
 			BeforeEach(func() {
-				updateDetails.ServiceID = "unknown"
+				rdsProperties2.Iops = int64(1000)
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Service 'unknown' not found"))
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.Iops).To(Equal(int64(1000)))
+				Expect(err).ToNot(HaveOccurred())
 			})
-		})
 
-		Context("when Plans is not updateable", func() {
-			BeforeEach(func() {
-				planUpdateable = false
-			})
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(brokerapi.ErrInstanceNotUpdateable))
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.Iops).To(Equal(int64(0)))
+					Expect(err).ToNot(HaveOccurred())
+				})
 			})
 		})
 
-		Context("when Service Plan is not found", func() {
+		Context("when has KmsKeyID", func() {
 			BeforeEach(func() {
-				updateDetails.PlanID = "unknown"
+				rdsProperties2.KmsKeyID = "test-kms-key-id"
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.KmsKeyID).To(Equal("test-kms-key-id"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.KmsKeyID).To(Equal(""))
+					Expect(err).ToNot(HaveOccurred())
+				})
 			})
 		})
 
-		Context("when modifying the DB Instance fails", func() {
+		Context("when has LicenseModel", func() {
 			BeforeEach(func() {
-				dbInstance.ModifyError = errors.New("operation failed")
+				rdsProperties2.LicenseModel = "test-license-model"
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("operation failed"))
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.LicenseModel).To(Equal("test-license-model"))
+				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("when the DB Instance does not exists", func() {
+			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					dbInstance.ModifyError = awsrds.ErrDBInstanceDoesNotExist
+					rdsProperties2.Engine = "aurora"
 				})
 
-				It("returns the proper error", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(err).To(HaveOccurred())
-					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.LicenseModel).To(Equal(""))
+					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
-		Context("when Engine is Aurora", func() {
+		Context("when has MultiAZ", func() {
 			BeforeEach(func() {
-				rdsProperties2.Engine = "aurora"
+				rdsProperties2.MultiAZ = true
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-				Expect(dbCluster.ModifyCalled).To(BeTrue())
-				Expect(dbCluster.ModifyID).To(Equal(dbClusterIdentifier))
-				Expect(dbCluster.ModifyDBClusterDetails.Engine).To(Equal("aurora"))
-				Expect(dbCluster.ModifyDBClusterDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
-				Expect(dbCluster.ModifyDBClusterDetails.Tags["Updated by"]).To(Equal("AWS RDS Service Broker"))
-				Expect(dbCluster.ModifyDBClusterDetails.Tags).To(HaveKey("Updated at"))
-				Expect(dbCluster.ModifyDBClusterDetails.Tags["Service ID"]).To(Equal("Service-2"))
-				Expect(dbCluster.ModifyDBClusterDetails.Tags["Plan ID"]).To(Equal("Plan-2"))
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.MultiAZ).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("when has DBClusterParameterGroupName", func() {
+			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					rdsProperties2.DBClusterParameterGroupName = "test-db-cluster-parameter-group-name"
+					rdsProperties2.Engine = "aurora"
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
-					Expect(dbCluster.ModifyDBClusterDetails.DBClusterParameterGroupName).To(Equal("test-db-cluster-parameter-group-name"))
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.MultiAZ).To(BeFalse())
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
-	})
-
-	var _ = Describe("Deprovision", func() {
-		var (
-			deprovisionDetails brokerapi.DeprovisionDetails
-			acceptsIncomplete  bool
-		)
-
-		BeforeEach(func() {
-			deprovisionDetails = brokerapi.DeprovisionDetails{
-				ServiceID: "Service-1",
-				PlanID:    "Plan-1",
-			}
-			acceptsIncomplete = true
-		})
-
-		It("returns the proper response", func() {
-			asynch, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-			Expect(asynch).To(BeTrue())
-			Expect(err).ToNot(HaveOccurred())
-		})
-
-		It("makes the proper calls", func() {
-			_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-			Expect(dbInstance.DeleteCalled).To(BeTrue())
-			Expect(dbInstance.DeleteID).To(Equal(dbInstanceIdentifier))
-			Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
-			Expect(err).ToNot(HaveOccurred())
-		})
 
-		Context("when it does not skip final snaphot", func() {
+		Context("when has OptionGroupName", func() {
 			BeforeEach(func() {
-				rdsProperties1.SkipFinalSnapshot = false
+				rdsProperties2.OptionGroupName = "test-option-group-name"
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-				Expect(dbInstance.DeleteCalled).To(BeTrue())
-				Expect(dbInstance.DeleteID).To(Equal(dbInstanceIdentifier))
-				Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeFalse())
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.OptionGroupName).To(Equal("test-option-group-name"))
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
 
-		Context("when request does not accept incomplete", func() {
+		Context("when has Port", func() {
 			BeforeEach(func() {
-				acceptsIncomplete = false
+				rdsProperties2.Port = int64(3306)
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(brokerapi.ErrAsyncRequired))
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.Port).To(Equal(int64(3306)))
+				Expect(err).ToNot(HaveOccurred())
 			})
-		})
 
-		Context("when Service Plan is not found", func() {
-			BeforeEach(func() {
-				deprovisionDetails.PlanID = "unknown"
-			})
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.Port).To(Equal(int64(3306)))
+					Expect(dbInstance.ModifyDBInstanceDetails.Port).To(Equal(int64(0)))
+					Expect(err).ToNot(HaveOccurred())
+				})
 			})
 		})
 
-		Context("when deleting the DB Instance fails", func() {
+		Context("when has PreferredBackupWindow", func() {
 			BeforeEach(func() {
-				dbInstance.DeleteError = errors.New("operation failed")
+				rdsProperties2.PreferredBackupWindow = "test-preferred-backup-window"
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("operation failed"))
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window"))
+				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("when the DB instance does not exists", func() {
+			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					dbInstance.DeleteError = awsrds.ErrDBInstanceDoesNotExist
+					rdsProperties2.Engine = "aurora"
 				})
 
-				It("returns the proper error", func() {
-					_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-					Expect(err).To(HaveOccurred())
-					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window"))
+					Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal(""))
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			Context("but has PreferredBackupWindow Parameter", func() {
+				BeforeEach(func() {
+					updateDetails.Parameters = map[string]interface{}{"preferred_backup_window": "test-preferred-backup-window-parameter"}
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window-parameter"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				Context("when Engine is Aurora", func() {
+					BeforeEach(func() {
+						rdsProperties2.Engine = "aurora"
+					})
+
+					It("makes the proper calls", func() {
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+						Expect(dbCluster.ModifyDBClusterDetails.PreferredBackupWindow).To(Equal("test-preferred-backup-window-parameter"))
+						Expect(dbInstance.ModifyDBInstanceDetails.PreferredBackupWindow).To(Equal(""))
+						Expect(err).ToNot(HaveOccurred())
+					})
 				})
 			})
 		})
 
-		Context("when Engine is Aurora", func() {
+		Context("when has PreferredMaintenanceWindow", func() {
 			BeforeEach(func() {
-				rdsProperties1.Engine = "aurora"
+				rdsProperties2.PreferredMaintenanceWindow = "test-preferred-maintenance-window"
 			})
 
 			It("makes the proper calls", func() {
-				_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-				Expect(dbCluster.DeleteCalled).To(BeTrue())
-				Expect(dbCluster.DeleteID).To(Equal(dbClusterIdentifier))
-				Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeTrue())
-				Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window"))
 				Expect(err).ToNot(HaveOccurred())
 			})
 
-			Context("when it does not skip final snaphot", func() {
+			Context("when Engine is Aurora", func() {
 				BeforeEach(func() {
-					rdsProperties1.SkipFinalSnapshot = false
+					rdsProperties2.Engine = "aurora"
 				})
 
 				It("makes the proper calls", func() {
-					_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
-					Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeFalse())
-					Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window"))
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 
-			Context("when deleting the DB Instance fails", func() {
+			Context("but has PreferredMaintenanceWindow Parameter", func() {
 				BeforeEach(func() {
-					dbCluster.DeleteError = errors.New("operation failed")
+					updateDetails.Parameters = map[string]interface{}{"preferred_maintenance_window": "test-preferred-maintenance-window-parameter"}
 				})
 
-				It("does not return an error", func() {
-					_, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window-parameter"))
 					Expect(err).ToNot(HaveOccurred())
 				})
+
+				Context("when Engine is Aurora", func() {
+					BeforeEach(func() {
+						rdsProperties2.Engine = "aurora"
+					})
+
+					It("makes the proper calls", func() {
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+						Expect(dbCluster.ModifyDBClusterDetails.PreferredMaintenanceWindow).To(Equal("test-preferred-maintenance-window-parameter"))
+						Expect(err).ToNot(HaveOccurred())
+					})
+				})
 			})
 		})
-	})
-
-	var _ = Describe("Bind", func() {
-		var (
-			bindDetails brokerapi.BindDetails
-		)
 
-		BeforeEach(func() {
-			bindDetails = brokerapi.BindDetails{
-				ServiceID:  "Service-1",
-				PlanID:     "Plan-1",
-				AppGUID:    "Application-1",
-				Parameters: map[string]interface{}{},
-			}
+		Context("when has PubliclyAccessible", func() {
+			BeforeEach(func() {
+				rdsProperties2.PubliclyAccessible = true
+			})
 
-			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
-				Identifier:     dbInstanceIdentifier,
-				Address:        "endpoint-address",
-				Port:           3306,
-				DBName:         "test-db",
-				MasterUsername: "master-username",
-			}
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.PubliclyAccessible).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
 
-			dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
-				Identifier:     dbClusterIdentifier,
-				Endpoint:       "endpoint-address",
-				Port:           3306,
-				DatabaseName:   "test-db",
-				MasterUsername: "master-username",
-			}
+		Context("when has StorageEncrypted", func() {
+			BeforeEach(func() {
+				rdsProperties2.StorageEncrypted = true
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.StorageEncrypted).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.StorageEncrypted).To(BeFalse())
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
 		})
 
-		It("returns the proper response", func() {
-			bindingResponse, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-			credentials := bindingResponse.Credentials.(*brokerapi.CredentialsHash)
-			Expect(bindingResponse.SyslogDrainURL).To(BeEmpty())
-			Expect(credentials.Host).To(Equal("endpoint-address"))
-			Expect(credentials.Port).To(Equal(int64(3306)))
-			Expect(credentials.Name).To(Equal("test-db"))
-			Expect(credentials.Username).To(Equal(dbUsername))
-			Expect(credentials.Password).ToNot(BeEmpty())
-			Expect(credentials.URI).To(ContainSubstring("@endpoint-address:3306/test-db?reconnect=true"))
-			Expect(credentials.JDBCURI).To(ContainSubstring("jdbc:fake://endpoint-address:3306/test-db?user=" + dbUsername + "&password="))
-			Expect(err).ToNot(HaveOccurred())
+		Context("when has StorageType", func() {
+			BeforeEach(func() {
+				rdsProperties2.StorageType = "test-storage-type"
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.StorageType).To(Equal("test-storage-type"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbInstance.ModifyDBInstanceDetails.StorageType).To(Equal(""))
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
 		})
 
-		It("makes the proper calls", func() {
-			_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-			Expect(dbCluster.DescribeCalled).To(BeFalse())
-			Expect(dbInstance.DescribeCalled).To(BeTrue())
-			Expect(dbInstance.DescribeID).To(Equal(dbInstanceIdentifier))
-			Expect(sqlProvider.GetSQLEngineCalled).To(BeTrue())
-			Expect(sqlProvider.GetSQLEngineEngine).To(Equal("test-engine-1"))
-			Expect(sqlEngine.OpenCalled).To(BeTrue())
-			Expect(sqlEngine.OpenAddress).To(Equal("endpoint-address"))
-			Expect(sqlEngine.OpenPort).To(Equal(int64(3306)))
-			Expect(sqlEngine.OpenDBName).To(Equal("test-db"))
-			Expect(sqlEngine.OpenUsername).To(Equal("master-username"))
-			Expect(sqlEngine.OpenPassword).ToNot(BeEmpty())
-			Expect(sqlEngine.CreateDBCalled).To(BeFalse())
-			Expect(sqlEngine.CreateUserCalled).To(BeTrue())
-			Expect(sqlEngine.CreateUserUsername).To(Equal(dbUsername))
-			Expect(sqlEngine.CreateUserPassword).ToNot(BeEmpty())
-			Expect(sqlEngine.GrantPrivilegesCalled).To(BeTrue())
-			Expect(sqlEngine.GrantPrivilegesDBName).To(Equal("test-db"))
-			Expect(sqlEngine.GrantPrivilegesUsername).To(Equal(dbUsername))
-			Expect(sqlEngine.CloseCalled).To(BeTrue())
-			Expect(err).ToNot(HaveOccurred())
+		Context("when has VpcSecurityGroupIds", func() {
+			BeforeEach(func() {
+				rdsProperties2.VpcSecurityGroupIds = []string{"test-vpc-security-group-ids"}
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbInstance.ModifyDBInstanceDetails.VpcSecurityGroupIds).To(Equal([]string{"test-vpc-security-group-ids"}))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.VpcSecurityGroupIds).To(Equal([]string{"test-vpc-security-group-ids"}))
+					Expect(dbInstance.ModifyDBInstanceDetails.VpcSecurityGroupIds).To(BeNil())
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		Context("when request does not accept incomplete", func() {
+			BeforeEach(func() {
+				acceptsIncomplete = false
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrAsyncRequired))
+			})
 		})
 
 		Context("when Parameters are not valid", func() {
 			BeforeEach(func() {
-				bindDetails.Parameters = map[string]interface{}{"dbname": true}
+				updateDetails.Parameters = map[string]interface{}{"backup_retention_period": "invalid"}
 			})
 
 			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("'dbname' expected type 'string', got unconvertible type 'bool'"))
+				Expect(err.Error()).To(Equal("Parameters are not valid: backup_retention_period: Invalid type. Expected: integer, given: string"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
 			})
 
-			Context("and user bind parameters are not allowed", func() {
+			Context("and update parameters are not allowed by the Service Plan", func() {
 				BeforeEach(func() {
-					allowUserBindParameters = false
+					updateParameters = nil
 				})
 
-				It("does not return an error", func() {
-					_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: (root): Additional property backup_retention_period is not allowed"))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when has RDS parameters", func() {
+			BeforeEach(func() {
+				updateParameters["db_instance_class"] = ParameterConstraints{Enum: []string{"db.m3.large", "db.m3.xlarge"}}
+				updateParameters["engine_version"] = ParameterConstraints{}
+				updateDetails.Parameters = map[string]interface{}{
+					"db_instance_class": "db.m3.large",
+					"engine_version":    "4.5.7",
+				}
+			})
+
+			It("overrides the Service Plan RDS Properties", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyDBInstanceDetails.DBInstanceClass).To(Equal("db.m3.large"))
+				Expect(dbInstance.ModifyDBInstanceDetails.EngineVersion).To(Equal("4.5.7"))
+			})
+
+			Context("and the instance has RDS parameters from a previous request", func() {
+				BeforeEach(func() {
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:         instanceID,
+						PlanID:     "Plan-1",
+						Parameters: map[string]interface{}{"allocated_storage": float64(400)},
+					}
+				})
+
+				It("keeps the previous RDS parameters", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.ModifyDBInstanceDetails.AllocatedStorage).To(Equal(int64(400)))
+					Expect(dbInstance.ModifyDBInstanceDetails.DBInstanceClass).To(Equal("db.m3.large"))
 				})
 			})
 		})
 
 		Context("when Service is not found", func() {
 			BeforeEach(func() {
-				bindDetails.ServiceID = "unknown"
+				updateDetails.ServiceID = "unknown"
 			})
 
 			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("Service 'unknown' not found"))
 			})
 		})
 
-		Context("when Service is not bindable", func() {
+		Context("when Plans is not updateable", func() {
 			BeforeEach(func() {
-				serviceBindable = false
+				planUpdateable = false
 			})
 
 			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
-				Expect(err).To(Equal(brokerapi.ErrInstanceNotBindable))
+				Expect(err).To(Equal(brokerapi.ErrInstanceNotUpdateable))
 			})
 		})
 
 		Context("when Service Plan is not found", func() {
 			BeforeEach(func() {
-				bindDetails.PlanID = "unknown"
+				updateDetails.PlanID = "unknown"
 			})
 
 			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
 			})
 		})
 
-		Context("when describing the DB Instance fails", func() {
+		It("saves the instance state", func() {
+			_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stateStore.Instances).To(HaveKey(instanceID))
+			instance := stateStore.Instances[instanceID]
+			Expect(instance.PlanID).To(Equal("Plan-2"))
+			Expect(instance.LastOperation.Type).To(Equal(statestore.OperationUpdate))
+		})
+
+		Context("when the instance state already exists", func() {
 			BeforeEach(func() {
-				dbInstance.DescribeError = errors.New("operation failed")
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:               instanceID,
+					PlanID:           "Plan-1",
+					OrganizationGUID: "organization-id",
+					Parameters:       map[string]interface{}{"dbname": "test-db"},
+				}
+				updateDetails.Parameters = map[string]interface{}{"backup_retention_period": float64(14)}
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("operation failed"))
+			It("keeps the previous instance state", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.PlanID).To(Equal("Plan-2"))
+				Expect(instance.OrganizationGUID).To(Equal("organization-id"))
+				Expect(instance.Parameters).To(HaveKeyWithValue("dbname", "test-db"))
+				Expect(instance.Parameters).To(HaveKeyWithValue("backup_retention_period", float64(14)))
 			})
 
-			Context("when the DB Instance does not exists", func() {
+			Context("and it has an operation in progress", func() {
 				BeforeEach(func() {
-					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					instance := stateStore.Instances[instanceID]
+					instance.LastOperation = statestore.OperationDetails{
+						Type:  statestore.OperationProvision,
+						State: operation.StateInProgress,
+						Step:  "wait-available",
+					}
+					stateStore.Instances[instanceID] = instance
 				})
 
 				It("returns the proper error", func() {
-					_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 					Expect(err).To(HaveOccurred())
-					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					Expect(err).To(Equal(brokerapi.ErrConcurrentAccess))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
 				})
 			})
 		})
 
-		Context("when Engine is aurora", func() {
+		Context("when rotating the master password", func() {
 			BeforeEach(func() {
-				rdsProperties1.Engine = "aurora"
+				updateDetails.Parameters = map[string]interface{}{"rotate_master_password": true}
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:             instanceID,
+					PlanID:         "Plan-1",
+					MasterPassword: "encrypted:old-master-password",
+				}
 			})
 
-			It("does not describe the DB Instance", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(dbInstance.DescribeCalled).To(BeFalse())
+				Expect(dbInstance.ModifyDBInstanceDetails.MasterUserPassword).To(HaveLen(32))
 			})
 
-			Context("when describing the DB Cluster fails", func() {
+			It("saves the new master password as soon as RDS accepts it", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.MasterPassword).To(Equal("encrypted:" + dbInstance.ModifyDBInstanceDetails.MasterUserPassword))
+				Expect(instance.PendingMasterPassword).To(BeEmpty())
+			})
+
+			Context("and Engine is Aurora", func() {
 				BeforeEach(func() {
-					dbCluster.DescribeError = errors.New("operation failed")
+					rdsProperties2.Engine = "aurora"
 				})
 
-				It("returns the proper error", func() {
-					_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-					Expect(err).To(HaveOccurred())
-					Expect(err.Error()).To(Equal("operation failed"))
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.ModifyDBClusterDetails.MasterUserPassword).To(HaveLen(32))
+					Expect(dbInstance.ModifyDBInstanceDetails.MasterUserPassword).To(BeEmpty())
+					Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:" + dbCluster.ModifyDBClusterDetails.MasterUserPassword))
+					Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
 				})
 
-				Context("when the DB Cluster does not exists", func() {
+				Context("and modifying the DB Instance fails after the DB Cluster", func() {
 					BeforeEach(func() {
-						dbCluster.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+						dbInstance.ModifyError = errors.New("operation failed")
 					})
 
-					It("returns the proper error", func() {
-						_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+					It("keeps the master password RDS already uses", func() {
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 						Expect(err).To(HaveOccurred())
-						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+						instance := stateStore.Instances[instanceID]
+						Expect(instance.MasterPassword).To(Equal("encrypted:" + dbCluster.ModifyDBClusterDetails.MasterUserPassword))
+						Expect(instance.PendingMasterPassword).To(BeEmpty())
+					})
+
+					Context("and the instance state did not exist", func() {
+						BeforeEach(func() {
+							delete(stateStore.Instances, instanceID)
+						})
+
+						It("keeps the instance state with the master password RDS already uses", func() {
+							_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+							Expect(err).To(HaveOccurred())
+							Expect(stateStore.Instances).To(HaveKey(instanceID))
+							Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:" + dbCluster.ModifyDBClusterDetails.MasterUserPassword))
+						})
 					})
 				})
 			})
-		})
 
-		Context("when getting the SQL Engine fails", func() {
-			BeforeEach(func() {
-				sqlProvider.GetSQLEngineError = errors.New("Engine 'unknown' not supported")
+			Context("and modifying the DB Instance fails", func() {
+				BeforeEach(func() {
+					dbInstance.ModifyError = errors.New("operation failed")
+				})
+
+				It("discards the pending master password", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					instance := stateStore.Instances[instanceID]
+					Expect(instance.MasterPassword).To(Equal("encrypted:old-master-password"))
+					Expect(instance.PendingMasterPassword).To(BeEmpty())
+				})
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Engine 'unknown' not supported"))
+			Context("and encrypting the master password fails", func() {
+				BeforeEach(func() {
+					encryptor.EncryptError = errors.New("Failed to encrypt")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Failed to encrypt"))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
+				})
 			})
 		})
 
-		Context("when opening a DB connection fails", func() {
+		Context("when has one-shot parameters", func() {
 			BeforeEach(func() {
-				sqlEngine.OpenError = errors.New("Failed to open sqlEngine")
+				updateDetails.Parameters = map[string]interface{}{
+					"apply_immediately":       true,
+					"rotate_master_password":  true,
+					"state":                   "running",
+					"backup_retention_period": float64(14),
+				}
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:             instanceID,
+					PlanID:         "Plan-1",
+					MasterPassword: "encrypted:old-master-password",
+					Parameters: map[string]interface{}{
+						"dbname":                  "test-db",
+						"backup_retention_period": float64(7),
+						"state":                   "stopped",
+					},
+				}
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Failed to open sqlEngine"))
+			It("stores only the instance configuration", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stateStore.Instances[instanceID].Parameters).To(Equal(map[string]interface{}{
+					"dbname":                  "test-db",
+					"backup_retention_period": float64(14),
+				}))
 			})
 		})
 
-		Context("when DBNname Parameter is set", func() {
-			BeforeEach(func() {
-				bindDetails.Parameters = map[string]interface{}{"dbname": "my-test-db"}
+		Context("when not rotating the master password", func() {
+			It("does not change the master password", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyDBInstanceDetails.MasterUserPassword).To(BeEmpty())
+				Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
 			})
+		})
 
-			It("returns the proper response", func() {
-				bindingResponse, _ := rdsBroker.Bind(instanceID, bindingID, bindDetails)
-				credentials := bindingResponse.Credentials.(*brokerapi.CredentialsHash)
-				Expect(bindingResponse.SyslogDrainURL).To(BeEmpty())
-				Expect(credentials.Name).To(Equal("my-test-db"))
+		Context("when stopping the DB Instance", func() {
+			BeforeEach(func() {
+				updateDetails.Parameters = map[string]interface{}{"state": "stopped"}
 			})
 
-			It("creates the DB with the proper name", func() {
-				rdsBroker.Bind(instanceID, bindingID, bindDetails)
-				Expect(sqlEngine.CreateDBCalled).To(BeTrue())
-				Expect(sqlEngine.CreateDBDBName).To(Equal("my-test-db"))
-				Expect(sqlEngine.GrantPrivilegesDBName).To(Equal("my-test-db"))
+			It("modifies the DB Instance before stopping it", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyCalled).To(BeTrue())
+				Expect(dbInstance.StopCalled).To(BeFalse())
+				Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-available"))
 			})
 
-			Context("when creating the DB fails", func() {
+			Context("and the instance has read replicas", func() {
 				BeforeEach(func() {
-					sqlEngine.CreateDBError = errors.New("Failed to create sqlEngine")
+					stateStore.Instances["read-replica-id"] = statestore.InstanceDetails{
+						ID:            "read-replica-id",
+						PlanID:        "Plan-1",
+						ReadReplicaOf: instanceID,
+					}
 				})
 
 				It("returns the proper error", func() {
-					_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 					Expect(err).To(HaveOccurred())
-					Expect(err.Error()).To(Equal("Failed to create sqlEngine"))
-					Expect(sqlEngine.CloseCalled).To(BeTrue())
+					Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' has read replicas (read-replica-id) and cannot be stopped"))
+					Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
 				})
 			})
 		})
 
-		Context("when creating a DB user fails", func() {
+		Context("when the state is not valid", func() {
 			BeforeEach(func() {
-				sqlEngine.CreateUserError = errors.New("Failed to create user")
+				updateDetails.Parameters = map[string]interface{}{"state": "paused"}
 			})
 
 			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Failed to create user"))
-				Expect(sqlEngine.CloseCalled).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("state must be one of the following"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
 			})
 		})
 
-		Context("when granting privileges fails", func() {
+		Context("when the DB Instance is stopped", func() {
 			BeforeEach(func() {
-				sqlEngine.GrantPrivilegesError = errors.New("Failed to grant privileges")
+				dbInstance.DescribeDBInstanceDetails.Status = "stopped"
 			})
 
 			It("returns the proper error", func() {
-				_, err := rdsBroker.Bind(instanceID, bindingID, bindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Failed to grant privileges"))
-				Expect(sqlEngine.CloseCalled).To(BeTrue())
+				Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' is stopped: update it with the 'state' parameter set to 'running' first"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusUnprocessableEntity))
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
 			})
-		})
-	})
 
-	var _ = Describe("Unbind", func() {
-		var (
-			unbindDetails brokerapi.UnbindDetails
-		)
+			Context("and the update starts it", func() {
+				BeforeEach(func() {
+					updateDetails.Parameters = map[string]interface{}{"state": "running"}
+				})
 
-		BeforeEach(func() {
-			unbindDetails = brokerapi.UnbindDetails{
-				ServiceID: "Service-1",
-				PlanID:    "Plan-1",
-			}
+				It("starts the DB Instance before modifying it", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.StartCalled).To(BeTrue())
+					Expect(dbInstance.StartID).To(Equal(dbInstanceIdentifier))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
+					Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-instance-started"))
+				})
 
-			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
-				Identifier:     dbInstanceIdentifier,
-				Address:        "endpoint-address",
-				Port:           3306,
-				DBName:         "test-db",
-				MasterUsername: "master-username",
-			}
-
-			dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
-				Identifier:     dbClusterIdentifier,
-				Endpoint:       "endpoint-address",
-				Port:           3306,
-				DatabaseName:   "test-db",
-				MasterUsername: "master-username",
-			}
-		})
+				Context("and Engine is Aurora", func() {
+					BeforeEach(func() {
+						rdsProperties2.Engine = "aurora"
+						dbCluster.DescribeDBClusterDetails.Status = "stopped"
+					})
 
-		It("makes the proper calls", func() {
-			err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-			Expect(dbCluster.DescribeCalled).To(BeFalse())
-			Expect(dbInstance.DescribeCalled).To(BeTrue())
-			Expect(dbInstance.DescribeID).To(Equal(dbInstanceIdentifier))
-			Expect(sqlProvider.GetSQLEngineCalled).To(BeTrue())
-			Expect(sqlProvider.GetSQLEngineEngine).To(Equal("test-engine-1"))
-			Expect(sqlEngine.OpenCalled).To(BeTrue())
-			Expect(sqlEngine.OpenAddress).To(Equal("endpoint-address"))
-			Expect(sqlEngine.OpenPort).To(Equal(int64(3306)))
-			Expect(sqlEngine.OpenDBName).To(Equal("test-db"))
-			Expect(sqlEngine.OpenUsername).To(Equal("master-username"))
-			Expect(sqlEngine.OpenPassword).ToNot(BeEmpty())
-			Expect(sqlEngine.PrivilegesCalled).To(BeTrue())
-			Expect(sqlEngine.RevokePrivilegesCalled).To(BeFalse())
-			Expect(sqlEngine.DropDBCalled).To(BeFalse())
-			Expect(sqlEngine.DropUserCalled).To(BeTrue())
-			Expect(sqlEngine.DropUserUsername).To(Equal(dbUsername))
-			Expect(sqlEngine.CloseCalled).To(BeTrue())
-			Expect(err).ToNot(HaveOccurred())
+					It("starts the DB Cluster", func() {
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dbCluster.StartCalled).To(BeTrue())
+						Expect(dbCluster.StartID).To(Equal(dbClusterIdentifier))
+						Expect(dbInstance.StartCalled).To(BeFalse())
+						Expect(dbCluster.ModifyCalled).To(BeFalse())
+						Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-cluster-started"))
+					})
+				})
+			})
 		})
 
-		Context("when Service Plan is not found", func() {
+		Context("when starting a DB Instance that is not stopped", func() {
 			BeforeEach(func() {
-				unbindDetails.PlanID = "unknown"
+				dbInstance.DescribeDBInstanceDetails.Status = "available"
+				updateDetails.Parameters = map[string]interface{}{"state": "running"}
 			})
 
-			It("returns the proper error", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+			It("does not start the DB Instance", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.StartCalled).To(BeFalse())
+				Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-instance-started"))
 			})
 		})
 
-		Context("when describing the DB Instance fails", func() {
+		Context("when modifying the DB Instance fails", func() {
 			BeforeEach(func() {
-				dbInstance.DescribeError = errors.New("operation failed")
+				dbInstance.ModifyError = errors.New("operation failed")
 			})
 
 			It("returns the proper error", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			It("leaves the stored parameters unchanged", func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:         instanceID,
+					PlanID:     "Plan-1",
+					Parameters: map[string]interface{}{"backup_retention_period": float64(7)},
+				}
+				updateDetails.Parameters = map[string]interface{}{"backup_retention_period": float64(14)}
+
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("operation failed"))
+				Expect(stateStore.Instances[instanceID].Parameters).To(Equal(map[string]interface{}{"backup_retention_period": float64(7)}))
 			})
 
 			Context("when the DB Instance does not exists", func() {
 				BeforeEach(func() {
-					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					dbInstance.ModifyError = awsrds.ErrDBInstanceDoesNotExist
 				})
 
 				It("returns the proper error", func() {
-					err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 					Expect(err).To(HaveOccurred())
 					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
 				})
 			})
 		})
 
-		Context("when Engine is aurora", func() {
+		Context("when Engine is Aurora", func() {
 			BeforeEach(func() {
-				rdsProperties1.Engine = "aurora"
+				rdsProperties2.Engine = "aurora"
 			})
 
-			It("does not describe the DB Instance", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+				Expect(dbCluster.ModifyCalled).To(BeTrue())
+				Expect(dbCluster.ModifyID).To(Equal(dbClusterIdentifier))
+				Expect(dbCluster.ModifyDBClusterDetails.Engine).To(Equal("aurora"))
+				Expect(dbCluster.ModifyDBClusterDetails.Tags["Owner"]).To(Equal("Cloud Foundry"))
+				Expect(dbCluster.ModifyDBClusterDetails.Tags["Updated by"]).To(Equal("AWS RDS Service Broker"))
+				Expect(dbCluster.ModifyDBClusterDetails.Tags).To(HaveKey("Updated at"))
+				Expect(dbCluster.ModifyDBClusterDetails.Tags["Service ID"]).To(Equal("Service-2"))
+				Expect(dbCluster.ModifyDBClusterDetails.Tags["Plan ID"]).To(Equal("Plan-2"))
 				Expect(err).ToNot(HaveOccurred())
-				Expect(dbInstance.DescribeCalled).To(BeFalse())
 			})
 
-			Context("when describing the DB Cluster fails", func() {
+			Context("when EngineMode is serverless", func() {
 				BeforeEach(func() {
-					dbCluster.DescribeError = errors.New("operation failed")
+					rdsProperties1.Engine = "aurora"
+					rdsProperties1.EngineMode = "serverless"
+					rdsProperties2.EngineMode = "serverless"
+					rdsProperties2.MinCapacity = 1
+					rdsProperties2.MaxCapacity = 4
+					updateDetails.Parameters = map[string]interface{}{"max_capacity": 8}
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:     instanceID,
+						PlanID: "Plan-1",
+					}
 				})
 
-				It("returns the proper error", func() {
-					err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-					Expect(err).To(HaveOccurred())
-					Expect(err.Error()).To(Equal("operation failed"))
+				It("modifies the scaling configuration of the DB Cluster only", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.ModifyDBClusterDetails.ScalingConfiguration).To(Equal(&awsrds.ScalingConfiguration{
+						MinCapacity: 1,
+						MaxCapacity: 8,
+					}))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
+					Expect(dbInstance.CreateCalled).To(BeFalse())
 				})
 
-				Context("when the DB Cluster does not exists", func() {
+				Context("and has State parameter", func() {
 					BeforeEach(func() {
-						dbCluster.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+						updateDetails.Parameters = map[string]interface{}{"state": "stopped"}
 					})
 
 					It("returns the proper error", func() {
-						err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 						Expect(err).To(HaveOccurred())
-						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+						Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' is an Aurora Serverless DB Cluster and cannot be stopped or started"))
+						Expect(dbCluster.ModifyCalled).To(BeFalse())
 					})
 				})
-			})
-		})
 
-		Context("when getting the SQL Engine fails", func() {
-			BeforeEach(func() {
-				sqlProvider.GetSQLEngineError = errors.New("SQL Engine 'unknown' not supported")
-			})
+				Context("and the previous Service Plan is not serverless", func() {
+					BeforeEach(func() {
+						rdsProperties1.EngineMode = ""
+					})
 
-			It("returns the proper error", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("SQL Engine 'unknown' not supported"))
+					It("returns the proper error", func() {
+						_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' cannot be updated between serverless and provisioned Service Plans"))
+						Expect(dbCluster.ModifyCalled).To(BeFalse())
+					})
+				})
 			})
-		})
 
-		Context("when opening a DB connection fails", func() {
-			BeforeEach(func() {
-				sqlEngine.OpenError = errors.New("Failed to open sqlEngine")
+			Context("when scaling up the InstanceCount", func() {
+				BeforeEach(func() {
+					rdsProperties2.InstanceCount = 3
+					dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"}
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:               instanceID,
+						PlanID:           "Plan-1",
+						OrganizationGUID: "organization-id",
+						SpaceGUID:        "space-id",
+					}
+				})
+
+				It("modifies the existing DB Cluster members and creates the missing ones", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.ModifyIDs).To(Equal([]string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"}))
+					Expect(dbInstance.CreateIDs).To(Equal([]string{dbInstanceIdentifier + "-2"}))
+					Expect(dbInstance.CreateDBInstanceDetails.DBClusterIdentifier).To(Equal(dbClusterIdentifier))
+					Expect(dbInstance.CreateDBInstanceDetails.DBInstanceClass).To(Equal("db.m2.test"))
+					Expect(dbInstance.CreateDBInstanceDetails.Tags["Plan ID"]).To(Equal("Plan-2"))
+					Expect(dbInstance.CreateDBInstanceDetails.Tags["Organization ID"]).To(Equal("organization-id"))
+					Expect(dbInstance.DeleteCalled).To(BeFalse())
+				})
 			})
 
-			It("returns the proper error", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Failed to open sqlEngine"))
+			Context("when scaling down the InstanceCount", func() {
+				BeforeEach(func() {
+					rdsProperties2.InstanceCount = 2
+					dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{dbInstanceIdentifier, dbInstanceIdentifier + "-2", dbInstanceIdentifier + "-1", "cf-read-replica-id"}
+				})
+
+				It("deletes the extra DB Cluster members", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.ModifyIDs).To(Equal([]string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"}))
+					Expect(dbInstance.CreateCalled).To(BeFalse())
+					Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier + "-2"}))
+					Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
+				})
 			})
-		})
 
-		Context("when getting privileges fails", func() {
-			BeforeEach(func() {
-				sqlEngine.PrivilegesError = errors.New("Failed to get privileges")
+			Context("when describing the DB Cluster fails", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = errors.New("operation failed")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+				})
 			})
 
-			It("returns the proper error", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Failed to get privileges"))
-				Expect(sqlEngine.CloseCalled).To(BeTrue())
+			Context("when has DBClusterParameterGroupName", func() {
+				BeforeEach(func() {
+					rdsProperties2.DBClusterParameterGroupName = "test-db-cluster-parameter-group-name"
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(dbCluster.ModifyDBClusterDetails.DBClusterParameterGroupName).To(Equal("test-db-cluster-parameter-group-name"))
+					Expect(err).ToNot(HaveOccurred())
+				})
 			})
 		})
 
-		Context("when user has privileges over a DB", func() {
+		Context("when the instance is a read replica", func() {
 			BeforeEach(func() {
-				sqlEngine.PrivilegesPrivileges = map[string][]string{"test-db": []string{dbUsername}}
+				rdsProperties2.ReadReplica = true
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:            instanceID,
+					PlanID:        "Plan-1",
+					ReadReplicaOf: "source-instance-id",
+				}
 			})
 
 			It("makes the proper calls", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-				Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
-				Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("test-db"))
-				Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
-				Expect(sqlEngine.DropDBCalled).To(BeFalse())
-				Expect(sqlEngine.CloseCalled).To(BeTrue())
+				_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyCalled).To(BeTrue())
+				Expect(dbInstance.ModifyID).To(Equal(dbInstanceIdentifier))
 			})
 
-			Context("when revoking privileges fails", func() {
+			Context("and the new Service Plan is not a read replica plan", func() {
 				BeforeEach(func() {
-					sqlEngine.RevokePrivilegesError = errors.New("Failed to revoke privileges")
+					rdsProperties2.ReadReplica = false
 				})
 
 				It("returns the proper error", func() {
-					err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
 					Expect(err).To(HaveOccurred())
-					Expect(err.Error()).To(Equal("Failed to revoke privileges"))
-					Expect(sqlEngine.CloseCalled).To(BeTrue())
+					Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' cannot be updated between read replica and non read replica Service Plans"))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
 				})
 			})
 
-			Context("and the db is not the master db", func() {
+			Context("and has RotateMasterPassword parameter", func() {
 				BeforeEach(func() {
-					sqlEngine.PrivilegesPrivileges = map[string][]string{"another-test-db": []string{dbUsername}}
+					updateDetails.Parameters = map[string]interface{}{"rotate_master_password": true}
 				})
 
-				It("makes the proper calls", func() {
-					err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-					Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
-					Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("another-test-db"))
-					Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
-					Expect(sqlEngine.DropDBCalled).To(BeTrue())
-					Expect(sqlEngine.DropDBDBName).To(Equal("another-test-db"))
-					Expect(sqlEngine.CloseCalled).To(BeTrue())
-					Expect(err).ToNot(HaveOccurred())
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' is a read replica and uses the master password of Service Instance 'source-instance-id'"))
 				})
+			})
 
-				Context("when droping the DB fails", func() {
-					BeforeEach(func() {
-						sqlEngine.DropDBError = errors.New("Failed to drop db")
-					})
-
-					It("returns the proper error", func() {
-						err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-						Expect(err).To(HaveOccurred())
-						Expect(err.Error()).To(Equal("Failed to drop db"))
-						Expect(sqlEngine.CloseCalled).To(BeTrue())
-					})
+			Context("and has State parameter", func() {
+				BeforeEach(func() {
+					updateDetails.Parameters = map[string]interface{}{"state": "stopped"}
 				})
 
-				Context("but there are other users with grants over the db", func() {
-					BeforeEach(func() {
-						sqlEngine.PrivilegesPrivileges = map[string][]string{"another-test-db": []string{dbUsername, "another-user"}}
-					})
-
-					It("makes the proper calls", func() {
-						err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-						Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
-						Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("another-test-db"))
-						Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
-						Expect(sqlEngine.DropDBCalled).To(BeFalse())
-						Expect(sqlEngine.CloseCalled).To(BeTrue())
-						Expect(err).ToNot(HaveOccurred())
-					})
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' is a read replica and cannot be stopped or started"))
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
 				})
 			})
-		})
 
-		Context("when deleting a user fails", func() {
-			BeforeEach(func() {
-				sqlEngine.DropUserError = errors.New("Failed to delete user")
-			})
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties2.Engine = "aurora"
+				})
 
-			It("returns the proper error", func() {
-				err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("Failed to delete user"))
-				Expect(sqlEngine.CloseCalled).To(BeTrue())
+				It("does not modify the source DB Cluster", func() {
+					_, _, err := rdsBroker.Update(instanceID, updateDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.ModifyCalled).To(BeFalse())
+					Expect(dbInstance.ModifyCalled).To(BeTrue())
+				})
 			})
 		})
 	})
 
-	var _ = Describe("LastOperation", func() {
+	var _ = Describe("Deprovision", func() {
 		var (
-			dbInstanceStatus            string
-			lastOperationState          string
-			properLastOperationResponse brokerapi.LastOperationResponse
+			deprovisionDetails brokerapi.DeprovisionDetails
+			acceptsIncomplete  bool
 		)
 
-		JustBeforeEach(func() {
-			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
-				Identifier:     dbInstanceIdentifier,
-				Engine:         "test-engine",
-				Address:        "endpoint-address",
-				Port:           3306,
-				DBName:         "test-db",
-				MasterUsername: "master-username",
-				Status:         dbInstanceStatus,
+		BeforeEach(func() {
+			deprovisionDetails = brokerapi.DeprovisionDetails{
+				ServiceID: "Service-1",
+				PlanID:    "Plan-1",
 			}
+			acceptsIncomplete = true
+		})
 
-			properLastOperationResponse = brokerapi.LastOperationResponse{
-				State:       lastOperationState,
-				Description: "DB Instance '" + dbInstanceIdentifier + "' status is '" + dbInstanceStatus + "'",
-			}
+		It("returns the proper response", func() {
+			deprovisionResponse, asynch, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+			Expect(deprovisionResponse.OperationData).To(Equal(fmt.Sprintf("deprovision:%d", stateStore.Instances[instanceID].LastOperation.StartedAt.Unix())))
+			Expect(asynch).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
 		})
 
-		Context("when describing the DB Instance fails", func() {
+		It("makes the proper calls", func() {
+			_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+			Expect(dbInstance.DeleteCalled).To(BeTrue())
+			Expect(dbInstance.DeleteID).To(Equal(dbInstanceIdentifier))
+			Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("saves the instance state", func() {
+			_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stateStore.Instances[instanceID].LastOperation.Type).To(Equal(statestore.OperationDeprovision))
+		})
+
+		Context("when the Service Plan has been removed from the catalog", func() {
 			BeforeEach(func() {
-				dbInstance.DescribeError = errors.New("operation failed")
+				deprovisionDetails.PlanID = "Plan-removed"
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:            instanceID,
+					PlanID:        "Plan-removed",
+					RDSProperties: []byte(`{"engine":"test-engine-1","skip_final_snapshot":false}`),
+				}
 			})
 
-			It("returns the proper error", func() {
-				_, err := rdsBroker.LastOperation(instanceID)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(Equal("operation failed"))
+			It("uses the RDS Properties from the instance state", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DeleteCalled).To(BeTrue())
+				Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeFalse())
 			})
+		})
 
-			Context("when the DB Instance does not exists", func() {
-				BeforeEach(func() {
+		Context("when it does not skip final snaphot", func() {
+			BeforeEach(func() {
+				rdsProperties1.SkipFinalSnapshot = false
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(dbInstance.DeleteCalled).To(BeTrue())
+				Expect(dbInstance.DeleteID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeFalse())
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when request does not accept incomplete", func() {
+			BeforeEach(func() {
+				acceptsIncomplete = false
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrAsyncRequired))
+			})
+		})
+
+		Context("when Service Plan is not found", func() {
+			BeforeEach(func() {
+				deprovisionDetails.PlanID = "unknown"
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+			})
+		})
+
+		Context("when deleting the DB Instance fails", func() {
+			BeforeEach(func() {
+				dbInstance.DeleteError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("when the DB instance does not exists", func() {
+				BeforeEach(func() {
+					dbInstance.DeleteError = awsrds.ErrDBInstanceDoesNotExist
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				})
+			})
+		})
+
+		Context("when Engine is Aurora", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "aurora"
+				dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+					Identifier:       dbClusterIdentifier,
+					Engine:           "aurora",
+					Status:           "available",
+					DBClusterMembers: []string{dbInstanceIdentifier},
+				}
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(dbCluster.DescribeID).To(Equal(dbClusterIdentifier))
+				Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier}))
+				Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the DB Cluster until all its members are gone", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.DeleteCalled).To(BeFalse())
+				Expect(stateStore.Instances[instanceID].LastOperation.Type).To(Equal(statestore.OperationDeprovision))
+				Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateInProgress))
+				Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-instances-deleted"))
+			})
+
+			Context("when the DB Cluster has several members", func() {
+				BeforeEach(func() {
+					rdsProperties1.InstanceCount = 2
+					dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"}
+				})
+
+				It("deletes all DB Cluster members", func() {
+					_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"}))
+				})
+
+				Context("and it has more members than the Service Plan instance count", func() {
+					BeforeEach(func() {
+						dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{dbInstanceIdentifier, dbInstanceIdentifier + "-1", dbInstanceIdentifier + "-2", "added-reader"}
+					})
+
+					It("deletes all DB Cluster members", func() {
+						_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier, dbInstanceIdentifier + "-1", dbInstanceIdentifier + "-2", "added-reader"}))
+					})
+				})
+			})
+
+			Context("when the DB Cluster does not exist", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = awsrds.ErrDBClusterDoesNotExist
+				})
+
+				It("does not delete any DB Instance", func() {
+					_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.DeleteCalled).To(BeFalse())
+				})
+			})
+
+			Context("when describing the DB Cluster fails", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = errors.New("operation failed")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+					Expect(dbInstance.DeleteCalled).To(BeFalse())
+				})
+			})
+
+			Context("when deleting a DB Cluster member fails", func() {
+				BeforeEach(func() {
+					dbInstance.DeleteError = errors.New("operation failed")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+				})
+
+				Context("when the DB Cluster member is already gone", func() {
+					BeforeEach(func() {
+						dbInstance.DeleteError = awsrds.ErrDBInstanceDoesNotExist
+					})
+
+					It("does not return an error", func() {
+						_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+						Expect(err).ToNot(HaveOccurred())
+					})
+				})
+			})
+		})
+
+		Context("when the instance has read replicas", func() {
+			BeforeEach(func() {
+				stateStore.Instances["replica-1"] = statestore.InstanceDetails{ID: "replica-1", ReadReplicaOf: instanceID}
+				stateStore.Instances["replica-2"] = statestore.InstanceDetails{ID: "replica-2", ReadReplicaOf: instanceID}
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' has read replicas (replica-1, replica-2): deprovision them first"))
+				Expect(dbInstance.DeleteCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the instance is a read replica", func() {
+			BeforeEach(func() {
+				rdsProperties1.SkipFinalSnapshot = false
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:            instanceID,
+					ReadReplicaOf: "source-instance-id",
+				}
+			})
+
+			It("skips the final snapshot", func() {
+				_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DeleteSkipFinalSnapshot).To(BeTrue())
+			})
+
+			Context("when Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties1.Engine = "aurora"
+				})
+
+				It("does not delete the source DB Cluster", func() {
+					_, _, err := rdsBroker.Deprovision(instanceID, deprovisionDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbInstance.DeleteCalled).To(BeTrue())
+					Expect(dbCluster.DeleteCalled).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	var _ = Describe("GetInstance", func() {
+		BeforeEach(func() {
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:         instanceID,
+				ServiceID:  "Service-1",
+				PlanID:     "Plan-1",
+				Parameters: map[string]interface{}{"dbname": "test-db"},
+				LastOperation: statestore.OperationDetails{
+					Type:  statestore.OperationProvision,
+					State: operation.StateSucceeded,
+				},
+			}
+		})
+
+		It("returns the proper response", func() {
+			getInstanceResponse, err := rdsBroker.GetInstance(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getInstanceResponse).To(Equal(brokerapi.GetInstanceResponse{
+				ServiceID:  "Service-1",
+				PlanID:     "Plan-1",
+				Parameters: map[string]interface{}{"dbname": "test-db"},
+			}))
+		})
+
+		Context("when the instance does not exist", func() {
+			It("returns the proper error", func() {
+				_, err := rdsBroker.GetInstance("unknown")
+				Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+			})
+		})
+
+		Context("when the instance is being provisioned", func() {
+			BeforeEach(func() {
+				instance := stateStore.Instances[instanceID]
+				instance.LastOperation.State = operation.StateInProgress
+				stateStore.Instances[instanceID] = instance
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.GetInstance(instanceID)
+				Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+			})
+		})
+
+		Context("when the instance is being updated", func() {
+			BeforeEach(func() {
+				instance := stateStore.Instances[instanceID]
+				instance.LastOperation.Type = statestore.OperationUpdate
+				instance.LastOperation.State = operation.StateInProgress
+				stateStore.Instances[instanceID] = instance
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.GetInstance(instanceID)
+				Expect(err).To(Equal(brokerapi.ErrConcurrentAccess))
+			})
+		})
+
+		Context("when the instance is being deprovisioned", func() {
+			BeforeEach(func() {
+				instance := stateStore.Instances[instanceID]
+				instance.LastOperation.Type = statestore.OperationDeprovision
+				stateStore.Instances[instanceID] = instance
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.GetInstance(instanceID)
+				Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+			})
+		})
+	})
+
+	var _ = Describe("Bind", func() {
+		var (
+			bindDetails       brokerapi.BindDetails
+			acceptsIncomplete bool
+		)
+
+		BeforeEach(func() {
+			bindDetails = brokerapi.BindDetails{
+				ServiceID:  "Service-1",
+				PlanID:     "Plan-1",
+				AppGUID:    "Application-1",
+				Parameters: map[string]interface{}{},
+			}
+			acceptsIncomplete = true
+
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier:     dbInstanceIdentifier,
+				Address:        "endpoint-address",
+				Port:           3306,
+				DBName:         "test-db",
+				MasterUsername: "master-username",
+			}
+
+			dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+				Identifier:     dbClusterIdentifier,
+				Endpoint:       "endpoint-address",
+				Port:           3306,
+				DatabaseName:   "test-db",
+				MasterUsername: "master-username",
+			}
+		})
+
+		It("returns the proper response", func() {
+			bindingResponse, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+			credentials := bindingResponse.Credentials.(*Credentials)
+			Expect(bindingResponse.SyslogDrainURL).To(BeEmpty())
+			Expect(credentials.Host).To(Equal("endpoint-address"))
+			Expect(credentials.Port).To(Equal(int64(3306)))
+			Expect(credentials.Name).To(Equal("test-db"))
+			Expect(credentials.Username).To(Equal(dbUsername))
+			Expect(credentials.Password).ToNot(BeEmpty())
+			Expect(credentials.URI).To(ContainSubstring("@endpoint-address:3306/test-db?reconnect=true"))
+			Expect(credentials.JDBCURI).To(ContainSubstring("jdbc:fake://endpoint-address:3306/test-db?user=" + dbUsername + "&password="))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("makes the proper calls", func() {
+			_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+			Expect(dbCluster.DescribeCalled).To(BeFalse())
+			Expect(dbInstance.DescribeCalled).To(BeTrue())
+			Expect(dbInstance.DescribeID).To(Equal(dbInstanceIdentifier))
+			Expect(sqlProvider.GetSQLEngineCalled).To(BeTrue())
+			Expect(sqlProvider.GetSQLEngineEngine).To(Equal("test-engine-1"))
+			Expect(sqlEngine.OpenCalled).To(BeTrue())
+			Expect(sqlEngine.OpenAddress).To(Equal("endpoint-address"))
+			Expect(sqlEngine.OpenPort).To(Equal(int64(3306)))
+			Expect(sqlEngine.OpenDBName).To(Equal("test-db"))
+			Expect(sqlEngine.OpenUsername).To(Equal("master-username"))
+			Expect(sqlEngine.OpenPassword).ToNot(BeEmpty())
+			Expect(sqlEngine.CreateDBCalled).To(BeFalse())
+			Expect(sqlEngine.CreateUserCalled).To(BeTrue())
+			Expect(sqlEngine.CreateUserUsername).To(Equal(dbUsername))
+			Expect(sqlEngine.CreateUserPassword).ToNot(BeEmpty())
+			Expect(sqlEngine.GrantPrivilegesCalled).To(BeTrue())
+			Expect(sqlEngine.GrantPrivilegesDBName).To(Equal("test-db"))
+			Expect(sqlEngine.GrantPrivilegesUsername).To(Equal(dbUsername))
+			Expect(sqlEngine.GrantPrivilegesRole).To(Equal("owner"))
+			Expect(sqlEngine.GrantPrivilegesOwnerRole).To(Equal(dbName + "_owner"))
+			Expect(sqlEngine.CreateOwnerRoleCalled).To(BeTrue())
+			Expect(sqlEngine.CreateOwnerRoleDBName).To(Equal("test-db"))
+			Expect(sqlEngine.CreateOwnerRoleRolename).To(Equal(dbName + "_owner"))
+			Expect(sqlEngine.CloseCalled).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when creating the owner role fails", func() {
+			BeforeEach(func() {
+				sqlEngine.CreateOwnerRoleError = errors.New("Failed to create owner role")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to create owner role"))
+				Expect(sqlEngine.CreateUserCalled).To(BeFalse())
+			})
+		})
+
+		It("saves the binding state", func() {
+			_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stateStore.SaveBindingCalled).To(BeTrue())
+			Expect(stateStore.SaveBindingDetails.ID).To(Equal(bindingID))
+			Expect(stateStore.SaveBindingDetails.InstanceID).To(Equal(instanceID))
+			Expect(stateStore.SaveBindingDetails.AppGUID).To(Equal("Application-1"))
+			Expect(stateStore.SaveBindingDetails.Username).To(Equal(dbUsername))
+			Expect(stateStore.SaveBindingDetails.DBName).To(Equal("test-db"))
+			Expect(stateStore.SaveBindingDetails.Role).To(Equal("owner"))
+			Expect(stateStore.SaveBindingDetails.Password).To(Equal("encrypted:" + sqlEngine.CreateUserPassword))
+		})
+
+		Context("when the plan has a default binding role", func() {
+			BeforeEach(func() {
+				rdsProperties1.DefaultBindingRole = "read_only"
+			})
+
+			It("grants the plan default role", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.GrantPrivilegesRole).To(Equal("read_only"))
+				Expect(stateStore.SaveBindingDetails.Role).To(Equal("read_only"))
+			})
+
+			Context("and the role is set as a parameter", func() {
+				BeforeEach(func() {
+					bindDetails.Parameters = map[string]interface{}{"role": "read_write"}
+				})
+
+				It("grants the requested role", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(sqlEngine.GrantPrivilegesRole).To(Equal("read_write"))
+					Expect(stateStore.SaveBindingDetails.Role).To(Equal("read_write"))
+				})
+
+				Context("but the role is not one of the roles allowed by the Service Plan", func() {
+					BeforeEach(func() {
+						bindParameters["role"] = ParameterConstraints{Enum: []string{"read_only"}}
+					})
+
+					It("returns a bad request error", func() {
+						_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(Equal(`Parameters are not valid: role: role must be one of the following: "read_only"`))
+						Expect(sqlEngine.CreateUserCalled).To(BeFalse())
+					})
+				})
+			})
+		})
+
+		Context("when the role parameter is not valid", func() {
+			BeforeEach(func() {
+				bindDetails.Parameters = map[string]interface{}{"role": "superuser"}
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Role 'superuser' is not valid"))
+				Expect(sqlEngine.CreateUserCalled).To(BeFalse())
+			})
+		})
+
+		Context("when granting privileges fails", func() {
+			BeforeEach(func() {
+				sqlEngine.GrantPrivilegesError = errors.New("Failed to grant privileges")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to grant privileges"))
+				Expect(stateStore.SaveBindingCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the master password is stored", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:             instanceID,
+					PlanID:         "Plan-1",
+					MasterPassword: "encrypted:stored-master-password",
+				}
+			})
+
+			It("uses the stored master password", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(encryptor.DecryptCalled).To(BeTrue())
+				Expect(sqlEngine.OpenPassword).To(Equal("stored-master-password"))
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
+			})
+
+			Context("and decrypting the master password fails", func() {
+				BeforeEach(func() {
+					encryptor.DecryptError = errors.New("Failed to decrypt")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Failed to decrypt"))
+					Expect(sqlEngine.OpenCalled).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the master password is not stored", func() {
+			It("uses the legacy master password", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.OpenPassword).To(Equal(masterUserPassword))
+			})
+
+			It("resets the master password", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyMasterPasswordCalled).To(BeTrue())
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
+				Expect(dbInstance.ModifyMasterPasswordID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+				Expect(dbInstance.ModifyMasterPasswordMasterPassword).ToNot(Equal(masterUserPassword))
+				Expect(stateStore.Instances[instanceID].PlanID).To(Equal("Plan-1"))
+				Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:" + dbInstance.ModifyMasterPasswordMasterPassword))
+			})
+
+			It("runs the reset as an operation, so other binds wait for it", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				lastOperation := stateStore.Instances[instanceID].LastOperation
+				Expect(lastOperation.Type).To(Equal(statestore.OperationRotateMasterPassword))
+				Expect(lastOperation.State).To(Equal(operation.StateInProgress))
+
+				_, _, err = rdsBroker.Bind(instanceID, "other-binding-id", bindDetails, acceptsIncomplete)
+				Expect(err).To(Equal(brokerapi.ErrConcurrentAccess))
+			})
+
+			Context("and Engine is Aurora", func() {
+				BeforeEach(func() {
+					rdsProperties1.Engine = "aurora"
+				})
+
+				It("resets the DB Cluster master password", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbCluster.ModifyMasterPasswordCalled).To(BeTrue())
+					Expect(dbCluster.ModifyCalled).To(BeFalse())
+					Expect(dbCluster.ModifyMasterPasswordID).To(Equal(dbClusterIdentifier))
+					Expect(dbCluster.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+					Expect(dbInstance.ModifyMasterPasswordCalled).To(BeFalse())
+					Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:" + dbCluster.ModifyMasterPasswordMasterPassword))
+				})
+			})
+
+			Context("and resetting the master password fails", func() {
+				BeforeEach(func() {
+					dbInstance.ModifyMasterPasswordError = errors.New("operation failed")
+				})
+
+				It("does not return an error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not save the new master password", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stateStore.Instances[instanceID].MasterPassword).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when saving the binding state fails", func() {
+			BeforeEach(func() {
+				stateStore.SaveBindingError = errors.New("Failed to save binding")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to save binding"))
+			})
+		})
+
+		Context("when Parameters are not valid", func() {
+			BeforeEach(func() {
+				bindDetails.Parameters = map[string]interface{}{"dbname": true}
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Parameters are not valid: dbname: Invalid type. Expected: string, given: boolean"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusBadRequest))
+			})
+
+			Context("and bind parameters are not allowed by the Service Plan", func() {
+				BeforeEach(func() {
+					bindParameters = nil
+				})
+
+				It("returns a bad request error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Parameters are not valid: (root): Additional property dbname is not allowed"))
+				})
+			})
+		})
+
+		Context("when Service is not found", func() {
+			BeforeEach(func() {
+				bindDetails.ServiceID = "unknown"
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service 'unknown' not found"))
+			})
+		})
+
+		Context("when Service is not bindable", func() {
+			BeforeEach(func() {
+				serviceBindable = false
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrInstanceNotBindable))
+			})
+		})
+
+		Context("when Service Plan is not found", func() {
+			BeforeEach(func() {
+				bindDetails.PlanID = "unknown"
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+			})
+		})
+
+		Context("when describing the DB Instance fails", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("when the DB Instance does not exists", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				})
+			})
+		})
+
+		Context("when Engine is aurora", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "aurora"
+			})
+
+			It("does not describe the DB Instance", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DescribeCalled).To(BeFalse())
+			})
+
+			It("returns the DB Cluster reader endpoint", func() {
+				dbCluster.DescribeDBClusterDetails.Endpoint = "cluster-endpoint-address"
+				dbCluster.DescribeDBClusterDetails.ReaderEndpoint = "cluster-reader-endpoint-address"
+
+				bindingResponse, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				credentials := bindingResponse.Credentials.(*Credentials)
+				Expect(credentials.Host).To(Equal("cluster-endpoint-address"))
+				Expect(credentials.ReaderEndpoint).To(Equal("cluster-reader-endpoint-address"))
+				Expect(credentials.ReaderURI).To(ContainSubstring("@cluster-reader-endpoint-address:"))
+				Expect(credentials.ReaderJDBCURI).To(ContainSubstring("jdbc:fake://cluster-reader-endpoint-address:"))
+			})
+
+			Context("when describing the DB Cluster fails", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = errors.New("operation failed")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+				})
+
+				Context("when the DB Cluster does not exists", func() {
+					BeforeEach(func() {
+						dbCluster.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					})
+
+					It("returns the proper error", func() {
+						_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					})
+				})
+			})
+		})
+
+		Context("when the DB Instance is stopped", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeDBInstanceDetails.Status = "stopped"
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' is stopped: update it with the 'state' parameter set to 'running' first"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusUnprocessableEntity))
+				Expect(sqlEngine.OpenCalled).To(BeFalse())
+			})
+		})
+
+		Context("when getting the SQL Engine fails", func() {
+			BeforeEach(func() {
+				sqlProvider.GetSQLEngineError = errors.New("Engine 'unknown' not supported")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Engine 'unknown' not supported"))
+			})
+		})
+
+		Context("when opening a DB connection fails", func() {
+			BeforeEach(func() {
+				sqlEngine.OpenError = errors.New("Failed to open sqlEngine")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to open sqlEngine"))
+			})
+		})
+
+		Context("when DBNname Parameter is set", func() {
+			BeforeEach(func() {
+				bindDetails.Parameters = map[string]interface{}{"dbname": "my-test-db"}
+			})
+
+			Context("and it is not a valid database name", func() {
+				BeforeEach(func() {
+					bindDetails.Parameters = map[string]interface{}{"dbname": "db\"; DROP DATABASE postgres; --"}
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("is not valid"))
+					Expect(sqlEngine.OpenCalled).To(BeFalse())
+					Expect(sqlEngine.CreateDBCalled).To(BeFalse())
+				})
+			})
+
+			It("returns the proper response", func() {
+				bindingResponse, _, _ := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				credentials := bindingResponse.Credentials.(*Credentials)
+				Expect(bindingResponse.SyslogDrainURL).To(BeEmpty())
+				Expect(credentials.Name).To(Equal("my-test-db"))
+			})
+
+			It("creates the DB with the proper name", func() {
+				rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(sqlEngine.CreateDBCalled).To(BeTrue())
+				Expect(sqlEngine.CreateDBDBName).To(Equal("my-test-db"))
+				Expect(sqlEngine.GrantPrivilegesDBName).To(Equal("my-test-db"))
+			})
+
+			It("makes the owner role the owner of the DB", func() {
+				rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(sqlEngine.CreateOwnerRoleDBName).To(Equal("my-test-db"))
+				Expect(sqlEngine.CreateOwnerRoleRolename).To(Equal(dbName + "_owner"))
+			})
+
+			Context("when creating the DB fails", func() {
+				BeforeEach(func() {
+					sqlEngine.CreateDBError = errors.New("Failed to create sqlEngine")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Failed to create sqlEngine"))
+					Expect(sqlEngine.CloseCalled).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when creating a DB user fails", func() {
+			BeforeEach(func() {
+				sqlEngine.CreateUserError = errors.New("Failed to create user")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to create user"))
+				Expect(sqlEngine.CloseCalled).To(BeTrue())
+			})
+		})
+
+		Context("when granting privileges fails", func() {
+			BeforeEach(func() {
+				sqlEngine.GrantPrivilegesError = errors.New("Failed to grant privileges")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to grant privileges"))
+				Expect(sqlEngine.CloseCalled).To(BeTrue())
+			})
+		})
+
+		Context("when the instance is a read replica", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "aurora"
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:            instanceID,
+					PlanID:        "Plan-1",
+					ReadReplicaOf: "source-instance-id",
+				}
+				stateStore.Instances["source-instance-id"] = statestore.InstanceDetails{
+					ID:             "source-instance-id",
+					PlanID:         "Plan-1",
+					MasterPassword: "encrypted:source-master-password",
+				}
+				dbCluster.DescribeDBClusterDetails.Endpoint = "writer-endpoint-address"
+				dbInstance.DescribeDBInstanceDetails.Address = "reader-endpoint-address"
+			})
+
+			It("creates the user in the source instance", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.DescribeID).To(Equal("cf-source-instance-id"))
+				Expect(sqlEngine.OpenAddress).To(Equal("writer-endpoint-address"))
+				Expect(sqlEngine.OpenPassword).To(Equal("source-master-password"))
+				Expect(sqlEngine.CreateOwnerRoleRolename).To(Equal("cf_source_instance_id_owner"))
+				Expect(sqlEngine.CreateUserCalled).To(BeTrue())
+			})
+
+			It("returns the writer and reader endpoints", func() {
+				bindingResponse, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				credentials := bindingResponse.Credentials.(*Credentials)
+				Expect(credentials.Host).To(Equal("writer-endpoint-address"))
+				Expect(credentials.ReaderEndpoint).To(Equal("reader-endpoint-address"))
+				Expect(credentials.ReaderPort).To(Equal(int64(3306)))
+				Expect(credentials.ReaderURI).To(ContainSubstring("@reader-endpoint-address:3306/test-db?reconnect=true"))
+				Expect(credentials.ReaderJDBCURI).To(ContainSubstring("jdbc:fake://reader-endpoint-address:3306/test-db"))
+			})
+		})
+	})
+
+	var _ = Describe("Unbind", func() {
+		var (
+			unbindDetails     brokerapi.UnbindDetails
+			acceptsIncomplete bool
+		)
+
+		BeforeEach(func() {
+			unbindDetails = brokerapi.UnbindDetails{
+				ServiceID: "Service-1",
+				PlanID:    "Plan-1",
+			}
+			acceptsIncomplete = true
+
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier:     dbInstanceIdentifier,
+				Address:        "endpoint-address",
+				Port:           3306,
+				DBName:         "test-db",
+				MasterUsername: "master-username",
+			}
+
+			dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+				Identifier:     dbClusterIdentifier,
+				Endpoint:       "endpoint-address",
+				Port:           3306,
+				DatabaseName:   "test-db",
+				MasterUsername: "master-username",
+			}
+		})
+
+		It("makes the proper calls", func() {
+			_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+			Expect(dbCluster.DescribeCalled).To(BeFalse())
+			Expect(dbInstance.DescribeCalled).To(BeTrue())
+			Expect(dbInstance.DescribeID).To(Equal(dbInstanceIdentifier))
+			Expect(sqlProvider.GetSQLEngineCalled).To(BeTrue())
+			Expect(sqlProvider.GetSQLEngineEngine).To(Equal("test-engine-1"))
+			Expect(sqlEngine.OpenCalled).To(BeTrue())
+			Expect(sqlEngine.OpenAddress).To(Equal("endpoint-address"))
+			Expect(sqlEngine.OpenPort).To(Equal(int64(3306)))
+			Expect(sqlEngine.OpenDBName).To(Equal("test-db"))
+			Expect(sqlEngine.OpenUsername).To(Equal("master-username"))
+			Expect(sqlEngine.OpenPassword).ToNot(BeEmpty())
+			Expect(sqlEngine.PrivilegesCalled).To(BeTrue())
+			Expect(sqlEngine.RevokePrivilegesCalled).To(BeFalse())
+			Expect(sqlEngine.CreateOwnerRoleCalled).To(BeFalse())
+			Expect(sqlEngine.ReassignOwnershipCalled).To(BeTrue())
+			Expect(sqlEngine.ReassignOwnershipDBNames).To(Equal([]string{"test-db"}))
+			Expect(sqlEngine.ReassignOwnershipUsername).To(Equal(dbUsername))
+			Expect(sqlEngine.ReassignOwnershipRolename).To(Equal(dbName + "_owner"))
+			Expect(sqlEngine.DropDBCalled).To(BeFalse())
+			Expect(sqlEngine.DropUserCalled).To(BeTrue())
+			Expect(sqlEngine.DropUserUsername).To(Equal(dbUsername))
+			Expect(sqlEngine.CloseCalled).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the DB Cluster is stopping", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "aurora"
+				dbCluster.DescribeDBClusterDetails.Status = "stopping"
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Instance '" + instanceID + "' is stopping: update it with the 'state' parameter set to 'running' first"))
+				Expect(err.(*brokerapi.FailureResponse).StatusCode()).To(Equal(http.StatusUnprocessableEntity))
+				Expect(sqlEngine.DropUserCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the user has privileges over several DBs", func() {
+			BeforeEach(func() {
+				sqlEngine.PrivilegesPrivileges = map[string][]string{
+					"test-db":      []string{dbUsername},
+					"other-db":     []string{dbUsername, "another-user"},
+					"another-db":   []string{dbUsername},
+					"unrelated-db": []string{"another-user"},
+				}
+			})
+
+			It("reassigns the ownership in all of them", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.ReassignOwnershipDBNames).To(ConsistOf("test-db", "another-db", "other-db"))
+				Expect(sqlEngine.ReassignOwnershipDBNames[0]).To(Equal("test-db"))
+				Expect(sqlEngine.DropUserCalled).To(BeTrue())
+			})
+		})
+
+		Context("when reassigning the ownership fails", func() {
+			BeforeEach(func() {
+				sqlEngine.ReassignOwnershipError = errors.New("Failed to reassign ownership")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to reassign ownership"))
+				Expect(sqlEngine.DropUserCalled).To(BeFalse())
+				Expect(stateStore.DeleteBindingCalled).To(BeFalse())
+			})
+		})
+
+		Context("when Service Plan is not found", func() {
+			BeforeEach(func() {
+				unbindDetails.PlanID = "unknown"
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Service Plan 'unknown' not found"))
+			})
+		})
+
+		Context("when describing the DB Instance fails", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("when the DB Instance does not exists", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				})
+			})
+		})
+
+		Context("when Engine is aurora", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "aurora"
+			})
+
+			It("does not describe the DB Instance", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DescribeCalled).To(BeFalse())
+			})
+
+			Context("when describing the DB Cluster fails", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = errors.New("operation failed")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+				})
+
+				Context("when the DB Cluster does not exists", func() {
+					BeforeEach(func() {
+						dbCluster.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					})
+
+					It("returns the proper error", func() {
+						_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					})
+				})
+			})
+		})
+
+		Context("when getting the SQL Engine fails", func() {
+			BeforeEach(func() {
+				sqlProvider.GetSQLEngineError = errors.New("SQL Engine 'unknown' not supported")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("SQL Engine 'unknown' not supported"))
+			})
+		})
+
+		Context("when opening a DB connection fails", func() {
+			BeforeEach(func() {
+				sqlEngine.OpenError = errors.New("Failed to open sqlEngine")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to open sqlEngine"))
+			})
+		})
+
+		Context("when getting privileges fails", func() {
+			BeforeEach(func() {
+				sqlEngine.PrivilegesError = errors.New("Failed to get privileges")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to get privileges"))
+				Expect(sqlEngine.CloseCalled).To(BeTrue())
+			})
+		})
+
+		Context("when user has privileges over a DB", func() {
+			BeforeEach(func() {
+				sqlEngine.PrivilegesPrivileges = map[string][]string{"test-db": []string{dbUsername}}
+			})
+
+			It("makes the proper calls", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
+				Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("test-db"))
+				Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
+				Expect(sqlEngine.DropDBCalled).To(BeFalse())
+				Expect(sqlEngine.CloseCalled).To(BeTrue())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when revoking privileges fails", func() {
+				BeforeEach(func() {
+					sqlEngine.RevokePrivilegesError = errors.New("Failed to revoke privileges")
+				})
+
+				It("returns the proper error", func() {
+					_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Failed to revoke privileges"))
+					Expect(sqlEngine.CloseCalled).To(BeTrue())
+				})
+			})
+
+			Context("and the db is not the master db", func() {
+				BeforeEach(func() {
+					sqlEngine.PrivilegesPrivileges = map[string][]string{"another-test-db": []string{dbUsername}}
+				})
+
+				It("makes the proper calls", func() {
+					_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+					Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
+					Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("another-test-db"))
+					Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
+					Expect(sqlEngine.DropDBCalled).To(BeTrue())
+					Expect(sqlEngine.DropDBDBName).To(Equal("another-test-db"))
+					Expect(sqlEngine.CloseCalled).To(BeTrue())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				Context("when droping the DB fails", func() {
+					BeforeEach(func() {
+						sqlEngine.DropDBError = errors.New("Failed to drop db")
+					})
+
+					It("returns the proper error", func() {
+						_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(Equal("Failed to drop db"))
+						Expect(sqlEngine.CloseCalled).To(BeTrue())
+					})
+				})
+
+				Context("but there are other users with grants over the db", func() {
+					BeforeEach(func() {
+						sqlEngine.PrivilegesPrivileges = map[string][]string{"another-test-db": []string{dbUsername, "another-user"}}
+					})
+
+					It("makes the proper calls", func() {
+						_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+						Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
+						Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("another-test-db"))
+						Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
+						Expect(sqlEngine.DropDBCalled).To(BeFalse())
+						Expect(sqlEngine.CloseCalled).To(BeTrue())
+						Expect(err).ToNot(HaveOccurred())
+					})
+				})
+
+				Context("but there are other bindings over the db", func() {
+					BeforeEach(func() {
+						stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+							"another-binding-id": statestore.BindingDetails{ID: "another-binding-id", InstanceID: instanceID, DBName: "another-test-db", Role: "read_only"},
+						}
+					})
+
+					It("does not drop the DB", func() {
+						_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
+						Expect(sqlEngine.DropDBCalled).To(BeFalse())
+					})
+				})
+			})
+		})
+
+		Context("when the binding state records the DB", func() {
+			BeforeEach(func() {
+				stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+					bindingID: statestore.BindingDetails{ID: bindingID, InstanceID: instanceID, DBName: "another-test-db", Role: "read_only"},
+				}
+			})
+
+			It("revokes the privileges over the recorded DB", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.RevokePrivilegesCalled).To(BeTrue())
+				Expect(sqlEngine.RevokePrivilegesDBName).To(Equal("another-test-db"))
+				Expect(sqlEngine.RevokePrivilegesUsername).To(Equal(dbUsername))
+				Expect(sqlEngine.DropDBCalled).To(BeTrue())
+				Expect(sqlEngine.DropDBDBName).To(Equal("another-test-db"))
+			})
+		})
+
+		Context("when the master password is stored", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:             instanceID,
+					PlanID:         "Plan-1",
+					MasterPassword: "encrypted:stored-master-password",
+				}
+			})
+
+			It("uses the stored master password", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.OpenPassword).To(Equal("stored-master-password"))
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the master password is not stored", func() {
+			It("resets the master password", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.OpenPassword).To(Equal(masterUserPassword))
+				Expect(dbInstance.ModifyMasterPasswordCalled).To(BeTrue())
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
+				Expect(dbInstance.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+				Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:" + dbInstance.ModifyMasterPasswordMasterPassword))
+			})
+		})
+
+		Context("when the binding state exists", func() {
+			BeforeEach(func() {
+				stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+					bindingID: statestore.BindingDetails{ID: bindingID, InstanceID: instanceID},
+				}
+			})
+
+			It("deletes the binding state", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stateStore.Bindings[instanceID]).ToNot(HaveKey(bindingID))
+			})
+		})
+
+		Context("when deleting a user fails", func() {
+			BeforeEach(func() {
+				sqlEngine.DropUserError = errors.New("Failed to delete user")
+			})
+
+			It("returns the proper error", func() {
+				_, _, err := rdsBroker.Unbind(instanceID, bindingID, unbindDetails, acceptsIncomplete)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to delete user"))
+				Expect(sqlEngine.CloseCalled).To(BeTrue())
+				Expect(stateStore.DeleteBindingCalled).To(BeFalse())
+			})
+		})
+	})
+
+	var _ = Describe("FetchBinding", func() {
+		BeforeEach(func() {
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:     instanceID,
+				PlanID: "Plan-1",
+			}
+			stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+				bindingID: statestore.BindingDetails{
+					ID:         bindingID,
+					InstanceID: instanceID,
+					Username:   dbUsername,
+					Password:   "encrypted:binding-password",
+					DBName:     "test-db",
+				},
+			}
+
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier:     dbInstanceIdentifier,
+				Address:        "endpoint-address",
+				Port:           3306,
+				DBName:         "test-db",
+				MasterUsername: "master-username",
+			}
+		})
+
+		It("returns the proper response", func() {
+			bindingResponse, err := rdsBroker.FetchBinding(instanceID, bindingID)
+			Expect(err).ToNot(HaveOccurred())
+			credentials := bindingResponse.Credentials.(*Credentials)
+			Expect(credentials.Host).To(Equal("endpoint-address"))
+			Expect(credentials.Port).To(Equal(int64(3306)))
+			Expect(credentials.Name).To(Equal("test-db"))
+			Expect(credentials.Username).To(Equal(dbUsername))
+			Expect(credentials.Password).To(Equal("binding-password"))
+			Expect(credentials.URI).To(ContainSubstring("@endpoint-address:3306/test-db?reconnect=true"))
+			Expect(sqlEngine.OpenCalled).To(BeFalse())
+		})
+
+		Context("when the binding does not exist", func() {
+			It("returns the proper error", func() {
+				_, err := rdsBroker.FetchBinding(instanceID, "unknown")
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrBindingDoesNotExist))
+			})
+		})
+
+		Context("when the binding has no stored credentials", func() {
+			BeforeEach(func() {
+				binding := stateStore.Bindings[instanceID][bindingID]
+				binding.Password = ""
+				stateStore.Bindings[instanceID][bindingID] = binding
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.FetchBinding(instanceID, bindingID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Credentials for Service Binding 'binding-id' are not available"))
+			})
+		})
+
+		Context("when the DB Instance does not exists", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.FetchBinding(instanceID, bindingID)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+			})
+		})
+	})
+
+	var _ = Describe("GetBinding", func() {
+		BeforeEach(func() {
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:     instanceID,
+				PlanID: "Plan-1",
+			}
+			stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+				bindingID: statestore.BindingDetails{
+					ID:         bindingID,
+					InstanceID: instanceID,
+					Username:   dbUsername,
+					Password:   "encrypted:binding-password",
+					DBName:     "test-db",
+					Parameters: map[string]interface{}{"role": "read_only"},
+				},
+			}
+
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier:     dbInstanceIdentifier,
+				Address:        "endpoint-address",
+				Port:           3306,
+				DBName:         "test-db",
+				MasterUsername: "master-username",
+			}
+		})
+
+		It("returns the proper response", func() {
+			getBindingResponse, err := rdsBroker.GetBinding(instanceID, bindingID)
+			Expect(err).ToNot(HaveOccurred())
+			credentials := getBindingResponse.Credentials.(*Credentials)
+			Expect(credentials.Host).To(Equal("endpoint-address"))
+			Expect(credentials.Username).To(Equal(dbUsername))
+			Expect(credentials.Password).To(Equal("binding-password"))
+			Expect(getBindingResponse.Parameters).To(Equal(map[string]interface{}{"role": "read_only"}))
+		})
+
+		Context("when the binding does not exist", func() {
+			It("returns the proper error", func() {
+				_, err := rdsBroker.GetBinding(instanceID, "unknown")
+				Expect(err).To(Equal(brokerapi.ErrBindingDoesNotExist))
+			})
+		})
+	})
+
+	var _ = Describe("LastBindingOperation", func() {
+		BeforeEach(func() {
+			stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+				bindingID: statestore.BindingDetails{
+					ID:         bindingID,
+					InstanceID: instanceID,
+				},
+			}
+		})
+
+		It("returns the proper response", func() {
+			lastOperationResponse, err := rdsBroker.LastBindingOperation(instanceID, bindingID, brokerapi.PollDetails{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationSucceeded))
+		})
+
+		Context("when the binding does not exist", func() {
+			It("returns the proper error", func() {
+				_, err := rdsBroker.LastBindingOperation(instanceID, "unknown", brokerapi.PollDetails{})
+				Expect(err).To(Equal(brokerapi.ErrBindingDoesNotExist))
+			})
+		})
+	})
+
+	var _ = Describe("RotateBindingCredentials", func() {
+		BeforeEach(func() {
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:             instanceID,
+				PlanID:         "Plan-1",
+				MasterPassword: "encrypted:master-password",
+			}
+			stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+				bindingID: statestore.BindingDetails{
+					ID:         bindingID,
+					InstanceID: instanceID,
+					Username:   dbUsername,
+					Password:   "encrypted:old-binding-password",
+					DBName:     "test-db",
+				},
+			}
+
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier:     dbInstanceIdentifier,
+				Address:        "endpoint-address",
+				Port:           3306,
+				DBName:         "test-db",
+				MasterUsername: "master-username",
+			}
+		})
+
+		It("makes the proper calls", func() {
+			err := rdsBroker.RotateBindingCredentials(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sqlEngine.OpenCalled).To(BeTrue())
+			Expect(sqlEngine.OpenUsername).To(Equal("master-username"))
+			Expect(sqlEngine.OpenPassword).To(Equal("master-password"))
+			Expect(sqlEngine.AlterUserPasswordCalled).To(BeTrue())
+			Expect(sqlEngine.AlterUserPasswordUsername).To(Equal(dbUsername))
+			Expect(sqlEngine.AlterUserPasswordPassword).To(HaveLen(32))
+			Expect(sqlEngine.CloseCalled).To(BeTrue())
+		})
+
+		It("saves the new binding credentials", func() {
+			err := rdsBroker.RotateBindingCredentials(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			binding := stateStore.Bindings[instanceID][bindingID]
+			Expect(binding.Password).To(Equal("encrypted:" + sqlEngine.AlterUserPasswordPassword))
+			Expect(binding.RotatedAt).ToNot(BeZero())
+			Expect(binding.PendingPassword).To(BeEmpty())
+		})
+
+		Context("when the instance has no bindings", func() {
+			BeforeEach(func() {
+				delete(stateStore.Bindings, instanceID)
+			})
+
+			It("does not open a connection", func() {
+				err := rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.OpenCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the instance state does not exist", func() {
+			BeforeEach(func() {
+				delete(stateStore.Instances, instanceID)
+			})
+
+			It("returns the proper error", func() {
+				err := rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+			})
+		})
+
+		Context("when altering the user password fails", func() {
+			BeforeEach(func() {
+				sqlEngine.AlterUserPasswordError = errors.New("Failed to alter user password")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to alter user password"))
+			})
+
+			It("keeps the previous binding credentials", func() {
+				rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(stateStore.Bindings[instanceID][bindingID].Password).To(Equal("encrypted:old-binding-password"))
+				Expect(stateStore.Bindings[instanceID][bindingID].PendingPassword).To(BeEmpty())
+			})
+		})
+
+		Context("when saving the new password fails", func() {
+			BeforeEach(func() {
+				stateStore.SaveBindingError = errors.New("Failed to save binding")
+			})
+
+			It("does not alter the user password", func() {
+				err := rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to save binding"))
+				Expect(sqlEngine.AlterUserPasswordCalled).To(BeFalse())
+				Expect(stateStore.Bindings[instanceID][bindingID].Password).To(Equal("encrypted:old-binding-password"))
+			})
+		})
+
+		Context("when saving the binding fails after altering the user password", func() {
+			BeforeEach(func() {
+				stateStore.SaveBindingError = errors.New("Failed to save binding")
+				stateStore.SaveBindingErrorAfter = 1
+			})
+
+			It("reverts the user password", func() {
+				err := rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to save binding"))
+				Expect(sqlEngine.AlterUserPasswordPasswords).To(HaveLen(2))
+				Expect(sqlEngine.AlterUserPasswordPasswords[1]).To(Equal("old-binding-password"))
+				Expect(stateStore.Bindings[instanceID][bindingID].Password).To(Equal("encrypted:old-binding-password"))
+			})
+
+			It("keeps the new password as pending", func() {
+				rdsBroker.RotateBindingCredentials(instanceID)
+				Expect(stateStore.Bindings[instanceID][bindingID].PendingPassword).To(Equal("encrypted:" + sqlEngine.AlterUserPasswordPasswords[0]))
+			})
+		})
+	})
+
+	var _ = Describe("RotateExpiredBindingCredentials", func() {
+		BeforeEach(func() {
+			rdsProperties1.CredentialsRotationDays = 90
+
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:             instanceID,
+				PlanID:         "Plan-1",
+				MasterPassword: "encrypted:master-password",
+			}
+			stateStore.Bindings[instanceID] = map[string]statestore.BindingDetails{
+				bindingID: statestore.BindingDetails{
+					ID:         bindingID,
+					InstanceID: instanceID,
+					Username:   dbUsername,
+					Password:   "encrypted:old-binding-password",
+					CreatedAt:  time.Now().Add(-91 * 24 * time.Hour),
+				},
+			}
+		})
+
+		It("rotates the expired binding credentials", func() {
+			rdsBroker.RotateExpiredBindingCredentials()
+			Expect(sqlEngine.AlterUserPasswordCalled).To(BeTrue())
+			Expect(sqlEngine.AlterUserPasswordUsername).To(Equal(dbUsername))
+			Expect(stateStore.Bindings[instanceID][bindingID].Password).To(Equal("encrypted:" + sqlEngine.AlterUserPasswordPassword))
+		})
+
+		Context("when the binding credentials were rotated recently", func() {
+			BeforeEach(func() {
+				binding := stateStore.Bindings[instanceID][bindingID]
+				binding.RotatedAt = time.Now().Add(-24 * time.Hour)
+				stateStore.Bindings[instanceID][bindingID] = binding
+			})
+
+			It("does not rotate the binding credentials", func() {
+				rdsBroker.RotateExpiredBindingCredentials()
+				Expect(sqlEngine.AlterUserPasswordCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the Service Plan has no rotation policy", func() {
+			BeforeEach(func() {
+				rdsProperties1.CredentialsRotationDays = 0
+			})
+
+			It("does not rotate the binding credentials", func() {
+				rdsBroker.RotateExpiredBindingCredentials()
+				Expect(sqlEngine.AlterUserPasswordCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the instance is being deprovisioned", func() {
+			BeforeEach(func() {
+				instance := stateStore.Instances[instanceID]
+				instance.LastOperation = statestore.OperationDetails{Type: statestore.OperationDeprovision}
+				stateStore.Instances[instanceID] = instance
+			})
+
+			It("does not rotate the binding credentials", func() {
+				rdsBroker.RotateExpiredBindingCredentials()
+				Expect(sqlEngine.AlterUserPasswordCalled).To(BeFalse())
+			})
+		})
+	})
+
+	var _ = Describe("RotateMasterPassword", func() {
+		BeforeEach(func() {
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:             instanceID,
+				PlanID:         "Plan-1",
+				MasterPassword: "encrypted:old-master-password",
+			}
+		})
+
+		It("makes the proper calls", func() {
+			err := rdsBroker.RotateMasterPassword(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbInstance.ModifyMasterPasswordCalled).To(BeTrue())
+			Expect(dbInstance.ModifyCalled).To(BeFalse())
+			Expect(dbInstance.ModifyMasterPasswordID).To(Equal(dbInstanceIdentifier))
+			Expect(dbInstance.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+		})
+
+		It("saves the new master password as soon as RDS accepts it", func() {
+			err := rdsBroker.RotateMasterPassword(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			instance := stateStore.Instances[instanceID]
+			Expect(instance.MasterPassword).To(Equal("encrypted:" + dbInstance.ModifyMasterPasswordMasterPassword))
+			Expect(instance.PendingMasterPassword).To(BeEmpty())
+		})
+
+		It("runs as an operation that waits for the DB Instance to be available", func() {
+			err := rdsBroker.RotateMasterPassword(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			lastOperation := stateStore.Instances[instanceID].LastOperation
+			Expect(lastOperation.Type).To(Equal(statestore.OperationRotateMasterPassword))
+			Expect(lastOperation.State).To(Equal(operation.StateInProgress))
+			Expect(lastOperation.Step).To(Equal("wait-available"))
+		})
+
+		Context("when binding the instance afterwards", func() {
+			var bindDetails brokerapi.BindDetails
+
+			BeforeEach(func() {
+				bindDetails = brokerapi.BindDetails{ServiceID: "Service-1", PlanID: "Plan-1", AppGUID: "Application-1"}
+			})
+
+			JustBeforeEach(func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+					Identifier:     dbInstanceIdentifier,
+					Status:         "resetting-master-credentials",
+					Address:        "endpoint-address",
+					Port:           3306,
+					DBName:         "test-db",
+					MasterUsername: "master-username",
+				}
+			})
+
+			It("waits for RDS to finish resetting the master credentials", func() {
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, true)
+				Expect(err).To(Equal(brokerapi.ErrConcurrentAccess))
+				Expect(sqlEngine.OpenCalled).To(BeFalse())
+			})
+
+			It("binds with the new master password once the DB Instance is available", func() {
+				dbInstance.DescribeDBInstanceDetails.Status = "available"
+				rdsBroker.RunOperations()
+
+				_, _, err := rdsBroker.Bind(instanceID, bindingID, bindDetails, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine.OpenPassword).To(Equal(dbInstance.ModifyMasterPasswordMasterPassword))
+			})
+		})
+
+		Context("when the DB Instance is available", func() {
+			JustBeforeEach(func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				dbInstance.DescribeDBInstanceDetails.Status = "available"
+			})
+
+			It("completes the operation", func() {
+				rdsBroker.RunOperations()
+				Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateSucceeded))
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the instance has an operation in progress", func() {
+			BeforeEach(func() {
+				instance := stateStore.Instances[instanceID]
+				instance.LastOperation = statestore.OperationDetails{
+					Type:  statestore.OperationUpdate,
+					State: operation.StateInProgress,
+					Step:  "wait-available",
+				}
+				stateStore.Instances[instanceID] = instance
+			})
+
+			It("returns the proper error", func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrConcurrentAccess))
+				Expect(dbInstance.ModifyMasterPasswordCalled).To(BeFalse())
+			})
+
+			It("does not overwrite the operation in progress", func() {
+				rdsBroker.RotateMasterPassword(instanceID)
+				Expect(stateStore.Instances[instanceID].LastOperation.Type).To(Equal(statestore.OperationUpdate))
+				Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
+			})
+		})
+
+		Context("when Engine is Aurora", func() {
+			BeforeEach(func() {
+				rdsProperties1.Engine = "aurora"
+			})
+
+			It("makes the proper calls", func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.ModifyMasterPasswordCalled).To(BeTrue())
+				Expect(dbCluster.ModifyCalled).To(BeFalse())
+				Expect(dbCluster.ModifyMasterPasswordID).To(Equal(dbClusterIdentifier))
+				Expect(dbCluster.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+				Expect(dbInstance.ModifyMasterPasswordCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the instance state does not exist", func() {
+			BeforeEach(func() {
+				delete(stateStore.Instances, instanceID)
+				dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+					Identifier: dbInstanceIdentifier,
+					Engine:     "test-engine-1",
+					Status:     "available",
+				}
+				dbInstance.DescribeTagsTags = map[string]string{
+					"Service ID":      "Service-1",
+					"Plan ID":         "Plan-1",
+					"Organization ID": "organization-id",
+					"Space ID":        "space-id",
+				}
+			})
+
+			It("rotates the master password with the Service Plan of the DB Instance tags", func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.DescribeTagsID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.ModifyMasterPasswordID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+			})
+
+			It("creates the instance state", func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stateStore.Instances).To(HaveKey(instanceID))
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.ServiceID).To(Equal("Service-1"))
+				Expect(instance.PlanID).To(Equal("Plan-1"))
+				Expect(instance.OrganizationGUID).To(Equal("organization-id"))
+				Expect(instance.SpaceGUID).To(Equal("space-id"))
+				Expect(instance.MasterPassword).To(Equal("encrypted:" + dbInstance.ModifyMasterPasswordMasterPassword))
+				Expect(instance.LastOperation.Type).To(Equal(statestore.OperationRotateMasterPassword))
+			})
+
+			Context("and modifying the DB Instance fails", func() {
+				BeforeEach(func() {
+					dbInstance.ModifyMasterPasswordError = errors.New("operation failed")
+				})
+
+				It("does not create the instance state", func() {
+					err := rdsBroker.RotateMasterPassword(instanceID)
+					Expect(err).To(HaveOccurred())
+					Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
+				})
+			})
+
+			Context("and the DB Instance does not exist", func() {
+				BeforeEach(func() {
+					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					dbCluster.DescribeError = awsrds.ErrDBClusterDoesNotExist
+				})
+
+				It("returns the proper error", func() {
+					err := rdsBroker.RotateMasterPassword(instanceID)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
+				})
+			})
+		})
+
+		Context("when modifying the DB Instance fails", func() {
+			BeforeEach(func() {
+				dbInstance.ModifyMasterPasswordError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				err := rdsBroker.RotateMasterPassword(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			It("discards the pending master password", func() {
+				rdsBroker.RotateMasterPassword(instanceID)
+				Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:old-master-password"))
+				Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
+			})
+
+			Context("when the DB Instance does not exists", func() {
+				BeforeEach(func() {
+					dbInstance.ModifyMasterPasswordError = awsrds.ErrDBInstanceDoesNotExist
+				})
+
+				It("returns the proper error", func() {
+					err := rdsBroker.RotateMasterPassword(instanceID)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				})
+			})
+		})
+	})
+
+	var _ = Describe("CreateSnapshot", func() {
+		BeforeEach(func() {
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier: dbInstanceIdentifier,
+				Engine:     "test-engine",
+			}
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:               instanceID,
+				ServiceID:        "Service-1",
+				PlanID:           "Plan-1",
+				OrganizationGUID: "organization-id",
+				SpaceGUID:        "space-id",
+			}
+		})
+
+		It("returns the proper snapshot", func() {
+			snapshot, err := rdsBroker.CreateSnapshot(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshot.ID).To(HavePrefix("rds-broker-" + dbInstanceIdentifier + "-"))
+			Expect(snapshot.Status).To(Equal("creating"))
+			Expect(snapshot.Type).To(Equal("manual"))
+		})
+
+		It("makes the proper calls", func() {
+			snapshot, err := rdsBroker.CreateSnapshot(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbInstance.CreateSnapshotCalled).To(BeTrue())
+			Expect(dbInstance.CreateSnapshotID).To(Equal(dbInstanceIdentifier))
+			Expect(dbInstance.CreateSnapshotSnapshotID).To(Equal(snapshot.ID))
+			Expect(dbInstance.CreateSnapshotTags["Organization ID"]).To(Equal("organization-id"))
+			Expect(dbInstance.CreateSnapshotTags["Space ID"]).To(Equal("space-id"))
+			Expect(dbCluster.CreateSnapshotCalled).To(BeFalse())
+		})
+
+		Context("when Engine is Aurora", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeDBInstanceDetails.Engine = "aurora"
+			})
+
+			It("makes the proper calls", func() {
+				_, err := rdsBroker.CreateSnapshot(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.CreateSnapshotCalled).To(BeTrue())
+				Expect(dbCluster.CreateSnapshotID).To(Equal(dbClusterIdentifier))
+				Expect(dbInstance.CreateSnapshotCalled).To(BeFalse())
+			})
+		})
+
+		Context("when the DB Cluster is serverless", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+				dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+					Identifier: dbClusterIdentifier,
+					Engine:     "aurora-postgresql",
+					EngineMode: "serverless",
+				}
+			})
+
+			It("makes the proper calls", func() {
+				_, err := rdsBroker.CreateSnapshot(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.CreateSnapshotCalled).To(BeTrue())
+				Expect(dbCluster.CreateSnapshotID).To(Equal(dbClusterIdentifier))
+			})
+		})
+
+		Context("when the DB Instance does not exists", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.CreateSnapshot(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+			})
+		})
+
+		Context("when creating the DB Snapshot fails", func() {
+			BeforeEach(func() {
+				dbInstance.CreateSnapshotError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.CreateSnapshot(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+		})
+	})
+
+	var _ = Describe("ListSnapshots", func() {
+		var (
+			snapshotCreateTime time.Time
+		)
+
+		BeforeEach(func() {
+			snapshotCreateTime = time.Now()
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier: dbInstanceIdentifier,
+				Engine:     "test-engine",
+			}
+			dbInstance.ListSnapshotsDBSnapshotDetails = []awsrds.DBSnapshotDetails{
+				awsrds.DBSnapshotDetails{
+					Identifier:   "snapshot-id",
+					Status:       "available",
+					SnapshotType: "automated",
+					CreateTime:   snapshotCreateTime,
+				},
+			}
+		})
+
+		It("returns the proper snapshots", func() {
+			snapshots, err := rdsBroker.ListSnapshots(instanceID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbInstance.ListSnapshotsID).To(Equal(dbInstanceIdentifier))
+			Expect(snapshots).To(Equal([]adminapi.Snapshot{
+				adminapi.Snapshot{
+					ID:        "snapshot-id",
+					Status:    "available",
+					Type:      "automated",
+					CreatedAt: snapshotCreateTime,
+				},
+			}))
+		})
+
+		Context("when Engine is Aurora", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeDBInstanceDetails.Engine = "aurora"
+			})
+
+			It("makes the proper calls", func() {
+				_, err := rdsBroker.ListSnapshots(instanceID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbCluster.ListSnapshotsCalled).To(BeTrue())
+				Expect(dbCluster.ListSnapshotsID).To(Equal(dbClusterIdentifier))
+				Expect(dbInstance.ListSnapshotsCalled).To(BeFalse())
+			})
+		})
+
+		Context("when listing the DB Snapshots fails", func() {
+			BeforeEach(func() {
+				dbInstance.ListSnapshotsError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.ListSnapshots(instanceID)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+		})
+	})
+
+	var _ = Describe("RunOperations", func() {
+		BeforeEach(func() {
+			stateStore.Instances[instanceID] = statestore.InstanceDetails{
+				ID:     instanceID,
+				PlanID: "Plan-1",
+				LastOperation: statestore.OperationDetails{
+					Type:  statestore.OperationDeprovision,
+					State: operation.StateInProgress,
+					Step:  "delete-db-instance",
+				},
+			}
+			stateStore.Instances["other-instance-id"] = statestore.InstanceDetails{
+				ID:     "other-instance-id",
+				PlanID: "Plan-1",
+				LastOperation: statestore.OperationDetails{
+					Type:  statestore.OperationDeprovision,
+					State: operation.StateFailed,
+					Step:  "delete-db-instance",
+				},
+			}
+		})
+
+		It("resumes the operations in progress", func() {
+			rdsBroker.RunOperations()
+			Expect(dbInstance.DeleteIDs).To(Equal([]string{dbInstanceIdentifier}))
+			Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-instance-deleted"))
+			Expect(stateStore.Instances["other-instance-id"].LastOperation.State).To(Equal(operation.StateFailed))
+		})
+
+		Context("when an instance has a legacy master password", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:  statestore.OperationProvision,
+						State: operation.StateSucceeded,
+					},
+				}
+			})
+
+			It("replaces it with a random master password", func() {
+				rdsBroker.RunOperations()
+				Expect(dbInstance.ModifyMasterPasswordCalled).To(BeTrue())
+				Expect(dbInstance.ModifyMasterPasswordID).To(Equal(dbInstanceIdentifier))
+				Expect(dbInstance.ModifyMasterPasswordMasterPassword).To(HaveLen(32))
+				instance := stateStore.Instances[instanceID]
+				Expect(instance.MasterPassword).To(Equal("encrypted:" + dbInstance.ModifyMasterPasswordMasterPassword))
+				Expect(instance.LastOperation.Type).To(Equal(statestore.OperationRotateMasterPassword))
+			})
+
+			Context("and it is a read replica", func() {
+				BeforeEach(func() {
+					instance := stateStore.Instances[instanceID]
+					instance.ReadReplicaOf = "source-instance-id"
+					stateStore.Instances[instanceID] = instance
+				})
+
+				It("does not change the master password", func() {
+					rdsBroker.RunOperations()
+					Expect(dbInstance.ModifyMasterPasswordCalled).To(BeFalse())
+				})
+			})
+
+			Context("and it is waiting for its restored master password to be reset", func() {
+				BeforeEach(func() {
+					instance := stateStore.Instances[instanceID]
+					instance.PendingMasterPassword = "encrypted:restored-master-password"
+					instance.ResetMasterPassword = true
+					stateStore.Instances[instanceID] = instance
+				})
+
+				It("does not change the master password", func() {
+					rdsBroker.RunOperations()
+					Expect(dbInstance.ModifyMasterPasswordCalled).To(BeFalse())
+				})
+			})
+
+			Context("and modifying the master password fails", func() {
+				BeforeEach(func() {
+					dbInstance.ModifyMasterPasswordError = errors.New("operation failed")
+				})
+
+				It("keeps the legacy master password to migrate it later", func() {
+					rdsBroker.RunOperations()
+					instance := stateStore.Instances[instanceID]
+					Expect(instance.MasterPassword).To(BeEmpty())
+					Expect(instance.PendingMasterPassword).To(BeEmpty())
+					Expect(instance.LastOperation.Type).To(Equal(statestore.OperationProvision))
+				})
+			})
+		})
+
+		Context("when an instance has a random master password", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:             instanceID,
+					PlanID:         "Plan-1",
+					MasterPassword: "encrypted:master-password",
+					LastOperation: statestore.OperationDetails{
+						Type:  statestore.OperationProvision,
+						State: operation.StateSucceeded,
+					},
+				}
+			})
+
+			It("does not change the master password", func() {
+				rdsBroker.RunOperations()
+				Expect(dbInstance.ModifyMasterPasswordCalled).To(BeFalse())
+			})
+		})
+
+		Context("when listing the instances fails", func() {
+			BeforeEach(func() {
+				stateStore.ListInstancesError = errors.New("Failed to list instances")
+			})
+
+			It("does not resume any operation", func() {
+				rdsBroker.RunOperations()
+				Expect(dbInstance.DeleteCalled).To(BeFalse())
+			})
+		})
+	})
+
+	var _ = Describe("LastOperation", func() {
+		var (
+			dbInstanceStatus            string
+			lastOperationState          string
+			properLastOperationResponse brokerapi.LastOperationResponse
+			pollDetails                 brokerapi.PollDetails
+
+			statusDescriptions = map[string]string{
+				"available":               "healthy and available",
+				"creating":                "being created",
+				"deleting":                "being deleted",
+				"failed":                  "failed and cannot be recovered by RDS",
+				"incompatible-parameters": "its parameter group has incompatible parameters",
+			}
+		)
+
+		BeforeEach(func() {
+			dbInstanceStatus = "available"
+			lastOperationState = brokerapi.LastOperationSucceeded
+			pollDetails = brokerapi.PollDetails{
+				ServiceID: "Service-1",
+				PlanID:    "Plan-1",
+			}
+		})
+
+		JustBeforeEach(func() {
+			dbInstance.DescribeDBInstanceDetails = awsrds.DBInstanceDetails{
+				Identifier:     dbInstanceIdentifier,
+				Engine:         "test-engine",
+				Address:        "endpoint-address",
+				Port:           3306,
+				DBName:         "test-db",
+				MasterUsername: "master-username",
+				Status:         dbInstanceStatus,
+			}
+
+			properLastOperationResponse = brokerapi.LastOperationResponse{
+				State:       lastOperationState,
+				Description: "DB Instance '" + dbInstanceIdentifier + "' status is '" + dbInstanceStatus + "' (" + statusDescriptions[dbInstanceStatus] + ")",
+			}
+		})
+
+		Context("when describing the DB Instance fails", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = errors.New("operation failed")
+			})
+
+			It("returns the proper error", func() {
+				_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("operation failed"))
+			})
+
+			Context("when the DB Instance does not exists", func() {
+				BeforeEach(func() {
 					dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
 				})
 
-				It("returns the proper error", func() {
-					_, err := rdsBroker.LastOperation(instanceID)
-					Expect(err).To(HaveOccurred())
-					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				It("returns the proper error", func() {
+					_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).To(HaveOccurred())
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+				})
+
+				Context("and the instance is being deprovisioned", func() {
+					BeforeEach(func() {
+						stateStore.Instances[instanceID] = statestore.InstanceDetails{
+							ID:            instanceID,
+							LastOperation: statestore.OperationDetails{Type: statestore.OperationDeprovision},
+						}
+					})
+
+					It("deletes the instance state", func() {
+						_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+						Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
+					})
+				})
+			})
+		})
+
+		Context("when last operation is still in progress", func() {
+			BeforeEach(func() {
+				dbInstanceStatus = "creating"
+				lastOperationState = brokerapi.LastOperationInProgress
+			})
+
+			It("returns the proper LastOperationResponse", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+			})
+
+			Context("and the master password is being reset", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "resetting-master-credentials"
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:                    instanceID,
+						MasterPassword:        "encrypted:old-master-password",
+						PendingMasterPassword: "encrypted:new-master-password",
+					}
+				})
+
+				It("keeps the pending master password", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+					Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:old-master-password"))
+					Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(Equal("encrypted:new-master-password"))
+				})
+			})
+		})
+
+		Context("when last operation failed", func() {
+			BeforeEach(func() {
+				dbInstanceStatus = "failed"
+				lastOperationState = brokerapi.LastOperationFailed
+			})
+
+			It("returns the proper LastOperationResponse", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+			})
+		})
+
+		Context("when last operation succeeded", func() {
+			BeforeEach(func() {
+				dbInstanceStatus = "available"
+				lastOperationState = brokerapi.LastOperationSucceeded
+			})
+
+			It("returns the proper LastOperationResponse", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+			})
+
+			Context("and a master password rotation is pending", func() {
+				BeforeEach(func() {
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:                    instanceID,
+						MasterPassword:        "encrypted:old-master-password",
+						PendingMasterPassword: "encrypted:new-master-password",
+					}
+				})
+
+				It("persists the new master password", func() {
+					_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:new-master-password"))
+					Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
+				})
+			})
+
+			Context("and the instance was restored from a snapshot", func() {
+				BeforeEach(func() {
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:                    instanceID,
+						PlanID:                "Plan-1",
+						PendingMasterPassword: "encrypted:new-master-password",
+						ResetMasterPassword:   true,
+					}
+				})
+
+				It("resets the master password", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+					Expect(lastOperationResponse.Description).To(Equal("Resetting the master password of DB Instance '" + dbInstanceIdentifier + "'"))
+					Expect(dbInstance.ModifyMasterPasswordCalled).To(BeTrue())
+					Expect(dbInstance.ModifyCalled).To(BeFalse())
+					Expect(dbInstance.ModifyMasterPasswordMasterPassword).To(Equal("new-master-password"))
+					Expect(stateStore.Instances[instanceID].ResetMasterPassword).To(BeFalse())
+					Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(Equal("encrypted:new-master-password"))
+				})
+
+				Context("and resetting the master password fails", func() {
+					BeforeEach(func() {
+						dbInstance.ModifyMasterPasswordError = errors.New("operation failed")
+					})
+
+					It("returns the proper error", func() {
+						_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(Equal("operation failed"))
+						Expect(stateStore.Instances[instanceID].ResetMasterPassword).To(BeTrue())
+					})
+				})
+			})
+
+			Context("but has pending modifications", func() {
+				JustBeforeEach(func() {
+					dbInstance.DescribeDBInstanceDetails.PendingModifications = true
+
+					properLastOperationResponse = brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationInProgress,
+						Description: "DB Instance '" + dbInstanceIdentifier + "' has pending modifications",
+					}
+				})
+
+				It("returns the proper LastOperationResponse", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+				})
+			})
+		})
+
+		Context("when RDS reports a DB Instance status", func() {
+			for status, state := range map[string]string{
+				"available":                           brokerapi.LastOperationSucceeded,
+				"backing-up":                          brokerapi.LastOperationInProgress,
+				"configuring-enhanced-monitoring":     brokerapi.LastOperationInProgress,
+				"configuring-iam-database-auth":       brokerapi.LastOperationInProgress,
+				"configuring-log-exports":             brokerapi.LastOperationInProgress,
+				"converting-to-vpc":                   brokerapi.LastOperationInProgress,
+				"creating":                            brokerapi.LastOperationInProgress,
+				"delete-precheck":                     brokerapi.LastOperationInProgress,
+				"deleting":                            brokerapi.LastOperationInProgress,
+				"failed":                              brokerapi.LastOperationFailed,
+				"inaccessible-encryption-credentials": brokerapi.LastOperationFailed,
+				"incompatible-network":                brokerapi.LastOperationFailed,
+				"incompatible-option-group":           brokerapi.LastOperationFailed,
+				"incompatible-parameters":             brokerapi.LastOperationFailed,
+				"incompatible-restore":                brokerapi.LastOperationFailed,
+				"insufficient-capacity":               brokerapi.LastOperationFailed,
+				"maintenance":                         brokerapi.LastOperationInProgress,
+				"modifying":                           brokerapi.LastOperationInProgress,
+				"moving-to-vpc":                       brokerapi.LastOperationInProgress,
+				"rebooting":                           brokerapi.LastOperationInProgress,
+				"renaming":                            brokerapi.LastOperationInProgress,
+				"resetting-master-credentials":        brokerapi.LastOperationInProgress,
+				"restore-error":                       brokerapi.LastOperationFailed,
+				"starting":                            brokerapi.LastOperationInProgress,
+				"stopped":                             brokerapi.LastOperationFailed,
+				"stopping":                            brokerapi.LastOperationInProgress,
+				"storage-full":                        brokerapi.LastOperationFailed,
+				"storage-optimization":                brokerapi.LastOperationSucceeded,
+				"upgrading":                           brokerapi.LastOperationInProgress,
+				"unknown-status":                      brokerapi.LastOperationFailed,
+			} {
+				status, state := status, state
+
+				It("reports '"+status+"' as "+state, func() {
+					dbInstance.DescribeDBInstanceDetails.Status = status
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(state))
+					Expect(lastOperationResponse.Description).To(MatchRegexp(`^DB Instance '` + dbInstanceIdentifier + `' status is '` + status + `' \(.+\)$`))
+				})
+			}
+
+			It("describes unknown statuses", func() {
+				dbInstance.DescribeDBInstanceDetails.Status = "unknown-status"
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse.Description).To(Equal("DB Instance '" + dbInstanceIdentifier + "' status is 'unknown-status' (unknown status)"))
+			})
+
+			Context("and the status policy sets the state of an ambiguous status", func() {
+				BeforeEach(func() {
+					statusPolicy = map[string]string{
+						"storage-full":         brokerapi.LastOperationInProgress,
+						"storage-optimization": brokerapi.LastOperationInProgress,
+					}
+				})
+
+				It("reports the status with the state of the policy", func() {
+					dbInstance.DescribeDBInstanceDetails.Status = "storage-full"
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationInProgress,
+						Description: "DB Instance '" + dbInstanceIdentifier + "' status is 'storage-full' (it has reached its storage capacity)",
+					}))
+				})
+
+				It("does not change the state of other statuses", func() {
+					dbInstance.DescribeDBInstanceDetails.Status = "failed"
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+				})
+			})
+		})
+
+		Context("when RDS reports a DB Cluster status", func() {
+			JustBeforeEach(func() {
+				dbInstance.DescribeDBInstanceDetails.Engine = "aurora"
+				dbInstance.DescribeDBInstanceDetails.DBClusterIdentifier = dbClusterIdentifier
+				dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+					Identifier:       dbClusterIdentifier,
+					Engine:           "aurora",
+					DBClusterMembers: []string{dbInstanceIdentifier},
+				}
+			})
+
+			for status, state := range map[string]string{
+				"available":                           brokerapi.LastOperationSucceeded,
+				"backing-up":                          brokerapi.LastOperationInProgress,
+				"backtracking":                        brokerapi.LastOperationInProgress,
+				"cloning-failed":                      brokerapi.LastOperationFailed,
+				"creating":                            brokerapi.LastOperationInProgress,
+				"deleting":                            brokerapi.LastOperationInProgress,
+				"failing-over":                        brokerapi.LastOperationInProgress,
+				"inaccessible-encryption-credentials": brokerapi.LastOperationFailed,
+				"maintenance":                         brokerapi.LastOperationInProgress,
+				"migrating":                           brokerapi.LastOperationInProgress,
+				"migration-failed":                    brokerapi.LastOperationFailed,
+				"modifying":                           brokerapi.LastOperationInProgress,
+				"preparing-data-migration":            brokerapi.LastOperationInProgress,
+				"promoting":                           brokerapi.LastOperationInProgress,
+				"renaming":                            brokerapi.LastOperationInProgress,
+				"resetting-master-credentials":        brokerapi.LastOperationInProgress,
+				"starting":                            brokerapi.LastOperationInProgress,
+				"stopped":                             brokerapi.LastOperationFailed,
+				"stopping":                            brokerapi.LastOperationInProgress,
+				"storage-optimization":                brokerapi.LastOperationSucceeded,
+				"update-iam-db-auth":                  brokerapi.LastOperationInProgress,
+				"upgrading":                           brokerapi.LastOperationInProgress,
+				"unknown-status":                      brokerapi.LastOperationFailed,
+			} {
+				status, state := status, state
+
+				It("reports '"+status+"' as "+state, func() {
+					dbCluster.DescribeDBClusterDetails.Status = status
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(state))
+					Expect(lastOperationResponse.Description).To(MatchRegexp(`^DB Cluster '` + dbClusterIdentifier + `' status is '` + status + `' \(.+\), `))
+				})
+			}
+
+			Context("and the status policy sets the state of an ambiguous status", func() {
+				BeforeEach(func() {
+					statusPolicy = map[string]string{"stopped": brokerapi.LastOperationSucceeded}
+				})
+
+				It("reports the status with the state of the policy", func() {
+					dbCluster.DescribeDBClusterDetails.Status = "stopped"
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationSucceeded))
+				})
+			})
+		})
+
+		Context("when RDS reports incompatible parameters", func() {
+			BeforeEach(func() {
+				dbInstanceStatus = "incompatible-parameters"
+				lastOperationState = brokerapi.LastOperationFailed
+			})
+
+			It("returns the proper LastOperationResponse", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+			})
+		})
+
+		Context("when RDS reports events", func() {
+			var (
+				startedAt time.Time
+			)
+
+			BeforeEach(func() {
+				dbInstanceStatus = "failed"
+				startedAt = time.Date(2017, time.July, 14, 2, 40, 0, 0, time.UTC)
+				pollDetails.OperationData = fmt.Sprintf("provision:%d", startedAt.Unix())
+				dbEvents.DescribeDBEventDetails = []awsrds.DBEventDetails{
+					awsrds.DBEventDetails{
+						SourceIdentifier: dbInstanceIdentifier,
+						Message:          "DB instance restarted",
+						EventCategories:  []string{"availability"},
+						Date:             startedAt.Add(2 * time.Minute),
+					},
+					awsrds.DBEventDetails{
+						SourceIdentifier: dbInstanceIdentifier,
+						Message:          "DB instance is being created in an incompatible network",
+						EventCategories:  []string{"failure"},
+						Date:             startedAt.Add(1 * time.Minute),
+					},
+					awsrds.DBEventDetails{
+						SourceIdentifier: dbInstanceIdentifier,
+						Message:          "Previous DB instance failure",
+						EventCategories:  []string{"failure"},
+						Date:             startedAt.Add(-1 * time.Minute),
+					},
+				}
+			})
+
+			It("describes the DB Instance events since the operation started", func() {
+				_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbEvents.DescribeCalled).To(BeTrue())
+				Expect(dbEvents.DescribeSourceType).To(Equal(awsrds.DBEventSourceTypeDBInstance))
+				Expect(dbEvents.DescribeSourceID).To(Equal(dbInstanceIdentifier))
+				Expect(dbEvents.DescribeStartTime.Equal(startedAt)).To(BeTrue())
+			})
+
+			It("includes the most relevant event in the description", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+					State:       brokerapi.LastOperationFailed,
+					Description: "DB Instance '" + dbInstanceIdentifier + "' status is 'failed' (failed and cannot be recovered by RDS): DB instance is being created in an incompatible network",
+				}))
+			})
+
+			Context("and there are no relevant events", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "creating"
+					dbEvents.DescribeDBEventDetails = dbEvents.DescribeDBEventDetails[:1]
+				})
+
+				It("includes the most recent event in the description", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationInProgress,
+						Description: "DB Instance '" + dbInstanceIdentifier + "' status is 'creating' (being created): DB instance restarted",
+					}))
+				})
+			})
+
+			Context("and the operation succeeded", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "available"
+				})
+
+				It("does not describe the DB Instance events", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationSucceeded))
+					Expect(dbEvents.DescribeCalled).To(BeFalse())
+				})
+			})
+
+			Context("and describing the DB Instance events fails", func() {
+				BeforeEach(func() {
+					dbEvents.DescribeError = errors.New("operation failed")
+				})
+
+				It("returns the DB Instance status", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationFailed,
+						Description: "DB Instance '" + dbInstanceIdentifier + "' status is 'failed' (failed and cannot be recovered by RDS)",
+					}))
+				})
+			})
+
+			Context("and the DB Instance is being provisioned", func() {
+				BeforeEach(func() {
+					stateStore.Instances[instanceID] = statestore.InstanceDetails{
+						ID:     instanceID,
+						PlanID: "Plan-1",
+						LastOperation: statestore.OperationDetails{
+							Type:      statestore.OperationProvision,
+							State:     operation.StateInProgress,
+							Step:      "wait-available",
+							StartedAt: startedAt,
+						},
+					}
+				})
+
+				It("fails the operation with the most relevant event", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(lastOperationResponse.Description).To(Equal("Waiting for DB Instance '" + dbInstanceIdentifier + "' to be available failed: DB Instance '" + dbInstanceIdentifier + "' status is 'failed' (failed and cannot be recovered by RDS): DB instance is being created in an incompatible network"))
+				})
+
+				Context("and the DB Instance is still being created", func() {
+					BeforeEach(func() {
+						dbInstanceStatus = "creating"
+					})
+
+					It("does not describe the DB Instance events while waiting", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+						Expect(dbEvents.DescribeCalled).To(BeFalse())
+					})
+				})
+			})
+		})
+
+		Context("when the DB Cluster is serverless", func() {
+			BeforeEach(func() {
+				dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+				dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+					Identifier: dbClusterIdentifier,
+					Engine:     "aurora",
+					EngineMode: "serverless",
+					Status:     "available",
+				}
+			})
+
+			It("returns the proper LastOperationResponse", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+					State:       brokerapi.LastOperationSucceeded,
+					Description: "DB Cluster '" + dbClusterIdentifier + "' status is 'available' (healthy and available)",
+				}))
+			})
+		})
+
+		Context("when Engine is Aurora", func() {
+			var (
+				dbClusterStatus string
+			)
+
+			BeforeEach(func() {
+				dbInstanceStatus = "available"
+				dbClusterStatus = "available"
+			})
+
+			JustBeforeEach(func() {
+				dbInstance.DescribeDBInstanceDetails.Engine = "aurora"
+				dbInstance.DescribeDBInstanceDetails.DBClusterIdentifier = dbClusterIdentifier
+
+				dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+					Identifier:       dbClusterIdentifier,
+					Engine:           "aurora",
+					Status:           dbClusterStatus,
+					DBClusterMembers: []string{dbInstanceIdentifier},
+				}
+			})
+
+			It("makes the proper calls", func() {
+				_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(dbCluster.DescribeCalled).To(BeTrue())
+				Expect(dbCluster.DescribeID).To(Equal(dbClusterIdentifier))
+				Expect(dbInstance.DescribeID).To(Equal(dbInstanceIdentifier))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the proper LastOperationResponse", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+					State:       brokerapi.LastOperationSucceeded,
+					Description: "DB Cluster '" + dbClusterIdentifier + "' status is 'available' (healthy and available), DB Instance '" + dbInstanceIdentifier + "' status is 'available' (healthy and available)",
+				}))
+			})
+
+			Context("and the DB Cluster is still in progress", func() {
+				BeforeEach(func() {
+					dbClusterStatus = "migrating"
+				})
+
+				It("returns the proper LastOperationResponse", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+					Expect(lastOperationResponse.Description).To(ContainSubstring("DB Cluster '" + dbClusterIdentifier + "' status is 'migrating'"))
+				})
+			})
+
+			Context("and the DB Cluster failed", func() {
+				BeforeEach(func() {
+					dbClusterStatus = "failed"
+					dbInstanceStatus = "creating"
+				})
+
+				It("returns the proper LastOperationResponse", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(lastOperationResponse.Description).To(ContainSubstring("DB Cluster '" + dbClusterIdentifier + "' status is 'failed' (failed and cannot be recovered by RDS)"))
+				})
+			})
+
+			Context("and a DB Cluster member is still in progress", func() {
+				JustBeforeEach(func() {
+					dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{dbInstanceIdentifier, "cf-instance-id-1"}
+				})
+
+				It("describes every DB Cluster member", func() {
+					_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(dbInstance.DescribeID).To(Equal("cf-instance-id-1"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			Context("and RDS reports events", func() {
+				BeforeEach(func() {
+					dbClusterStatus = "failed"
+					dbEvents.DescribeDBEventDetails = []awsrds.DBEventDetails{
+						awsrds.DBEventDetails{
+							SourceIdentifier: dbClusterIdentifier,
+							Message:          "DB cluster created",
+							EventCategories:  []string{"creation"},
+							Date:             time.Now(),
+						},
+						awsrds.DBEventDetails{
+							SourceIdentifier: dbInstanceIdentifier,
+							Message:          "Insufficient capacity",
+							EventCategories:  []string{"failure"},
+							Date:             time.Now(),
+						},
+					}
+				})
+
+				It("includes the most relevant event of the DB Cluster and its members in the description", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(lastOperationResponse.Description).To(Equal("DB Cluster '" + dbClusterIdentifier + "' status is 'failed' (failed and cannot be recovered by RDS), DB Instance '" + dbInstanceIdentifier + "' status is 'available' (healthy and available): Insufficient capacity"))
+				})
+			})
+
+			Context("and the DB Instance has pending modifications", func() {
+				JustBeforeEach(func() {
+					dbInstance.DescribeDBInstanceDetails.PendingModifications = true
+				})
+
+				It("returns the proper LastOperationResponse", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+					Expect(lastOperationResponse.Description).To(ContainSubstring("DB Instance '" + dbInstanceIdentifier + "' has pending modifications"))
+				})
+			})
+
+			Context("when describing the DB Cluster fails", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = errors.New("operation failed")
+				})
+
+				It("returns the proper error", func() {
+					_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("operation failed"))
+				})
+
+				Context("when the DB Cluster does not exists", func() {
+					BeforeEach(func() {
+						dbCluster.DescribeError = awsrds.ErrDBClusterDoesNotExist
+					})
+
+					It("returns the proper error", func() {
+						_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).To(HaveOccurred())
+						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					})
+				})
+			})
+		})
+
+		Context("when an operation is in progress", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:  statestore.OperationProvision,
+						State: operation.StateInProgress,
+						Step:  "wait-available",
+					},
+				}
+			})
+
+			It("returns the operation description while the DB Instance is not available", func() {
+				dbInstance.DescribeDBInstanceDetails.Status = "creating"
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+					State:       brokerapi.LastOperationInProgress,
+					Description: "Waiting for DB Instance '" + dbInstanceIdentifier + "' to be available",
+				}))
+				Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateInProgress))
+			})
+
+			Context("and the DB Instance is available", func() {
+				It("completes the operation", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+					Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateSucceeded))
+				})
+			})
+
+			Context("and the DB Instance failed", func() {
+				It("fails the operation and rolls back the DB Instance", func() {
+					dbInstance.DescribeDBInstanceDetails.Status = "failed"
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationFailed,
+						Description: "Waiting for DB Instance '" + dbInstanceIdentifier + "' to be available failed: DB Instance '" + dbInstanceIdentifier + "' status is 'failed' (failed and cannot be recovered by RDS)",
+					}))
+					Expect(dbInstance.DeleteCalled).To(BeTrue())
+					Expect(dbInstance.DeleteID).To(Equal(dbInstanceIdentifier))
+					Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateFailed))
+				})
+			})
+
+			Context("and the operation failed", func() {
+				BeforeEach(func() {
+					instance := stateStore.Instances[instanceID]
+					instance.LastOperation.State = operation.StateFailed
+					instance.LastOperation.Description = "Creating DB Instance '" + dbInstanceIdentifier + "' failed: operation failed"
+					stateStore.Instances[instanceID] = instance
+				})
+
+				It("returns the operation description", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationFailed,
+						Description: "Creating DB Instance '" + dbInstanceIdentifier + "' failed: operation failed",
+					}))
+					Expect(dbInstance.DescribeCalled).To(BeFalse())
 				})
 			})
 		})
 
-		Context("when last operation is still in progress", func() {
+		Context("when an update is waiting for the DB Instance", func() {
 			BeforeEach(func() {
-				dbInstanceStatus = "creating"
-				lastOperationState = brokerapi.LastOperationInProgress
+				dbInstanceStatus = "available"
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:       statestore.OperationUpdate,
+						State:      operation.StateInProgress,
+						Step:       "wait-available",
+						Parameters: map[string]interface{}{"apply_immediately": true},
+					},
+				}
 			})
 
-			It("returns the proper LastOperationResponse", func() {
-				lastOperationResponse, err := rdsBroker.LastOperation(instanceID)
+			JustBeforeEach(func() {
+				dbInstance.DescribeDBInstanceDetails.PendingModifications = true
+			})
+
+			It("waits for the pending modifications to be applied", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+				Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+				Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateInProgress))
+			})
+
+			Context("and the modifications are not applied immediately", func() {
+				BeforeEach(func() {
+					instance := stateStore.Instances[instanceID]
+					instance.LastOperation.Parameters = map[string]interface{}{}
+					stateStore.Instances[instanceID] = instance
+				})
+
+				It("completes the operation", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationSucceeded,
+						Description: "DB Instance '" + dbInstanceIdentifier + "' has pending modifications that will be applied during the next maintenance window",
+					}))
+					Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateSucceeded))
+				})
 			})
 		})
 
-		Context("when last operation failed", func() {
+		Context("when an update is stopping the DB Instance", func() {
 			BeforeEach(func() {
-				dbInstanceStatus = "failed"
-				lastOperationState = brokerapi.LastOperationFailed
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:       statestore.OperationUpdate,
+						State:      operation.StateInProgress,
+						Step:       "wait-available",
+						Parameters: map[string]interface{}{"state": "stopped"},
+					},
+				}
 			})
 
-			It("returns the proper LastOperationResponse", func() {
-				lastOperationResponse, err := rdsBroker.LastOperation(instanceID)
+			It("stops the DB Instance once it is available", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+				Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+				Expect(dbInstance.StopCalled).To(BeTrue())
+				Expect(dbInstance.StopID).To(Equal(dbInstanceIdentifier))
+				Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-instance-stopped"))
+			})
+
+			Context("and the DB Instance is stopping", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "stopping"
+					instance := stateStore.Instances[instanceID]
+					instance.LastOperation.Step = "wait-db-instance-stopped"
+					stateStore.Instances[instanceID] = instance
+				})
+
+				It("waits for the DB Instance to be stopped", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationInProgress,
+						Description: "Waiting for DB Instance '" + dbInstanceIdentifier + "' to be stopped",
+					}))
+					Expect(dbInstance.StopCalled).To(BeFalse())
+				})
+			})
+
+			Context("and the DB Instance is stopped", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "stopped"
+					instance := stateStore.Instances[instanceID]
+					instance.LastOperation.Step = "wait-db-instance-stopped"
+					stateStore.Instances[instanceID] = instance
+				})
+
+				It("completes the operation", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationSucceeded,
+						Description: "DB Instance '" + dbInstanceIdentifier + "' status is 'stopped' (stopped and not accepting connections)",
+					}))
+					Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateSucceeded))
+				})
 			})
 		})
 
-		Context("when last operation succeeded", func() {
+		Context("when an update is rotating the master password", func() {
+			BeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:                    instanceID,
+					PlanID:                "Plan-1",
+					MasterPassword:        "encrypted:old-master-password",
+					PendingMasterPassword: "encrypted:new-master-password",
+					LastOperation: statestore.OperationDetails{
+						Type:       statestore.OperationUpdate,
+						State:      operation.StateInProgress,
+						Step:       "modify-db-instance",
+						Parameters: map[string]interface{}{"rotate_master_password": true},
+					},
+				}
+			})
+
+			It("saves the new master password once the DB Instance is modified", func() {
+				_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbInstance.ModifyDBInstanceDetails.MasterUserPassword).To(Equal("new-master-password"))
+				Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:new-master-password"))
+				Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
+			})
+
+			Context("and modifying the DB Instance fails", func() {
+				BeforeEach(func() {
+					dbInstance.ModifyError = errors.New("operation failed")
+				})
+
+				It("discards the pending master password", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(stateStore.Instances[instanceID].MasterPassword).To(Equal("encrypted:old-master-password"))
+					Expect(stateStore.Instances[instanceID].PendingMasterPassword).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when an update is starting the DB Instance", func() {
+			BeforeEach(func() {
+				dbInstanceStatus = "starting"
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:       statestore.OperationUpdate,
+						State:      operation.StateInProgress,
+						Step:       "wait-db-instance-started",
+						Parameters: map[string]interface{}{"state": "running"},
+					},
+				}
+			})
+
+			It("waits for the DB Instance to be started", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+					State:       brokerapi.LastOperationInProgress,
+					Description: "Waiting for DB Instance '" + dbInstanceIdentifier + "' to be started",
+				}))
+				Expect(dbInstance.ModifyCalled).To(BeFalse())
+			})
+
+			Context("and the DB Instance is available", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "available"
+				})
+
+				It("modifies the DB Instance and completes the operation", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationSucceeded))
+					Expect(dbInstance.ModifyCalled).To(BeTrue())
+					Expect(stateStore.Instances[instanceID].LastOperation.State).To(Equal(operation.StateSucceeded))
+				})
+			})
+
+			Context("and the DB Instance has failed", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "failed"
+				})
+
+				It("fails the operation", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationFailed,
+						Description: "Waiting for DB Instance '" + dbInstanceIdentifier + "' to be started failed: Waiting for DB Instance '" + dbInstanceIdentifier + "' to be started status is 'failed' (failed and cannot be recovered by RDS)",
+					}))
+				})
+			})
+		})
+
+		Context("when polled with an operation token", func() {
+			var (
+				startedAt time.Time
+			)
+
 			BeforeEach(func() {
 				dbInstanceStatus = "available"
-				lastOperationState = brokerapi.LastOperationSucceeded
+				startedAt = time.Unix(1500000000, 0)
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:        statestore.OperationProvision,
+						State:       operation.StateFailed,
+						Description: "Creating DB Instance '" + dbInstanceIdentifier + "' failed: operation failed",
+						StartedAt:   startedAt,
+					},
+				}
 			})
 
-			It("returns the proper LastOperationResponse", func() {
-				lastOperationResponse, err := rdsBroker.LastOperation(instanceID)
+			Context("that matches the operation in the state store", func() {
+				BeforeEach(func() {
+					pollDetails.OperationData = "provision:1500000000"
+				})
+
+				It("returns the operation state", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(lastOperationResponse.Description).To(Equal("Creating DB Instance '" + dbInstanceIdentifier + "' failed: operation failed"))
+					Expect(dbInstance.DescribeCalled).To(BeFalse())
+				})
+			})
+
+			Context("without a start time", func() {
+				BeforeEach(func() {
+					pollDetails.OperationData = "provision"
+				})
+
+				It("returns the operation state", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(dbInstance.DescribeCalled).To(BeFalse())
+				})
+			})
+
+			Context("that is invalid", func() {
+				BeforeEach(func() {
+					pollDetails.OperationData = "unknown:1500000000"
+				})
+
+				It("returns the state of the operation in the state store", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					Expect(dbInstance.DescribeCalled).To(BeFalse())
+				})
+			})
+
+			Context("of a provision the state store has no record of", func() {
+				BeforeEach(func() {
+					pollDetails.OperationData = "provision:1600000000"
+				})
+
+				It("returns the DB Instance state", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+				})
+
+				Context("and the DB Instance does not exist", func() {
+					BeforeEach(func() {
+						dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					})
+
+					It("reports the operation as failed", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+							State:       brokerapi.LastOperationFailed,
+							Description: "DB Instance '" + dbInstanceIdentifier + "' does not exist",
+						}))
+						Expect(stateStore.Instances).To(HaveKey(instanceID))
+					})
+				})
+
+				Context("and the DB Instance is being deleted", func() {
+					BeforeEach(func() {
+						dbInstanceStatus = "deleting"
+					})
+
+					It("reports the operation as failed", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					})
+				})
+			})
+
+			Context("of an update the state store has no record of", func() {
+				BeforeEach(func() {
+					pollDetails.OperationData = "update:1600000000"
+				})
+
+				Context("and the DB Instance has pending modifications", func() {
+					JustBeforeEach(func() {
+						dbInstance.DescribeDBInstanceDetails.PendingModifications = true
+					})
+
+					It("reports the operation as succeeded", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationSucceeded))
+						Expect(lastOperationResponse.Description).To(Equal("DB Instance '" + dbInstanceIdentifier + "' has pending modifications that will be applied during the next maintenance window"))
+					})
+				})
+
+				Context("and the DB Cluster does not exist", func() {
+					BeforeEach(func() {
+						dbCluster.DescribeError = awsrds.ErrDBClusterDoesNotExist
+					})
+
+					JustBeforeEach(func() {
+						dbInstance.DescribeDBInstanceDetails.Engine = "aurora"
+						dbInstance.DescribeDBInstanceDetails.DBClusterIdentifier = dbClusterIdentifier
+					})
+
+					It("reports the operation as failed", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+							State:       brokerapi.LastOperationFailed,
+							Description: "DB Cluster '" + dbClusterIdentifier + "' does not exist",
+						}))
+					})
+				})
+			})
+
+			Context("of a deprovision the state store has no record of", func() {
+				BeforeEach(func() {
+					pollDetails.OperationData = "deprovision:1600000000"
+					dbInstanceStatus = "deleting"
+					lastOperationState = brokerapi.LastOperationInProgress
+				})
+
+				It("returns the DB Instance state", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+				})
+
+				Context("and the DB Instance does not exist", func() {
+					BeforeEach(func() {
+						dbInstance.DescribeError = awsrds.ErrDBInstanceDoesNotExist
+					})
+
+					It("returns the proper error", func() {
+						_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					})
+				})
+
+				Context("and the DB Instance is available", func() {
+					BeforeEach(func() {
+						dbInstanceStatus = "available"
+					})
+
+					It("reports the operation as failed", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationFailed))
+					})
+				})
+			})
+		})
+
+		Context("when an Aurora DB Cluster is being deprovisioned", func() {
+			var (
+				step string
+			)
+
+			BeforeEach(func() {
+				step = "wait-db-instances-deleted"
+				dbInstanceStatus = "deleting"
+				rdsProperties1.Engine = "aurora"
+				rdsProperties1.SkipFinalSnapshot = false
+				dbCluster.DescribeDBClusterDetails = awsrds.DBClusterDetails{
+					Identifier:       dbClusterIdentifier,
+					Engine:           "aurora",
+					Status:           "available",
+					DBClusterMembers: []string{dbInstanceIdentifier, dbInstanceIdentifier + "-1"},
+				}
+			})
+
+			JustBeforeEach(func() {
+				stateStore.Instances[instanceID] = statestore.InstanceDetails{
+					ID:     instanceID,
+					PlanID: "Plan-1",
+					LastOperation: statestore.OperationDetails{
+						Type:  statestore.OperationDeprovision,
+						State: operation.StateInProgress,
+						Step:  step,
+					},
+				}
+			})
+
+			It("waits for the DB Cluster members to be deleted", func() {
+				lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+				Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+					State:       brokerapi.LastOperationInProgress,
+					Description: "Waiting for the DB Instances of DB Cluster '" + dbClusterIdentifier + "' to be deleted",
+				}))
+				Expect(dbCluster.DeleteCalled).To(BeFalse())
 			})
 
-			Context("but has pending modifications", func() {
-				JustBeforeEach(func() {
-					dbInstance.DescribeDBInstanceDetails.PendingModifications = true
+			Context("and a DB Cluster member is not being deleted", func() {
+				BeforeEach(func() {
+					dbInstanceStatus = "available"
+				})
 
-					properLastOperationResponse = brokerapi.LastOperationResponse{
+				It("fails the operation", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+						State:       brokerapi.LastOperationFailed,
+						Description: "Waiting for the DB Instances of DB Cluster '" + dbClusterIdentifier + "' to be deleted failed: DB Instance '" + dbInstanceIdentifier + "' status is 'available' (healthy and available): it is not being deleted",
+					}))
+					Expect(dbCluster.DeleteCalled).To(BeFalse())
+				})
+			})
+
+			Context("and all DB Cluster members are gone", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeDBClusterDetails.DBClusterMembers = []string{}
+				})
+
+				It("deletes the DB Cluster with its final snapshot", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
 						State:       brokerapi.LastOperationInProgress,
-						Description: "DB Instance '" + dbInstanceIdentifier + "' has pending modifications",
-					}
+						Description: "Waiting for DB Cluster '" + dbClusterIdentifier + "' to be deleted",
+					}))
+					Expect(dbCluster.DeleteCalled).To(BeTrue())
+					Expect(dbCluster.DeleteID).To(Equal(dbClusterIdentifier))
+					Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeFalse())
+					Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("wait-db-cluster-deleted"))
 				})
 
-				It("returns the proper LastOperationResponse", func() {
-					lastOperationResponse, err := rdsBroker.LastOperation(instanceID)
+				Context("and it skips the final snapshot", func() {
+					BeforeEach(func() {
+						rdsProperties1.SkipFinalSnapshot = true
+					})
+
+					It("deletes the DB Cluster without a final snapshot", func() {
+						_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(dbCluster.DeleteSkipFinalSnapshot).To(BeTrue())
+					})
+				})
+
+				Context("and deleting the DB Cluster fails", func() {
+					BeforeEach(func() {
+						dbCluster.DeleteError = errors.New("operation failed")
+					})
+
+					It("returns the proper LastOperationResponse", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse).To(Equal(brokerapi.LastOperationResponse{
+							State:       brokerapi.LastOperationFailed,
+							Description: "Deleting DB Cluster '" + dbClusterIdentifier + "' failed: operation failed",
+						}))
+					})
+				})
+
+				Context("and deleting the DB Cluster fails with a transient error", func() {
+					BeforeEach(func() {
+						dbCluster.DeleteError = errors.New("InvalidDBClusterStateFault: DB cluster is not available")
+					})
+
+					It("retries the step later", func() {
+						lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+						Expect(lastOperationResponse.Description).To(Equal("Deleting DB Cluster '" + dbClusterIdentifier + "' (retrying after error: InvalidDBClusterStateFault: DB cluster is not available)"))
+						Expect(stateStore.Instances[instanceID].LastOperation.Step).To(Equal("delete-db-cluster"))
+						Expect(stateStore.Instances[instanceID].LastOperation.Attempts).To(Equal(1))
+					})
+				})
+			})
+
+			Context("and the DB Cluster is being deleted", func() {
+				BeforeEach(func() {
+					step = "wait-db-cluster-deleted"
+				})
+
+				It("does not delete the DB Cluster again", func() {
+					lastOperationResponse, err := rdsBroker.LastOperation(instanceID, pollDetails)
 					Expect(err).ToNot(HaveOccurred())
-					Expect(lastOperationResponse).To(Equal(properLastOperationResponse))
+					Expect(lastOperationResponse.State).To(Equal(brokerapi.LastOperationInProgress))
+					Expect(dbCluster.DeleteCalled).To(BeFalse())
+				})
+			})
+
+			Context("and the DB Cluster is gone", func() {
+				BeforeEach(func() {
+					dbCluster.DescribeError = awsrds.ErrDBClusterDoesNotExist
+				})
+
+				It("deletes the instance state", func() {
+					_, err := rdsBroker.LastOperation(instanceID, pollDetails)
+					Expect(err).To(Equal(brokerapi.ErrInstanceDoesNotExist))
+					Expect(stateStore.Instances).ToNot(HaveKey(instanceID))
 				})
 			})
 		})
@@ -3,11 +3,33 @@ package rdsbroker
 import (
 	"fmt"
 	"strings"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+	"github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
 )
 
 const minAllocatedStorage = 5
 const maxAllocatedStorage = 6144
 
+const minSecondsUntilAutoPause = 300
+const maxSecondsUntilAutoPause = 86400
+
+var licenseModels = map[string][]string{
+	"mariadb":       {"general-public-license"},
+	"mysql":         {"general-public-license"},
+	"oracle-ee":     {"bring-your-own-license"},
+	"oracle-se":     {"license-included", "bring-your-own-license"},
+	"oracle-se1":    {"license-included", "bring-your-own-license"},
+	"oracle-se2":    {"license-included", "bring-your-own-license"},
+	"postgres":      {"postgresql-license"},
+	"sqlserver-ee":  {"license-included"},
+	"sqlserver-ex":  {"license-included"},
+	"sqlserver-se":  {"license-included"},
+	"sqlserver-web": {"license-included"},
+}
+
 type Catalog struct {
 	Services []Service `json:"services,omitempty"`
 }
@@ -35,12 +57,15 @@ type ServiceMetadata struct {
 }
 
 type ServicePlan struct {
-	ID            string               `json:"id"`
-	Name          string               `json:"name"`
-	Description   string               `json:"description"`
-	Metadata      *ServicePlanMetadata `json:"metadata,omitempty"`
-	Free          bool                 `json:"free"`
-	RDSProperties RDSProperties        `json:"rds_properties,omitempty"`
+	ID                  string                          `json:"id"`
+	Name                string                          `json:"name"`
+	Description         string                          `json:"description"`
+	Metadata            *ServicePlanMetadata            `json:"metadata,omitempty"`
+	Free                bool                            `json:"free"`
+	RDSProperties       RDSProperties                   `json:"rds_properties,omitempty"`
+	ProvisionParameters map[string]ParameterConstraints `json:"provision_parameters,omitempty"`
+	UpdateParameters    map[string]ParameterConstraints `json:"update_parameters,omitempty"`
+	BindParameters      map[string]ParameterConstraints `json:"bind_parameters,omitempty"`
 }
 
 type ServicePlanMetadata struct {
@@ -87,6 +112,15 @@ type RDSProperties struct {
 	VpcSecurityGroupIds         []string `json:"vpc_security_group_ids,omitempty"`
 	CopyTagsToSnapshot          bool     `json:"copy_tags_to_snapshot,omitempty"`
 	SkipFinalSnapshot           bool     `json:"skip_final_snapshot,omitempty"`
+	CredentialsRotationDays     int64    `json:"credentials_rotation_days,omitempty"`
+	DefaultBindingRole          string   `json:"default_binding_role,omitempty"`
+	ReadReplica                 bool     `json:"read_replica,omitempty"`
+	InstanceCount               int64    `json:"instance_count,omitempty"`
+	EngineMode                  string   `json:"engine_mode,omitempty"`
+	MinCapacity                 int64    `json:"min_capacity,omitempty"`
+	MaxCapacity                 int64    `json:"max_capacity,omitempty"`
+	AutoPause                   bool     `json:"auto_pause,omitempty"`
+	SecondsUntilAutoPause       int64    `json:"seconds_until_auto_pause,omitempty"`
 }
 
 func (c Catalog) Validate() error {
@@ -160,9 +194,34 @@ func (sp ServicePlan) Validate() error {
 		return fmt.Errorf("Validating RDS Properties configuration: %s", err)
 	}
 
+	if err := validateAllowedParameters("provision", sp.ProvisionParameters, provisionParameterDefinitions); err != nil {
+		return fmt.Errorf("Validating Provision Parameters configuration: %s (%+v)", err, sp)
+	}
+
+	if err := validateAllowedParameters("update", sp.UpdateParameters, updateParameterDefinitions); err != nil {
+		return fmt.Errorf("Validating Update Parameters configuration: %s (%+v)", err, sp)
+	}
+
+	if err := validateAllowedParameters("bind", sp.BindParameters, bindParameterDefinitions); err != nil {
+		return fmt.Errorf("Validating Bind Parameters configuration: %s (%+v)", err, sp)
+	}
+
 	return nil
 }
 
+// Schemas returns the JSON Schemas of the parameters allowed by the Service Plan
+func (sp ServicePlan) Schemas() *brokerapi.ServiceSchemas {
+	return &brokerapi.ServiceSchemas{
+		Instance: brokerapi.ServiceInstanceSchema{
+			Create: brokerapi.Schema{Parameters: parametersSchema(sp.ProvisionParameters, provisionParameterDefinitions)},
+			Update: brokerapi.Schema{Parameters: parametersSchema(sp.UpdateParameters, updateParameterDefinitions)},
+		},
+		Binding: brokerapi.ServiceBindingSchema{
+			Create: brokerapi.Schema{Parameters: parametersSchema(sp.BindParameters, bindParameterDefinitions)},
+		},
+	}
+}
+
 func (rp RDSProperties) Validate() error {
 	if rp.DBInstanceClass == "" {
 		return fmt.Errorf("Must provide a non-empty DBInstanceClass (%+v)", rp)
@@ -172,14 +231,140 @@ func (rp RDSProperties) Validate() error {
 		return fmt.Errorf("Must provide a non-empty Engine (%+v)", rp)
 	}
 
-	switch strings.ToLower(rp.Engine) {
-	case "aurora":
-	case "mariadb":
-	case "mysql":
-	case "postgres":
-	default:
+	engineFamily, ok := awsrds.FindEngineFamily(rp.Engine)
+	if !ok {
 		return fmt.Errorf("This broker does not support RDS engine '%s' (%+v)", rp.Engine, rp)
 	}
 
+	if rp.LicenseModel == "" && isOracleEngine(rp.Engine) {
+		return fmt.Errorf("Must provide a LicenseModel for RDS engine '%s' (%+v)", rp.Engine, rp)
+	}
+
+	if rp.LicenseModel != "" {
+		if err := validateLicenseModel(rp.Engine, rp.LicenseModel); err != nil {
+			return fmt.Errorf("%s (%+v)", err, rp)
+		}
+	}
+
+	if rp.CredentialsRotationDays < 0 {
+		return fmt.Errorf("Must provide a non-negative CredentialsRotationDays (%+v)", rp)
+	}
+
+	if rp.DefaultBindingRole != "" {
+		if err := sqlengine.ValidateRole(rp.DefaultBindingRole); err != nil {
+			return fmt.Errorf("This broker does not support binding role '%s' (%+v)", rp.DefaultBindingRole, rp)
+		}
+	}
+
+	if rp.ReadReplica && !engineFamily.ReadReplicas {
+		return fmt.Errorf("This broker does not support read replicas for RDS engine '%s' (%+v)", rp.Engine, rp)
+	}
+
+	if rp.InstanceCount < 0 || rp.InstanceCount > 16 {
+		return fmt.Errorf("Must provide an InstanceCount between 1 and 16 (%+v)", rp)
+	}
+
+	if rp.InstanceCount > 1 && !engineFamily.Cluster {
+		return fmt.Errorf("This broker does not support InstanceCount for RDS engine '%s' (%+v)", rp.Engine, rp)
+	}
+
+	switch strings.ToLower(rp.EngineMode) {
+	case "", awsrds.EngineModeProvisioned:
+		if rp.MinCapacity != 0 || rp.MaxCapacity != 0 || rp.AutoPause || rp.SecondsUntilAutoPause != 0 {
+			return fmt.Errorf("This broker only supports MinCapacity, MaxCapacity, AutoPause and SecondsUntilAutoPause for EngineMode '%s' (%+v)", awsrds.EngineModeServerless, rp)
+		}
+	case awsrds.EngineModeServerless:
+		if !engineFamily.Cluster {
+			return fmt.Errorf("This broker does not support EngineMode '%s' for RDS engine '%s' (%+v)", rp.EngineMode, rp.Engine, rp)
+		}
+
+		if rp.InstanceCount > 1 || rp.ReadReplica {
+			return fmt.Errorf("This broker does not support InstanceCount or ReadReplica for EngineMode '%s' (%+v)", rp.EngineMode, rp)
+		}
+
+		if err := validateScalingConfiguration(rp.scalingConfiguration()); err != nil {
+			return fmt.Errorf("%s (%+v)", err, rp)
+		}
+	default:
+		return fmt.Errorf("This broker does not support EngineMode '%s' (%+v)", rp.EngineMode, rp)
+	}
+
+	if err := sqlengine.ValidateDriver(rp.Engine); err != nil {
+		return fmt.Errorf("%s (%+v)", err, rp)
+	}
+
+	return nil
+}
+
+func (rp RDSProperties) scalingConfiguration() awsrds.ScalingConfiguration {
+	return awsrds.ScalingConfiguration{
+		MinCapacity:           rp.MinCapacity,
+		MaxCapacity:           rp.MaxCapacity,
+		AutoPause:             rp.AutoPause,
+		SecondsUntilAutoPause: rp.SecondsUntilAutoPause,
+	}
+}
+
+func validateScalingConfiguration(scalingConfiguration awsrds.ScalingConfiguration) error {
+	if scalingConfiguration.MinCapacity < 0 || scalingConfiguration.MaxCapacity < 0 {
+		return fmt.Errorf("Must provide a non-negative MinCapacity and MaxCapacity")
+	}
+
+	if scalingConfiguration.MaxCapacity > 0 && scalingConfiguration.MinCapacity > scalingConfiguration.MaxCapacity {
+		return fmt.Errorf("MinCapacity %d must not be greater than MaxCapacity %d", scalingConfiguration.MinCapacity, scalingConfiguration.MaxCapacity)
+	}
+
+	if scalingConfiguration.SecondsUntilAutoPause != 0 && (scalingConfiguration.SecondsUntilAutoPause < minSecondsUntilAutoPause || scalingConfiguration.SecondsUntilAutoPause > maxSecondsUntilAutoPause) {
+		return fmt.Errorf("SecondsUntilAutoPause must be between %d and %d", minSecondsUntilAutoPause, maxSecondsUntilAutoPause)
+	}
+
 	return nil
 }
+
+func validateAllowedParameters(kind string, allowedParameters map[string]ParameterConstraints, definitions map[string]parameterDefinition) error {
+	for name, parameterConstraints := range allowedParameters {
+		definition, ok := definitions[name]
+		if !ok {
+			return fmt.Errorf("This broker does not support %s parameter '%s'", kind, name)
+		}
+
+		if err := parameterConstraints.Validate(definition.Type); err != nil {
+			return fmt.Errorf("Parameter '%s': %s", name, err)
+		}
+
+		for _, value := range parameterConstraints.Enum {
+			if !definition.supports(value) {
+				return fmt.Errorf("Parameter '%s': Enum value '%s' is not supported, it must be one of %v", name, value, definition.Enum)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateLicenseModel(engine string, licenseModel string) error {
+	validLicenseModels, ok := licenseModels[strings.ToLower(engine)]
+	if !ok {
+		return fmt.Errorf("This broker does not support LicenseModel for RDS engine '%s'", engine)
+	}
+
+	for _, validLicenseModel := range validLicenseModels {
+		if licenseModel == validLicenseModel {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("LicenseModel '%s' is not valid for RDS engine '%s': it must be one of %v", licenseModel, engine, validLicenseModels)
+}
+
+func isOracleEngine(engine string) bool {
+	return awsrds.EngineProtocol(engine) == awsrds.ProtocolOracle
+}
+
+func isSQLServerEngine(engine string) bool {
+	return awsrds.EngineProtocol(engine) == awsrds.ProtocolSQLServer
+}
+
+func isServerlessEngineMode(engineMode string) bool {
+	return strings.ToLower(engineMode) == awsrds.EngineModeServerless
+}
@@ -203,6 +203,128 @@ var _ = Describe("ServicePlan", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Validating RDS Properties configuration"))
 		})
+
+		It("does not return error if allowed parameters are valid", func() {
+			servicePlan.ProvisionParameters = map[string]ParameterConstraints{
+				"allocated_storage": {Min: int64Pointer(10), Max: int64Pointer(100)},
+				"storage_type":      {Enum: []string{"gp2", "io1"}},
+				"multi_az":          {},
+			}
+			servicePlan.UpdateParameters = map[string]ParameterConstraints{"apply_immediately": {}}
+			servicePlan.BindParameters = map[string]ParameterConstraints{"role": {Enum: []string{"read_only", "read_write"}}}
+
+			err := servicePlan.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if an allowed parameter is not supported", func() {
+			servicePlan.UpdateParameters = map[string]ParameterConstraints{"dbname": {}}
+
+			err := servicePlan.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Validating Update Parameters configuration: This broker does not support update parameter 'dbname'"))
+		})
+
+		It("returns error if Min is greater than Max", func() {
+			servicePlan.ProvisionParameters = map[string]ParameterConstraints{
+				"allocated_storage": {Min: int64Pointer(100), Max: int64Pointer(10)},
+			}
+
+			err := servicePlan.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Parameter 'allocated_storage': Min 100 must not be greater than Max 10"))
+		})
+
+		It("returns error if Min is set for a non integer parameter", func() {
+			servicePlan.ProvisionParameters = map[string]ParameterConstraints{
+				"storage_type": {Min: int64Pointer(1)},
+			}
+
+			err := servicePlan.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Parameter 'storage_type': Min and Max are only supported by integer parameters"))
+		})
+
+		It("returns error if Enum is set for a non string parameter", func() {
+			servicePlan.ProvisionParameters = map[string]ParameterConstraints{
+				"multi_az": {Enum: []string{"true"}},
+			}
+
+			err := servicePlan.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Parameter 'multi_az': Enum is only supported by string parameters"))
+		})
+
+		It("returns error if Enum has a value not supported by the broker", func() {
+			servicePlan.UpdateParameters = map[string]ParameterConstraints{
+				"state": {Enum: []string{"stopped", "paused"}},
+			}
+
+			err := servicePlan.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Parameter 'state': Enum value 'paused' is not supported, it must be one of [running stopped]"))
+		})
+	})
+
+	Describe("Schemas", func() {
+		BeforeEach(func() {
+			servicePlan.ProvisionParameters = map[string]ParameterConstraints{
+				"allocated_storage": {Min: int64Pointer(10), Max: int64Pointer(100)},
+			}
+			servicePlan.UpdateParameters = map[string]ParameterConstraints{"apply_immediately": {}}
+			servicePlan.BindParameters = map[string]ParameterConstraints{"role": {Enum: []string{"read_only"}}}
+		})
+
+		It("returns the JSON Schemas of the allowed parameters", func() {
+			schemas := servicePlan.Schemas()
+			Expect(schemas.Instance.Create.Parameters).To(Equal(map[string]interface{}{
+				"$schema": "http://json-schema.org/draft-04/schema#",
+				"type":    "object",
+				"properties": map[string]interface{}{
+					"allocated_storage": map[string]interface{}{
+						"type":        "integer",
+						"description": "The amount of storage (in gigabytes) to be allocated for the DB instance",
+						"minimum":     int64(10),
+						"maximum":     int64(100),
+					},
+				},
+				"additionalProperties": false,
+			}))
+			Expect(schemas.Instance.Update.Parameters["properties"]).To(Equal(map[string]interface{}{
+				"apply_immediately": map[string]interface{}{
+					"type":        "boolean",
+					"description": "Apply the modifications as soon as possible, regardless of the maintenance window",
+				},
+			}))
+			Expect(schemas.Binding.Create.Parameters["properties"]).To(Equal(map[string]interface{}{
+				"role": map[string]interface{}{
+					"type":        "string",
+					"description": "The privileges granted to the binding user over the Database",
+					"enum":        []string{"read_only"},
+				},
+			}))
+		})
+
+		It("restricts the parameters supported by the broker to their values", func() {
+			servicePlan.UpdateParameters = map[string]ParameterConstraints{"state": {}}
+
+			schemas := servicePlan.Schemas()
+			Expect(schemas.Instance.Update.Parameters["properties"]).To(Equal(map[string]interface{}{
+				"state": map[string]interface{}{
+					"type":        "string",
+					"description": "Stop or start the DB instance",
+					"enum":        []string{"running", "stopped"},
+				},
+			}))
+		})
+
+		It("returns empty JSON Schemas if no parameter is allowed", func() {
+			servicePlan.ProvisionParameters = nil
+
+			schemas := servicePlan.Schemas()
+			Expect(schemas.Instance.Create.Parameters["properties"]).To(BeEmpty())
+			Expect(schemas.Instance.Create.Parameters["additionalProperties"]).To(BeFalse())
+		})
 	})
 })
 
@@ -251,5 +373,178 @@ var _ = Describe("RDSProperties", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("This broker does not support RDS engine"))
 		})
+
+		It("does not return error if Engine is Oracle with a LicenseModel", func() {
+			rdsProperties.Engine = "oracle-se2"
+			rdsProperties.LicenseModel = "license-included"
+
+			err := rdsProperties.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if Engine is Oracle without a LicenseModel", func() {
+			rdsProperties.Engine = "oracle-se2"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Must provide a LicenseModel for RDS engine 'oracle-se2'"))
+		})
+
+		It("returns error if LicenseModel is not valid for Oracle Enterprise Edition", func() {
+			rdsProperties.Engine = "oracle-ee"
+			rdsProperties.LicenseModel = "license-included"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("LicenseModel 'license-included' is not valid for RDS engine 'oracle-ee': it must be one of [bring-your-own-license]"))
+		})
+
+		It("does not return error if Engine is SQL Server", func() {
+			rdsProperties.Engine = "sqlserver-ex"
+
+			err := rdsProperties.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if LicenseModel is not valid for SQL Server", func() {
+			rdsProperties.Engine = "sqlserver-se"
+			rdsProperties.LicenseModel = "bring-your-own-license"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("LicenseModel 'bring-your-own-license' is not valid for RDS engine 'sqlserver-se'"))
+		})
+
+		It("returns error if LicenseModel is set for Aurora", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.LicenseModel = "general-public-license"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support LicenseModel for RDS engine 'aurora'"))
+		})
+
+		It("returns error if CredentialsRotationDays is negative", func() {
+			rdsProperties.CredentialsRotationDays = -1
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Must provide a non-negative CredentialsRotationDays"))
+		})
+
+		It("returns error if DefaultBindingRole is not valid", func() {
+			rdsProperties.DefaultBindingRole = "superuser"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support binding role 'superuser'"))
+		})
+
+		It("does not return error if DefaultBindingRole is valid", func() {
+			rdsProperties.DefaultBindingRole = "read_only"
+
+			err := rdsProperties.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if InstanceCount is not valid", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.InstanceCount = 17
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Must provide an InstanceCount between 1 and 16"))
+		})
+
+		It("returns error if InstanceCount is not supported by the Engine", func() {
+			rdsProperties.InstanceCount = 2
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support InstanceCount for RDS engine 'MySQL'"))
+		})
+
+		It("does not return error if InstanceCount is valid", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.InstanceCount = 3
+
+			err := rdsProperties.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not return error if InstanceCount is valid for Aurora PostgreSQL", func() {
+			rdsProperties.Engine = "aurora-postgresql"
+			rdsProperties.InstanceCount = 3
+
+			err := rdsProperties.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not return error if EngineMode is serverless", func() {
+			rdsProperties.Engine = "aurora-mysql"
+			rdsProperties.EngineMode = "serverless"
+			rdsProperties.MinCapacity = 1
+			rdsProperties.MaxCapacity = 4
+			rdsProperties.AutoPause = true
+			rdsProperties.SecondsUntilAutoPause = 300
+
+			err := rdsProperties.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if EngineMode is not supported", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.EngineMode = "parallelquery"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support EngineMode 'parallelquery'"))
+		})
+
+		It("returns error if EngineMode is serverless and the Engine is not an Aurora one", func() {
+			rdsProperties.EngineMode = "serverless"
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support EngineMode 'serverless' for RDS engine 'MySQL'"))
+		})
+
+		It("returns error if EngineMode is serverless and has InstanceCount", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.EngineMode = "serverless"
+			rdsProperties.InstanceCount = 2
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support InstanceCount or ReadReplica for EngineMode 'serverless'"))
+		})
+
+		It("returns error if SecondsUntilAutoPause is not valid", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.EngineMode = "serverless"
+			rdsProperties.SecondsUntilAutoPause = 60
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SecondsUntilAutoPause must be between 300 and 86400"))
+		})
+
+		It("returns error if has scaling settings and EngineMode is not serverless", func() {
+			rdsProperties.Engine = "aurora"
+			rdsProperties.MaxCapacity = 4
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker only supports MinCapacity, MaxCapacity, AutoPause and SecondsUntilAutoPause for EngineMode 'serverless'"))
+		})
+
+		It("returns error if ReadReplica is not supported by the Engine", func() {
+			rdsProperties.Engine = "sqlserver-ex"
+			rdsProperties.ReadReplica = true
+
+			err := rdsProperties.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("This broker does not support read replicas for RDS engine 'sqlserver-ex'"))
+		})
 	})
 })
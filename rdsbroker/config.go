@@ -6,12 +6,10 @@ import (
 )
 
 type Config struct {
-	Region                       string  `json:"region"`
-	DBPrefix                     string  `json:"db_prefix"`
-	AllowUserProvisionParameters bool    `json:"allow_user_provision_parameters"`
-	AllowUserUpdateParameters    bool    `json:"allow_user_update_parameters"`
-	AllowUserBindParameters      bool    `json:"allow_user_bind_parameters"`
-	Catalog                      Catalog `json:"catalog"`
+	Region       string            `json:"region"`
+	DBPrefix     string            `json:"db_prefix"`
+	Catalog      Catalog           `json:"catalog"`
+	StatusPolicy map[string]string `json:"status_policy"`
 }
 
 func (c Config) Validate() error {
@@ -27,5 +25,9 @@ func (c Config) Validate() error {
 		return fmt.Errorf("Validating Catalog configuration: %s", err)
 	}
 
+	if err := validateStatusPolicy(c.StatusPolicy); err != nil {
+		return fmt.Errorf("Validating Status Policy configuration: %s", err)
+	}
+
 	return nil
 }
@@ -63,5 +63,31 @@ var _ = Describe("Config", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("Validating Catalog configuration"))
 		})
+
+		It("does not return error if Status Policy sets the state of ambiguous statuses", func() {
+			config.StatusPolicy = map[string]string{
+				"storage-full":         "in progress",
+				"storage-optimization": "failed",
+			}
+
+			err := config.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns error if Status Policy sets the state of a status that is not ambiguous", func() {
+			config.StatusPolicy = map[string]string{"failed": "succeeded"}
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Validating Status Policy configuration: RDS status 'failed' is not an ambiguous status"))
+		})
+
+		It("returns error if Status Policy sets an invalid state", func() {
+			config.StatusPolicy = map[string]string{"storage-full": "unknown"}
+
+			err := config.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid state 'unknown' for RDS status 'storage-full'"))
+		})
 	})
 })
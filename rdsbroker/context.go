@@ -0,0 +1,73 @@
+package rdsbroker
+
+import (
+	"unicode"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+const maxTagValueLength = 256
+
+// RequestContext holds the platform specific fields of the context object sent by OSBAPI 2.12+ platforms
+type RequestContext struct {
+	Platform         string `mapstructure:"platform"`
+	OrganizationGUID string `mapstructure:"organization_guid"`
+	OrganizationName string `mapstructure:"organization_name"`
+	SpaceGUID        string `mapstructure:"space_guid"`
+	SpaceName        string `mapstructure:"space_name"`
+	InstanceName     string `mapstructure:"instance_name"`
+	Namespace        string `mapstructure:"namespace"`
+	ClusterID        string `mapstructure:"clusterid"`
+}
+
+// NewRequestContext decodes the fields of a context object known by the broker, ignoring the others
+func NewRequestContext(context map[string]interface{}) RequestContext {
+	requestContext := RequestContext{}
+
+	// Fields of an unexpected type are left empty, as the context is informational only
+	mapstructure.Decode(context, &requestContext)
+
+	return requestContext
+}
+
+func (rc RequestContext) tags() map[string]string {
+	tags := make(map[string]string)
+
+	values := map[string]string{
+		"Platform":          rc.Platform,
+		"Organization Name": rc.OrganizationName,
+		"Space Name":        rc.SpaceName,
+		"Instance Name":     rc.InstanceName,
+		"Namespace":         rc.Namespace,
+		"Cluster ID":        rc.ClusterID,
+	}
+	for key, value := range values {
+		if value != "" {
+			tags[key] = tagValue(value)
+		}
+	}
+
+	return tags
+}
+
+// tagValue replaces the characters that are not allowed in AWS tag values and truncates them to their maximum length
+func tagValue(value string) string {
+	runes := []rune(value)
+	if len(runes) > maxTagValueLength {
+		runes = runes[:maxTagValueLength]
+	}
+
+	for i, r := range runes {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+			continue
+		}
+
+		switch r {
+		case '_', '.', ':', '/', '=', '+', '-', '@':
+		default:
+			runes[i] = '_'
+		}
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,15 @@
+package rdsbroker
+
+type Credentials struct {
+	Host           string `json:"host,omitempty"`
+	Port           int64  `json:"port,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Username       string `json:"username,omitempty"`
+	Password       string `json:"password,omitempty"`
+	URI            string `json:"uri,omitempty"`
+	JDBCURI        string `json:"jdbcUrl,omitempty"`
+	ReaderEndpoint string `json:"reader_endpoint,omitempty"`
+	ReaderPort     int64  `json:"reader_port,omitempty"`
+	ReaderURI      string `json:"reader_uri,omitempty"`
+	ReaderJDBCURI  string `json:"reader_jdbc_uri,omitempty"`
+}
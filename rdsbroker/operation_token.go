@@ -0,0 +1,77 @@
+package rdsbroker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+)
+
+const operationTokenSeparator = ":"
+
+// operationToken identifies an asynchronous operation to the platform, that sends it back when polling its last operation
+type operationToken struct {
+	Type      string
+	StartedAt time.Time
+}
+
+func newOperationToken(operationDetails statestore.OperationDetails) operationToken {
+	return operationToken{
+		Type:      operationDetails.Type,
+		StartedAt: operationDetails.StartedAt,
+	}
+}
+
+// parseOperationToken decodes the operation data sent by the platform. Tokens returned by previous broker
+// versions only hold the operation type, and platforms not sending any operation data get an empty token
+func parseOperationToken(operationData string) (operationToken, error) {
+	token := operationToken{}
+	if operationData == "" {
+		return token, nil
+	}
+
+	parts := strings.SplitN(operationData, operationTokenSeparator, 2)
+	switch parts[0] {
+	case statestore.OperationProvision, statestore.OperationUpdate, statestore.OperationDeprovision:
+		token.Type = parts[0]
+	default:
+		return token, fmt.Errorf("Operation '%s' not supported", operationData)
+	}
+
+	if len(parts) == 2 {
+		startedAt, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return operationToken{}, fmt.Errorf("Operation '%s' has an invalid start time", operationData)
+		}
+		token.StartedAt = time.Unix(startedAt, 0)
+	}
+
+	return token, nil
+}
+
+func (t operationToken) String() string {
+	if t.StartedAt.IsZero() {
+		return t.Type
+	}
+
+	return t.Type + operationTokenSeparator + strconv.FormatInt(t.StartedAt.Unix(), 10)
+}
+
+// matches returns whether the token identifies an operation, ignoring the start time of tokens without one
+func (t operationToken) matches(operationDetails statestore.OperationDetails) bool {
+	if t.Type != operationDetails.Type {
+		return false
+	}
+
+	return t.StartedAt.IsZero() || t.StartedAt.Unix() == operationDetails.StartedAt.Unix()
+}
+
+// operationDetails returns the details of the operation identified by the token, for operations the state store has no record of
+func (t operationToken) operationDetails() statestore.OperationDetails {
+	return statestore.OperationDetails{
+		Type:      t.Type,
+		StartedAt: t.StartedAt,
+	}
+}
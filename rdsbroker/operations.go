@@ -0,0 +1,757 @@
+package rdsbroker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pivotal-golang/lager"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+	"github.com/cloudfoundry-community/pe-rds-broker/operation"
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+)
+
+// RunOperations resumes the operations in progress, so they complete even if nobody polls their last operation,
+// and migrates the instances still using a legacy master password
+func (b *RDSBroker) RunOperations() {
+	instances, err := b.stateStore.ListInstances()
+	if err != nil {
+		b.logger.Error("state-store-error", err)
+		return
+	}
+
+	for _, instance := range instances {
+		if instance.LastOperation.State != operation.StateInProgress {
+			if hasLegacyMasterPassword(instance) {
+				b.migrateLegacyMasterPassword(instance)
+			}
+			continue
+		}
+
+		if _, err := b.resumeOperation(instance.ID); err != nil {
+			b.logger.Error("run-operation-error", err, lager.Data{instanceIDLogKey: instance.ID})
+		}
+	}
+}
+
+// hasLegacyMasterPassword reports whether an instance was created by a previous version of the broker and still
+// uses the master password derived from its ID. Read replicas use the master password of their source, and
+// restored instances have theirs reset when their operation completes
+func hasLegacyMasterPassword(instance statestore.InstanceDetails) bool {
+	return instance.MasterPassword == "" &&
+		instance.PendingMasterPassword == "" &&
+		!instance.ResetMasterPassword &&
+		instance.ReadReplicaOf == "" &&
+		instance.LastOperation.Type != statestore.OperationDeprovision
+}
+
+func (b *RDSBroker) migrateLegacyMasterPassword(instance statestore.InstanceDetails) {
+	servicePlan, err := b.servicePlan(instance.ID, instance.PlanID)
+	if err != nil {
+		b.logger.Error("migrate-master-password-error", err, lager.Data{instanceIDLogKey: instance.ID})
+		return
+	}
+
+	b.migrateMasterPassword(instance.ID, servicePlan)
+}
+
+// startOperation saves the instance state with a new operation and runs its steps until the first one that waits for AWS.
+// It returns the token identifying the operation. If a step fails, its error is returned and the previous instance state is restored
+func (b *RDSBroker) startOperation(instance statestore.InstanceDetails, servicePlan ServicePlan, operationType string, parameters map[string]interface{}) (operationToken, error) {
+	b.operationsLock.Lock()
+	defer b.operationsLock.Unlock()
+
+	previousInstance, previousErr := b.stateStore.FindInstance(instance.ID)
+
+	if err := b.saveInstanceState(instance, servicePlan, operationType); err != nil {
+		return operationToken{}, err
+	}
+
+	instance, err := b.stateStore.FindInstance(instance.ID)
+	if err == nil {
+		instance.LastOperation.Parameters = parameters
+
+		var steps []operation.Step
+		if steps, err = b.operationSteps(instance); err == nil {
+			var operationDetails statestore.OperationDetails
+			operationDetails, err = b.operationRunner.Start(instance.LastOperation, steps, b.saveOperation(instance.ID))
+			if err != nil && operationDetails.RollbackStep != "" {
+				// The instance state is kept until the rollback, resumed in the background, is done
+				return operationToken{}, err
+			}
+		}
+	}
+
+	if err != nil {
+		if previousErr == nil {
+			// A master password promoted by a step is kept, as RDS already uses it
+			if currentInstance, findErr := b.stateStore.FindInstance(instance.ID); findErr == nil {
+				previousInstance.MasterPassword = currentInstance.MasterPassword
+			}
+			b.stateStore.SaveInstance(previousInstance)
+		} else if currentInstance, findErr := b.stateStore.FindInstance(instance.ID); findErr != nil || currentInstance.MasterPassword == instance.MasterPassword {
+			// A new instance state is kept if a step promoted a master password, as it is the only record of it
+			b.stateStore.DeleteInstance(instance.ID)
+		}
+		return operationToken{}, err
+	}
+
+	return newOperationToken(instance.LastOperation), nil
+}
+
+// resumeOperation runs the remaining steps of the operation in progress of an instance, and returns its details.
+// A failed step is not returned as an error, as it is reported by the operation state
+func (b *RDSBroker) resumeOperation(instanceID string) (statestore.OperationDetails, error) {
+	b.operationsLock.Lock()
+	defer b.operationsLock.Unlock()
+
+	instance, err := b.stateStore.FindInstance(instanceID)
+	if err != nil {
+		if err == statestore.ErrInstanceNotFound {
+			return statestore.OperationDetails{}, nil
+		}
+		return statestore.OperationDetails{}, err
+	}
+
+	if instance.LastOperation.State != operation.StateInProgress {
+		return instance.LastOperation, nil
+	}
+
+	steps, err := b.operationSteps(instance)
+	if err != nil {
+		return instance.LastOperation, err
+	}
+
+	operationDetails, err := b.operationRunner.Resume(instance.LastOperation, steps, b.saveOperation(instanceID))
+	if err != nil && operationDetails.State != operation.StateFailed && operationDetails.RollbackStep == "" {
+		return operationDetails, err
+	}
+
+	return operationDetails, nil
+}
+
+func (b *RDSBroker) saveOperation(instanceID string) operation.SaveFunc {
+	return func(operationDetails statestore.OperationDetails) error {
+		instance, err := b.stateStore.FindInstance(instanceID)
+		if err != nil {
+			return err
+		}
+
+		instance.LastOperation = operationDetails
+		instance.UpdatedAt = time.Now()
+
+		// A pending master password is promoted as soon as RDS accepts it, so once the operation fails it was never set
+		// (restored instances keep theirs, as it is set once the restore finishes)
+		if operationDetails.State == operation.StateFailed && !instance.ResetMasterPassword {
+			instance.PendingMasterPassword = ""
+		}
+
+		return b.stateStore.SaveInstance(instance)
+	}
+}
+
+// operationSteps builds the steps of the last operation of an instance from its state, so the operation
+// can be resumed after the broker restarts
+func (b *RDSBroker) operationSteps(instance statestore.InstanceDetails) ([]operation.Step, error) {
+	servicePlan, err := b.servicePlan(instance.ID, instance.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch instance.LastOperation.Type {
+	case statestore.OperationProvision:
+		return b.provisionSteps(instance, servicePlan)
+	case statestore.OperationUpdate:
+		return b.updateSteps(instance, servicePlan)
+	case statestore.OperationDeprovision:
+		return b.deprovisionSteps(instance, servicePlan), nil
+	case statestore.OperationRotateMasterPassword:
+		return b.rotateMasterPasswordSteps(instance, servicePlan)
+	}
+
+	return nil, fmt.Errorf("Operation '%s' not supported", instance.LastOperation.Type)
+}
+
+func (b *RDSBroker) provisionSteps(instance statestore.InstanceDetails, servicePlan ServicePlan) ([]operation.Step, error) {
+	provisionParameters := ProvisionParameters{}
+	if err := mapstructure.Decode(instance.Parameters, &provisionParameters); err != nil {
+		return nil, err
+	}
+	servicePlan.RDSProperties = provisionParameters.RDSParameters.apply(servicePlan.RDSProperties)
+
+	restoreTime, err := b.parseRestoreParameters(provisionParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	// A restored DB is created with the pending master password, that is reset once the restore finishes
+	encryptedMasterPassword := instance.MasterPassword
+	if encryptedMasterPassword == "" {
+		encryptedMasterPassword = instance.PendingMasterPassword
+	}
+
+	var masterPassword string
+	if encryptedMasterPassword != "" {
+		if masterPassword, err = b.encryptor.Decrypt(encryptedMasterPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	details := brokerapi.ProvisionDetails{
+		ServiceID:        instance.ServiceID,
+		PlanID:           instance.PlanID,
+		OrganizationGUID: instance.OrganizationGUID,
+		SpaceGUID:        instance.SpaceGUID,
+		Parameters:       instance.Parameters,
+		Context:          instance.Context,
+	}
+
+	instanceID := instance.ID
+	dbClusterIdentifier := b.dbClusterIdentifier(instanceID)
+	dbInstanceIdentifier := b.dbInstanceIdentifier(instanceID)
+	createDBInstance := b.createDBInstance(instanceID, masterPassword, servicePlan, provisionParameters, details)
+
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) && provisionParameters.ReadReplicaOf == "" {
+		createDBCluster := b.createDBCluster(instanceID, masterPassword, servicePlan, provisionParameters, details)
+
+		steps := []operation.Step{
+			{
+				Name:        "create-db-cluster",
+				Description: fmt.Sprintf("Creating DB Cluster '%s'", dbClusterIdentifier),
+				Run: func() (bool, error) {
+					var err error
+					switch {
+					case provisionParameters.RestoreFromSnapshot != "":
+						err = b.dbCluster.RestoreFromSnapshot(dbClusterIdentifier, provisionParameters.RestoreFromSnapshot, *createDBCluster)
+					case provisionParameters.SourceInstanceID != "":
+						err = b.dbCluster.RestoreToPointInTime(dbClusterIdentifier, b.dbClusterIdentifier(provisionParameters.SourceInstanceID), restoreTime, *createDBCluster)
+					default:
+						err = b.dbCluster.Create(dbClusterIdentifier, *createDBCluster)
+					}
+					return err == nil, err
+				},
+				// The DB Cluster can only be deleted once the members created by the next steps are gone,
+				// and has nothing worth a final snapshot
+				ReadyToCompensate: func() (bool, error) {
+					return b.dbClusterMembersDeleted(dbClusterIdentifier)
+				},
+				Compensate: func() error {
+					return b.dbCluster.Delete(dbClusterIdentifier, true)
+				},
+			},
+		}
+
+		for index := 0; index < b.dbClusterInstanceCount(servicePlan); index++ {
+			steps = append(steps, b.createDBClusterMemberStep(instanceID, index, *createDBInstance))
+		}
+
+		return append(steps, b.waitAvailableStep(instance, servicePlan)), nil
+	}
+
+	return []operation.Step{
+		{
+			Name:        "create-db-instance",
+			Description: fmt.Sprintf("Creating DB Instance '%s'", dbInstanceIdentifier),
+			Run: func() (bool, error) {
+				var err error
+				switch {
+				case awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine):
+					createDBInstance.DBClusterIdentifier = b.dbClusterIdentifier(provisionParameters.ReadReplicaOf)
+					err = b.dbInstance.Create(dbInstanceIdentifier, *createDBInstance)
+				case provisionParameters.ReadReplicaOf != "":
+					err = b.dbInstance.CreateReadReplica(dbInstanceIdentifier, b.dbInstanceIdentifier(provisionParameters.ReadReplicaOf), *createDBInstance)
+				case provisionParameters.RestoreFromSnapshot != "":
+					err = b.dbInstance.RestoreFromSnapshot(dbInstanceIdentifier, provisionParameters.RestoreFromSnapshot, *createDBInstance)
+				case provisionParameters.SourceInstanceID != "":
+					err = b.dbInstance.RestoreToPointInTime(dbInstanceIdentifier, b.dbInstanceIdentifier(provisionParameters.SourceInstanceID), restoreTime, *createDBInstance)
+				default:
+					err = b.dbInstance.Create(dbInstanceIdentifier, *createDBInstance)
+				}
+				return err == nil, err
+			},
+			Compensate: func() error {
+				return b.dbInstance.Delete(dbInstanceIdentifier, true)
+			},
+		},
+		b.waitAvailableStep(instance, servicePlan),
+	}, nil
+}
+
+func (b *RDSBroker) createDBClusterMemberStep(instanceID string, index int, createDBInstance awsrds.DBInstanceDetails) operation.Step {
+	dbMemberIdentifier := b.dbClusterMemberIdentifier(instanceID, index)
+
+	return operation.Step{
+		Name:        fmt.Sprintf("create-db-instance-%d", index),
+		Description: fmt.Sprintf("Creating DB Instance '%s'", dbMemberIdentifier),
+		Run: func() (bool, error) {
+			err := b.dbInstance.Create(dbMemberIdentifier, createDBInstance)
+			return err == nil, err
+		},
+		Compensate: func() error {
+			return b.dbInstance.Delete(dbMemberIdentifier, true)
+		},
+	}
+}
+
+func (b *RDSBroker) updateSteps(instance statestore.InstanceDetails, servicePlan ServicePlan) ([]operation.Step, error) {
+	updateParameters := UpdateParameters{}
+	if err := mapstructure.Decode(instance.LastOperation.Parameters, &updateParameters); err != nil {
+		return nil, err
+	}
+
+	// RDS Properties overridden by previous provision or update requests are kept, instead of reverting them to the plan ones
+	rdsParameters := RDSParameters{}
+	if err := mapstructure.Decode(instance.Parameters, &rdsParameters); err != nil {
+		return nil, err
+	}
+	servicePlan.RDSProperties = rdsParameters.apply(servicePlan.RDSProperties)
+
+	var masterPassword string
+	if updateParameters.RotateMasterPassword && instance.PendingMasterPassword != "" {
+		var err error
+		if masterPassword, err = b.encryptor.Decrypt(instance.PendingMasterPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	details := brokerapi.UpdateDetails{
+		ServiceID:  instance.ServiceID,
+		PlanID:     instance.PlanID,
+		Parameters: instance.LastOperation.Parameters,
+		Context:    instance.Context,
+	}
+
+	instanceID := instance.ID
+
+	modifyDBInstance := b.modifyDBInstance(instanceID, servicePlan, updateParameters, details)
+	if !awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		modifyDBInstance.MasterUserPassword = masterPassword
+	}
+
+	// Stopped DBs cannot be modified, so they are started first
+	steps := []operation.Step{}
+	if updateParameters.State == dbStateRunning {
+		steps = append(steps, b.startDBSteps(instanceID, servicePlan)...)
+	}
+
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) && instance.ReadReplicaOf == "" {
+		modifyDBCluster := b.modifyDBCluster(instanceID, servicePlan, updateParameters, details)
+		modifyDBCluster.MasterUserPassword = masterPassword
+
+		steps = append(steps, operation.Step{
+			Name:        "modify-db-cluster",
+			Description: fmt.Sprintf("Modifying DB Cluster '%s'", b.dbClusterIdentifier(instanceID)),
+			Run: func() (bool, error) {
+				if err := b.dbCluster.Modify(b.dbClusterIdentifier(instanceID), *modifyDBCluster, updateParameters.ApplyImmediately); err != nil {
+					return false, err
+				}
+				// RDS changes the master password right away, whatever happens to the following steps
+				if masterPassword != "" {
+					b.promotePendingMasterPassword(instanceID)
+				}
+				return true, nil
+			},
+		})
+	}
+
+	// Aurora Serverless DB Clusters have no DB Instances to modify or scale
+	if isServerlessEngineMode(servicePlan.RDSProperties.EngineMode) {
+		return append(steps, b.waitAvailableStep(instance, servicePlan)), nil
+	}
+
+	steps = append(steps, operation.Step{
+		Name:        "modify-db-instance",
+		Description: fmt.Sprintf("Modifying DB Instance '%s'", b.dbInstanceIdentifier(instanceID)),
+		Run: func() (bool, error) {
+			if err := b.dbInstance.Modify(b.dbInstanceIdentifier(instanceID), *modifyDBInstance, updateParameters.ApplyImmediately); err != nil {
+				if err == awsrds.ErrDBInstanceDoesNotExist {
+					return false, brokerapi.ErrInstanceDoesNotExist
+				}
+				return false, err
+			}
+			if modifyDBInstance.MasterUserPassword != "" {
+				b.promotePendingMasterPassword(instanceID)
+			}
+			return true, nil
+		},
+	})
+
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) && instance.ReadReplicaOf == "" {
+		steps = append(steps, operation.Step{
+			Name:        "scale-db-cluster",
+			Description: fmt.Sprintf("Scaling DB Cluster '%s' to %d DB Instances", b.dbClusterIdentifier(instanceID), b.dbClusterInstanceCount(servicePlan)),
+			Run: func() (bool, error) {
+				err := b.scaleDBCluster(instance, servicePlan, modifyDBInstance, updateParameters.ApplyImmediately, details)
+				return err == nil, err
+			},
+		})
+	}
+
+	steps = append(steps, b.waitAvailableStep(instance, servicePlan))
+
+	// DBs are stopped once modified, as RDS does not modify stopped DBs
+	if updateParameters.State == dbStateStopped {
+		steps = append(steps, b.stopDBSteps(instanceID, servicePlan)...)
+	}
+
+	return steps, nil
+}
+
+// rotateMasterPasswordSteps change the master password of an instance to its pending one, without modifying
+// anything else. The password is saved as soon as RDS accepts it, as RDS starts using it right away
+func (b *RDSBroker) rotateMasterPasswordSteps(instance statestore.InstanceDetails, servicePlan ServicePlan) ([]operation.Step, error) {
+	var masterPassword string
+	if instance.PendingMasterPassword != "" {
+		var err error
+		if masterPassword, err = b.encryptor.Decrypt(instance.PendingMasterPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	instanceID := instance.ID
+	resource, identifier := "DB Instance", b.dbInstanceIdentifier(instanceID)
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		resource, identifier = "DB Cluster", b.dbClusterIdentifier(instanceID)
+	}
+
+	return []operation.Step{
+		{
+			Name:        "modify-master-password",
+			Description: fmt.Sprintf("Changing the master password of %s '%s'", resource, identifier),
+			Run: func() (bool, error) {
+				// There is no pending master password left once it has been saved
+				if masterPassword == "" {
+					return true, nil
+				}
+
+				if err := b.modifyMasterPassword(instanceID, servicePlan, masterPassword); err != nil {
+					if err == awsrds.ErrDBInstanceDoesNotExist || err == awsrds.ErrDBClusterDoesNotExist {
+						return false, brokerapi.ErrInstanceDoesNotExist
+					}
+					return false, err
+				}
+				b.promotePendingMasterPassword(instanceID)
+				return true, nil
+			},
+		},
+		b.waitDBStatusStep("wait-available", fmt.Sprintf("Waiting for %s '%s' to be available", resource, identifier), instanceID, servicePlan, "available"),
+	}, nil
+}
+
+// startDBSteps start the DB Instance (or the DB Cluster) of an instance when it is stopped
+func (b *RDSBroker) startDBSteps(instanceID string, servicePlan ServicePlan) []operation.Step {
+	resource, stepSuffix, identifier, start := "DB Instance", "db-instance", b.dbInstanceIdentifier(instanceID), b.dbInstance.Start
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		resource, stepSuffix, identifier, start = "DB Cluster", "db-cluster", b.dbClusterIdentifier(instanceID), b.dbCluster.Start
+	}
+
+	return []operation.Step{
+		{
+			Name:        "start-" + stepSuffix,
+			Description: fmt.Sprintf("Starting %s '%s'", resource, identifier),
+			Run: func() (bool, error) {
+				status, err := b.describeDBStatus(instanceID, servicePlan)
+				if err != nil {
+					return false, err
+				}
+
+				switch status {
+				case "stopped":
+				case "stopping":
+					// RDS only starts DBs once they are fully stopped
+					return false, nil
+				default:
+					return true, nil
+				}
+
+				if err := start(identifier); err != nil {
+					if err == awsrds.ErrDBInstanceDoesNotExist || err == awsrds.ErrDBClusterDoesNotExist {
+						return false, brokerapi.ErrInstanceDoesNotExist
+					}
+					return false, err
+				}
+				return true, nil
+			},
+		},
+		b.waitDBStatusStep("wait-"+stepSuffix+"-started", fmt.Sprintf("Waiting for %s '%s' to be started", resource, identifier), instanceID, servicePlan, "available"),
+	}
+}
+
+// stopDBSteps stop the DB Instance (or the DB Cluster) of an instance when it is available
+func (b *RDSBroker) stopDBSteps(instanceID string, servicePlan ServicePlan) []operation.Step {
+	resource, stepSuffix, identifier, stop := "DB Instance", "db-instance", b.dbInstanceIdentifier(instanceID), b.dbInstance.Stop
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		resource, stepSuffix, identifier, stop = "DB Cluster", "db-cluster", b.dbClusterIdentifier(instanceID), b.dbCluster.Stop
+	}
+
+	return []operation.Step{
+		{
+			Name:        "stop-" + stepSuffix,
+			Description: fmt.Sprintf("Stopping %s '%s'", resource, identifier),
+			Run: func() (bool, error) {
+				status, err := b.describeDBStatus(instanceID, servicePlan)
+				if err != nil {
+					return false, err
+				}
+
+				switch status {
+				case "available":
+				case "stopped", "stopping":
+					return true, nil
+				default:
+					// RDS only stops available DBs
+					return false, nil
+				}
+
+				if err := stop(identifier); err != nil {
+					if err == awsrds.ErrDBInstanceDoesNotExist || err == awsrds.ErrDBClusterDoesNotExist {
+						return false, brokerapi.ErrInstanceDoesNotExist
+					}
+					return false, err
+				}
+				return true, nil
+			},
+		},
+		b.waitDBStatusStep("wait-"+stepSuffix+"-stopped", fmt.Sprintf("Waiting for %s '%s' to be stopped", resource, identifier), instanceID, servicePlan, "stopped"),
+	}
+}
+
+// waitDBStatusStep waits until the DB Instance (or the DB Cluster) of an instance reaches a status. Being stopped is
+// not a failure, as it is the status DBs are started from
+func (b *RDSBroker) waitDBStatusStep(name string, description string, instanceID string, servicePlan ServicePlan, targetStatus string) operation.Step {
+	return operation.Step{
+		Name:        name,
+		Description: description,
+		Wait:        true,
+		Run: func() (bool, error) {
+			name, err := b.describeDBStatus(instanceID, servicePlan)
+			if err != nil {
+				return false, err
+			}
+
+			if name == targetStatus {
+				return true, nil
+			}
+
+			if status := b.rdsStatus(name); status.State == brokerapi.LastOperationFailed && name != "stopped" {
+				return false, fmt.Errorf("%s status is '%s' (%s)", description, name, status.Description)
+			}
+
+			return false, nil
+		},
+	}
+}
+
+func (b *RDSBroker) deprovisionSteps(instance statestore.InstanceDetails, servicePlan ServicePlan) []operation.Step {
+	instanceID := instance.ID
+	dbInstanceIdentifier := b.dbInstanceIdentifier(instanceID)
+
+	if !awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) || instance.ReadReplicaOf != "" {
+		// Read replicas and Aurora DB Instances do not support final snapshots
+		skipFinalSnapshot := servicePlan.RDSProperties.SkipFinalSnapshot || instance.ReadReplicaOf != ""
+
+		return []operation.Step{
+			{
+				Name:        "delete-db-instance",
+				Description: fmt.Sprintf("Deleting DB Instance '%s'", dbInstanceIdentifier),
+				Run: func() (bool, error) {
+					if err := b.dbInstance.Delete(dbInstanceIdentifier, skipFinalSnapshot); err != nil {
+						if err == awsrds.ErrDBInstanceDoesNotExist {
+							return false, brokerapi.ErrInstanceDoesNotExist
+						}
+						return false, err
+					}
+					return true, nil
+				},
+			},
+			{
+				Name:        "wait-db-instance-deleted",
+				Description: fmt.Sprintf("Waiting for DB Instance '%s' to be deleted", dbInstanceIdentifier),
+				Wait:        true,
+				Run: func() (bool, error) {
+					if _, err := b.dbInstance.Describe(dbInstanceIdentifier); err != nil {
+						if err == awsrds.ErrDBInstanceDoesNotExist {
+							return true, nil
+						}
+						return false, err
+					}
+					return false, nil
+				},
+			},
+		}
+	}
+
+	// The DB Cluster can only be deleted once all its members are gone
+	dbClusterIdentifier := b.dbClusterIdentifier(instanceID)
+
+	return []operation.Step{
+		{
+			Name:        "delete-db-instances",
+			Description: fmt.Sprintf("Deleting the DB Instances of DB Cluster '%s'", dbClusterIdentifier),
+			Run: func() (bool, error) {
+				err := b.deleteDBClusterMembers(dbClusterIdentifier)
+				return err == nil, err
+			},
+		},
+		{
+			Name:        "wait-db-instances-deleted",
+			Description: fmt.Sprintf("Waiting for the DB Instances of DB Cluster '%s' to be deleted", dbClusterIdentifier),
+			Wait:        true,
+			Run: func() (bool, error) {
+				return b.dbClusterMembersDeleted(dbClusterIdentifier)
+			},
+		},
+		{
+			Name:        "delete-db-cluster",
+			Description: fmt.Sprintf("Deleting DB Cluster '%s'", dbClusterIdentifier),
+			Run: func() (bool, error) {
+				if err := b.dbCluster.Delete(dbClusterIdentifier, servicePlan.RDSProperties.SkipFinalSnapshot); err != nil && err != awsrds.ErrDBClusterDoesNotExist {
+					return false, err
+				}
+				return true, nil
+			},
+		},
+		{
+			Name:        "wait-db-cluster-deleted",
+			Description: fmt.Sprintf("Waiting for DB Cluster '%s' to be deleted", dbClusterIdentifier),
+			Wait:        true,
+			Run: func() (bool, error) {
+				if _, err := b.dbCluster.Describe(dbClusterIdentifier); err != nil {
+					if err == awsrds.ErrDBClusterDoesNotExist {
+						return true, nil
+					}
+					return false, err
+				}
+				return false, nil
+			},
+		},
+	}
+}
+
+// deleteDBClusterMembers deletes every member of a DB Cluster, including the ones not created by the broker
+// or beyond the Service Plan instance count
+func (b *RDSBroker) deleteDBClusterMembers(dbClusterIdentifier string) error {
+	dbClusterDetails, err := b.dbCluster.Describe(dbClusterIdentifier)
+	if err != nil {
+		if err == awsrds.ErrDBClusterDoesNotExist {
+			return nil
+		}
+		return err
+	}
+
+	for _, dbMemberIdentifier := range dbClusterDetails.DBClusterMembers {
+		if err := b.dbInstance.Delete(dbMemberIdentifier, true); err != nil && err != awsrds.ErrDBInstanceDoesNotExist {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dbClusterMembersDeleted reports whether all the members of a DB Cluster are gone. It fails if a member is not
+// being deleted, as the DB Cluster would never be deleted otherwise
+func (b *RDSBroker) dbClusterMembersDeleted(dbClusterIdentifier string) (bool, error) {
+	dbClusterDetails, err := b.dbCluster.Describe(dbClusterIdentifier)
+	if err != nil {
+		if err == awsrds.ErrDBClusterDoesNotExist {
+			return true, nil
+		}
+		return false, err
+	}
+
+	for _, dbMemberIdentifier := range dbClusterDetails.DBClusterMembers {
+		dbMemberDetails, err := b.dbInstance.Describe(dbMemberIdentifier)
+		if err != nil {
+			if err == awsrds.ErrDBInstanceDoesNotExist {
+				continue
+			}
+			return false, err
+		}
+
+		if status := b.rdsStatus(dbMemberDetails.Status); status.Name != "deleting" {
+			return false, fmt.Errorf("%s: it is not being deleted", status.describe("DB Instance", dbMemberIdentifier))
+		}
+	}
+
+	return len(dbClusterDetails.DBClusterMembers) == 0, nil
+}
+
+// waitAvailableStep waits until the DB Instance (or the DB Cluster and all its members) of an instance is available
+func (b *RDSBroker) waitAvailableStep(instance statestore.InstanceDetails, servicePlan ServicePlan) operation.Step {
+	instanceID := instance.ID
+	description := fmt.Sprintf("Waiting for DB Instance '%s' to be available", b.dbInstanceIdentifier(instanceID))
+	if awsrds.IsClusterEngine(servicePlan.RDSProperties.Engine) {
+		description = fmt.Sprintf("Waiting for DB Cluster '%s' to be available", b.dbClusterIdentifier(instanceID))
+	}
+
+	return operation.Step{
+		Name:        "wait-available",
+		Description: description,
+		Wait:        true,
+		Run: func() (bool, error) {
+			lastOperationResponse, dbEventSources, err := b.dbLastOperation(instanceID, instance.LastOperation)
+			if err != nil {
+				return false, err
+			}
+
+			switch lastOperationResponse.State {
+			case brokerapi.LastOperationSucceeded:
+				return true, nil
+			case brokerapi.LastOperationFailed:
+				// Only a failure ends up in the description of the operation, so events are not described on every wait
+				lastOperationResponse = b.withDBEvents(lastOperationResponse, instance.LastOperation, dbEventSources...)
+				return false, errors.New(lastOperationResponse.Description)
+			}
+
+			return false, nil
+		},
+	}
+}
+
+// scaleDBCluster creates, modifies or deletes the additional members of a DB Cluster to match the Service Plan instance count
+func (b *RDSBroker) scaleDBCluster(instance statestore.InstanceDetails, servicePlan ServicePlan, modifyDBInstance *awsrds.DBInstanceDetails, applyImmediately bool, details brokerapi.UpdateDetails) error {
+	dbClusterDetails, err := b.dbCluster.Describe(b.dbClusterIdentifier(instance.ID))
+	if err != nil {
+		if err == awsrds.ErrDBClusterDoesNotExist {
+			return brokerapi.ErrInstanceDoesNotExist
+		}
+		return err
+	}
+
+	indexes := b.dbClusterMemberIndexes(instance.ID, dbClusterDetails)
+	members := map[int]bool{}
+	for _, index := range indexes {
+		members[index] = true
+	}
+
+	instanceCount := b.dbClusterInstanceCount(servicePlan)
+	for index := 1; index < instanceCount; index++ {
+		if members[index] {
+			if err := b.dbInstance.Modify(b.dbClusterMemberIdentifier(instance.ID, index), *modifyDBInstance, applyImmediately); err != nil {
+				return err
+			}
+			continue
+		}
+
+		createDBInstance := b.dbInstanceFromPlan(servicePlan)
+		createDBInstance.DBClusterIdentifier = b.dbClusterIdentifier(instance.ID)
+		createDBInstance.PreferredMaintenanceWindow = modifyDBInstance.PreferredMaintenanceWindow
+		createDBInstance.Tags = b.dbTags("Created", details.ServiceID, details.PlanID, instance.OrganizationGUID, instance.SpaceGUID, instance.Context)
+		if err := b.dbInstance.Create(b.dbClusterMemberIdentifier(instance.ID, index), *createDBInstance); err != nil {
+			return err
+		}
+	}
+
+	for _, index := range indexes {
+		if index >= instanceCount {
+			if err := b.dbInstance.Delete(b.dbClusterMemberIdentifier(instance.ID, index), true); err != nil && err != awsrds.ErrDBInstanceDoesNotExist {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,20 +1,310 @@
 package rdsbroker
 
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+)
+
+const (
+	parameterTypeBoolean = "boolean"
+	parameterTypeInteger = "integer"
+	parameterTypeString  = "string"
+)
+
+const invalidParametersLoggerAction = "invalid-parameters"
+
+// States users can set with the state update parameter
+const (
+	dbStateRunning = "running"
+	dbStateStopped = "stopped"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-04/schema#"
+
+var (
+	provisionParameterDefinitions = parameterDefinitions(reflect.TypeOf(ProvisionParameters{}))
+	updateParameterDefinitions    = parameterDefinitions(reflect.TypeOf(UpdateParameters{}))
+	bindParameterDefinitions      = parameterDefinitions(reflect.TypeOf(BindParameters{}))
+)
+
 type ProvisionParameters struct {
-	BackupRetentionPeriod      int64  `mapstructure:"backup_retention_period"`
-	CharacterSetName           string `mapstructure:"character_set_name"`
-	DBName                     string `mapstructure:"dbname"`
-	PreferredBackupWindow      string `mapstructure:"preferred_backup_window"`
-	PreferredMaintenanceWindow string `mapstructure:"preferred_maintenance_window"`
+	BackupRetentionPeriod      int64  `mapstructure:"backup_retention_period" description:"The number of days that Amazon RDS should retain automatic backups of the DB instance"`
+	CharacterSetName           string `mapstructure:"character_set_name" description:"The CharacterSet the DB instance should be associated with"`
+	DBName                     string `mapstructure:"dbname" description:"The name of the Database to be provisioned"`
+	PreferredBackupWindow      string `mapstructure:"preferred_backup_window" description:"The daily time range during which automated backups are created"`
+	PreferredMaintenanceWindow string `mapstructure:"preferred_maintenance_window" description:"The weekly time range during which system maintenance can occur"`
+	ReadReplicaOf              string `mapstructure:"read_replica_of" description:"The ID of the service instance to create a read replica of"`
+	RestoreFromSnapshot        string `mapstructure:"restore_from_snapshot" description:"The identifier of a DB snapshot to restore the DB instance from"`
+	RestoreTime                string `mapstructure:"restore_time" description:"The date and time (RFC 3339) to restore the source service instance to"`
+	SourceInstanceID           string `mapstructure:"source_instance_id" description:"The ID of a service instance to restore the DB instance from"`
+	RDSParameters              `mapstructure:",squash"`
+	ScalingParameters          `mapstructure:",squash"`
 }
 
 type UpdateParameters struct {
-	ApplyImmediately           bool   `mapstructure:"apply_immediately"`
-	BackupRetentionPeriod      int64  `mapstructure:"backup_retention_period"`
-	PreferredBackupWindow      string `mapstructure:"preferred_backup_window"`
-	PreferredMaintenanceWindow string `mapstructure:"preferred_maintenance_window"`
+	ApplyImmediately           bool   `mapstructure:"apply_immediately" description:"Apply the modifications as soon as possible, regardless of the maintenance window"`
+	BackupRetentionPeriod      int64  `mapstructure:"backup_retention_period" description:"The number of days that Amazon RDS should retain automatic backups of the DB instance"`
+	PreferredBackupWindow      string `mapstructure:"preferred_backup_window" description:"The daily time range during which automated backups are created"`
+	PreferredMaintenanceWindow string `mapstructure:"preferred_maintenance_window" description:"The weekly time range during which system maintenance can occur"`
+	RotateMasterPassword       bool   `mapstructure:"rotate_master_password" description:"Generate a new random master password for the DB instance"`
+	State                      string `mapstructure:"state" description:"Stop or start the DB instance" enum:"running,stopped"`
+	RDSParameters              `mapstructure:",squash"`
+	ScalingParameters          `mapstructure:",squash"`
+}
+
+// RDSParameters override the RDS Properties of the Service Plan
+type RDSParameters struct {
+	AllocatedStorage            int64  `mapstructure:"allocated_storage" description:"The amount of storage (in gigabytes) to be allocated for the DB instance"`
+	AutoMinorVersionUpgrade     *bool  `mapstructure:"auto_minor_version_upgrade" description:"Enable or disable automatic upgrades to new minor versions"`
+	CopyTagsToSnapshot          *bool  `mapstructure:"copy_tags_to_snapshot" description:"Enable or disable copying all tags from the DB instance to snapshots"`
+	DBClusterParameterGroupName string `mapstructure:"db_cluster_parameter_group_name" description:"The DB cluster parameter group name to apply to the DB cluster"`
+	DBInstanceClass             string `mapstructure:"db_instance_class" description:"The name of the DB Instance Class"`
+	DBParameterGroupName        string `mapstructure:"db_parameter_group_name" description:"The DB parameter group name to apply to the DB instance"`
+	EngineVersion               string `mapstructure:"engine_version" description:"The version number of the Database Engine"`
+	Iops                        int64  `mapstructure:"iops" description:"The amount of Provisioned IOPS to be allocated for the DB instance"`
+	MultiAZ                     *bool  `mapstructure:"multi_az" description:"Enable or disable Multi-AZ deployment for the DB instance"`
+	OptionGroupName             string `mapstructure:"option_group_name" description:"The DB option group name to apply to the DB instance"`
+	PubliclyAccessible          *bool  `mapstructure:"publicly_accessible" description:"Specify if the DB instance will be publicly accessible"`
+	StorageType                 string `mapstructure:"storage_type" description:"The storage type to be associated with the DB instance"`
+}
+
+// ScalingParameters override the capacity range of Aurora Serverless Service Plans
+type ScalingParameters struct {
+	MinCapacity           int64 `mapstructure:"min_capacity" description:"The minimum capacity (in Aurora capacity units) of the DB cluster"`
+	MaxCapacity           int64 `mapstructure:"max_capacity" description:"The maximum capacity (in Aurora capacity units) of the DB cluster"`
+	AutoPause             *bool `mapstructure:"auto_pause" description:"Allow automatically pausing the DB cluster when it has no connections"`
+	SecondsUntilAutoPause int64 `mapstructure:"seconds_until_auto_pause" description:"The time, in seconds, before an idle DB cluster is paused"`
 }
 
 type BindParameters struct {
-	DBName string `mapstructure:"dbname"`
+	DBName string `mapstructure:"dbname" description:"The name of the Database to bind the application to"`
+	Role   string `mapstructure:"role" description:"The privileges granted to the binding user over the Database"`
+}
+
+// parameterDefinition describes a parameter users can send, as declared by the fields of the parameters structs
+type parameterDefinition struct {
+	Type        string
+	Description string
+	// Enum lists the only values the broker supports, that Service Plans can restrict further
+	Enum []string
+}
+
+// ParameterConstraints restrict the values users can set for a parameter allowed by a Service Plan
+type ParameterConstraints struct {
+	Min  *int64   `json:"min,omitempty"`
+	Max  *int64   `json:"max,omitempty"`
+	Enum []string `json:"enum,omitempty"`
+}
+
+// supports returns whether the broker supports a value of the parameter
+func (d parameterDefinition) supports(value string) bool {
+	if len(d.Enum) == 0 {
+		return true
+	}
+
+	for _, supportedValue := range d.Enum {
+		if value == supportedValue {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p RDSParameters) apply(rdsProperties RDSProperties) RDSProperties {
+	if p.AllocatedStorage > 0 {
+		rdsProperties.AllocatedStorage = p.AllocatedStorage
+	}
+
+	if p.AutoMinorVersionUpgrade != nil {
+		rdsProperties.AutoMinorVersionUpgrade = *p.AutoMinorVersionUpgrade
+	}
+
+	if p.CopyTagsToSnapshot != nil {
+		rdsProperties.CopyTagsToSnapshot = *p.CopyTagsToSnapshot
+	}
+
+	if p.DBClusterParameterGroupName != "" {
+		rdsProperties.DBClusterParameterGroupName = p.DBClusterParameterGroupName
+	}
+
+	if p.DBInstanceClass != "" {
+		rdsProperties.DBInstanceClass = p.DBInstanceClass
+	}
+
+	if p.DBParameterGroupName != "" {
+		rdsProperties.DBParameterGroupName = p.DBParameterGroupName
+	}
+
+	if p.EngineVersion != "" {
+		rdsProperties.EngineVersion = p.EngineVersion
+	}
+
+	if p.Iops > 0 {
+		rdsProperties.Iops = p.Iops
+	}
+
+	if p.MultiAZ != nil {
+		rdsProperties.MultiAZ = *p.MultiAZ
+	}
+
+	if p.OptionGroupName != "" {
+		rdsProperties.OptionGroupName = p.OptionGroupName
+	}
+
+	if p.PubliclyAccessible != nil {
+		rdsProperties.PubliclyAccessible = *p.PubliclyAccessible
+	}
+
+	if p.StorageType != "" {
+		rdsProperties.StorageType = p.StorageType
+	}
+
+	return rdsProperties
+}
+
+func (pc ParameterConstraints) Validate(parameterType string) error {
+	if (pc.Min != nil || pc.Max != nil) && parameterType != parameterTypeInteger {
+		return errors.New("Min and Max are only supported by integer parameters")
+	}
+
+	if pc.Min != nil && pc.Max != nil && *pc.Min > *pc.Max {
+		return fmt.Errorf("Min %d must not be greater than Max %d", *pc.Min, *pc.Max)
+	}
+
+	if len(pc.Enum) > 0 && parameterType != parameterTypeString {
+		return errors.New("Enum is only supported by string parameters")
+	}
+
+	return nil
+}
+
+// decodeParameters validates the user parameters against the JSON Schema of a Service Plan before decoding them.
+// Errors are returned as 400 failure responses, as they are caused by the request
+func decodeParameters(parameters map[string]interface{}, schema map[string]interface{}, result interface{}) error {
+	if err := validateParameters(parameters, schema); err != nil {
+		return invalidParametersError(err)
+	}
+
+	if err := mapstructure.Decode(parameters, result); err != nil {
+		return invalidParametersError(err)
+	}
+
+	return nil
+}
+
+func validateParameters(parameters map[string]interface{}, schema map[string]interface{}) error {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewGoLoader(schema), gojsonschema.NewGoLoader(parameters))
+	if err != nil {
+		return err
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	messages := []string{}
+	for _, resultError := range result.Errors() {
+		messages = append(messages, resultError.String())
+	}
+	sort.Strings(messages)
+
+	return fmt.Errorf("Parameters are not valid: %s", strings.Join(messages, "; "))
+}
+
+func invalidParametersError(err error) error {
+	return brokerapi.NewFailureResponse(err, http.StatusBadRequest, invalidParametersLoggerAction)
+}
+
+// parametersSchema builds the JSON Schema of the parameters allowed by a Service Plan, so no other parameter is accepted
+func parametersSchema(allowedParameters map[string]ParameterConstraints, definitions map[string]parameterDefinition) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for name, parameterConstraints := range allowedParameters {
+		definition := definitions[name]
+
+		property := map[string]interface{}{
+			"type": definition.Type,
+		}
+
+		if definition.Description != "" {
+			property["description"] = definition.Description
+		}
+
+		if parameterConstraints.Min != nil {
+			property["minimum"] = *parameterConstraints.Min
+		}
+
+		if parameterConstraints.Max != nil {
+			property["maximum"] = *parameterConstraints.Max
+		}
+
+		if len(parameterConstraints.Enum) > 0 {
+			property["enum"] = parameterConstraints.Enum
+		} else if len(definition.Enum) > 0 {
+			property["enum"] = definition.Enum
+		}
+
+		properties[name] = property
+	}
+
+	return map[string]interface{}{
+		"$schema":              jsonSchemaDraft,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// parameterDefinitions maps the mapstructure names of the fields of a parameters struct to their JSON types and descriptions
+func parameterDefinitions(parametersType reflect.Type) map[string]parameterDefinition {
+	definitions := make(map[string]parameterDefinition)
+
+	for i := 0; i < parametersType.NumField(); i++ {
+		field := parametersType.Field(i)
+		name := field.Tag.Get("mapstructure")
+
+		if name == ",squash" {
+			for embeddedName, embeddedDefinition := range parameterDefinitions(field.Type) {
+				definitions[embeddedName] = embeddedDefinition
+			}
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		definition := parameterDefinition{Description: field.Tag.Get("description")}
+		if enum := field.Tag.Get("enum"); enum != "" {
+			definition.Enum = strings.Split(enum, ",")
+		}
+		switch fieldType.Kind() {
+		case reflect.Bool:
+			definition.Type = parameterTypeBoolean
+		case reflect.Int, reflect.Int64:
+			definition.Type = parameterTypeInteger
+		case reflect.String:
+			definition.Type = parameterTypeString
+		default:
+			continue
+		}
+
+		definitions[name] = definition
+	}
+
+	return definitions
 }
@@ -0,0 +1,98 @@
+package rdsbroker
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/brokerapi"
+)
+
+// rdsStatus describes how the status of a DB Instance or DB Cluster is reported to the platform
+type rdsStatus struct {
+	Name        string
+	State       string
+	Description string
+	// Ambiguous statuses do not tell whether the DB is usable, so their state can be set with the status policy
+	Ambiguous bool
+}
+
+// rdsStatuses lists the DB Instance and DB Cluster statuses documented by AWS
+var rdsStatuses = map[string]rdsStatus{
+	"available":                           {State: brokerapi.LastOperationSucceeded, Description: "healthy and available"},
+	"backing-up":                          {State: brokerapi.LastOperationInProgress, Description: "being backed up"},
+	"backtracking":                        {State: brokerapi.LastOperationInProgress, Description: "being backtracked"},
+	"cloning-failed":                      {State: brokerapi.LastOperationFailed, Description: "cloning failed"},
+	"configuring-enhanced-monitoring":     {State: brokerapi.LastOperationInProgress, Description: "enabling or disabling Enhanced Monitoring"},
+	"configuring-iam-database-auth":       {State: brokerapi.LastOperationInProgress, Description: "enabling or disabling IAM database authentication"},
+	"configuring-log-exports":             {State: brokerapi.LastOperationInProgress, Description: "enabling or disabling the publication of log files to CloudWatch Logs"},
+	"converting-to-vpc":                   {State: brokerapi.LastOperationInProgress, Description: "being converted to a VPC DB instance"},
+	"creating":                            {State: brokerapi.LastOperationInProgress, Description: "being created"},
+	"delete-precheck":                     {State: brokerapi.LastOperationInProgress, Description: "validating that its read replicas are safe to delete"},
+	"deleting":                            {State: brokerapi.LastOperationInProgress, Description: "being deleted"},
+	"failed":                              {State: brokerapi.LastOperationFailed, Description: "failed and cannot be recovered by RDS"},
+	"failing-over":                        {State: brokerapi.LastOperationInProgress, Description: "failing over to a standby"},
+	"inaccessible-encryption-credentials": {State: brokerapi.LastOperationFailed, Description: "its KMS encryption key cannot be accessed", Ambiguous: true},
+	"incompatible-network":                {State: brokerapi.LastOperationFailed, Description: "its network configuration prevents RDS from recovering it"},
+	"incompatible-option-group":           {State: brokerapi.LastOperationFailed, Description: "its option group cannot be applied"},
+	"incompatible-parameters":             {State: brokerapi.LastOperationFailed, Description: "its parameter group has incompatible parameters"},
+	"incompatible-restore":                {State: brokerapi.LastOperationFailed, Description: "it cannot be restored to a point in time"},
+	"insufficient-capacity":               {State: brokerapi.LastOperationFailed, Description: "RDS does not have enough capacity to create it"},
+	"maintenance":                         {State: brokerapi.LastOperationInProgress, Description: "applying a maintenance update"},
+	"migrating":                           {State: brokerapi.LastOperationInProgress, Description: "being migrated"},
+	"migration-failed":                    {State: brokerapi.LastOperationFailed, Description: "the migration failed"},
+	"modifying":                           {State: brokerapi.LastOperationInProgress, Description: "being modified"},
+	"moving-to-vpc":                       {State: brokerapi.LastOperationInProgress, Description: "being moved to a new VPC"},
+	"preparing-data-migration":            {State: brokerapi.LastOperationInProgress, Description: "preparing a data migration"},
+	"promoting":                           {State: brokerapi.LastOperationInProgress, Description: "being promoted"},
+	"rebooting":                           {State: brokerapi.LastOperationInProgress, Description: "being rebooted"},
+	"renaming":                            {State: brokerapi.LastOperationInProgress, Description: "being renamed"},
+	"resetting-master-credentials":        {State: brokerapi.LastOperationInProgress, Description: "resetting its master credentials"},
+	"restore-error":                       {State: brokerapi.LastOperationFailed, Description: "an error occurred while restoring it"},
+	"starting":                            {State: brokerapi.LastOperationInProgress, Description: "being started"},
+	"stopped":                             {State: brokerapi.LastOperationFailed, Description: "stopped and not accepting connections", Ambiguous: true},
+	"stopping":                            {State: brokerapi.LastOperationInProgress, Description: "being stopped"},
+	"storage-full":                        {State: brokerapi.LastOperationFailed, Description: "it has reached its storage capacity", Ambiguous: true},
+	"storage-optimization":                {State: brokerapi.LastOperationSucceeded, Description: "available while RDS optimizes its storage", Ambiguous: true},
+	"update-iam-db-auth":                  {State: brokerapi.LastOperationInProgress, Description: "updating IAM database authentication"},
+	"upgrading":                           {State: brokerapi.LastOperationInProgress, Description: "upgrading its engine version"},
+}
+
+// rdsStatus2State returns how a DB Instance or DB Cluster status is reported, with the state of ambiguous statuses
+// overridden by the status policy. Unknown statuses are reported as failed
+func rdsStatus2State(name string, statusPolicy map[string]string) (rdsStatus, bool) {
+	status, ok := rdsStatuses[name]
+	if !ok {
+		return rdsStatus{
+			Name:        name,
+			State:       brokerapi.LastOperationFailed,
+			Description: "unknown status",
+		}, false
+	}
+
+	status.Name = name
+	if state, ok := statusPolicy[name]; ok && status.Ambiguous {
+		status.State = state
+	}
+
+	return status, true
+}
+
+// describe returns the human readable status of a DB Instance or DB Cluster, like "DB Instance 'id' status is 'creating' (being created)"
+func (s rdsStatus) describe(resource string, identifier string) string {
+	return fmt.Sprintf("%s '%s' status is '%s' (%s)", resource, identifier, s.Name, s.Description)
+}
+
+func validateStatusPolicy(statusPolicy map[string]string) error {
+	for name, state := range statusPolicy {
+		if status, ok := rdsStatuses[name]; !ok || !status.Ambiguous {
+			return fmt.Errorf("RDS status '%s' is not an ambiguous status", name)
+		}
+
+		switch state {
+		case brokerapi.LastOperationSucceeded, brokerapi.LastOperationInProgress, brokerapi.LastOperationFailed:
+		default:
+			return fmt.Errorf("Invalid state '%s' for RDS status '%s': must be '%s', '%s' or '%s'", state, name, brokerapi.LastOperationSucceeded, brokerapi.LastOperationInProgress, brokerapi.LastOperationFailed)
+		}
+	}
+
+	return nil
+}
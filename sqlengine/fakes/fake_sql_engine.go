@@ -32,18 +32,37 @@ type FakeSQLEngine struct {
 	CreateUserPassword string
 	CreateUserError    error
 
+	AlterUserPasswordCalled    bool
+	AlterUserPasswordUsername  string
+	AlterUserPasswordPassword  string
+	AlterUserPasswordPasswords []string
+	AlterUserPasswordError     error
+
 	DropUserCalled   bool
 	DropUserUsername string
 	DropUserError    error
 
+	CreateOwnerRoleCalled   bool
+	CreateOwnerRoleDBName   string
+	CreateOwnerRoleRolename string
+	CreateOwnerRoleError    error
+
+	ReassignOwnershipCalled   bool
+	ReassignOwnershipDBNames  []string
+	ReassignOwnershipUsername string
+	ReassignOwnershipRolename string
+	ReassignOwnershipError    error
+
 	PrivilegesCalled     bool
 	PrivilegesPrivileges map[string][]string
 	PrivilegesError      error
 
-	GrantPrivilegesCalled   bool
-	GrantPrivilegesDBName   string
-	GrantPrivilegesUsername string
-	GrantPrivilegesError    error
+	GrantPrivilegesCalled    bool
+	GrantPrivilegesDBName    string
+	GrantPrivilegesUsername  string
+	GrantPrivilegesRole      string
+	GrantPrivilegesOwnerRole string
+	GrantPrivilegesError     error
 
 	RevokePrivilegesCalled   bool
 	RevokePrivilegesDBName   string
@@ -95,6 +114,15 @@ func (f *FakeSQLEngine) CreateUser(username string, password string) error {
 	return f.CreateUserError
 }
 
+func (f *FakeSQLEngine) AlterUserPassword(username string, password string) error {
+	f.AlterUserPasswordCalled = true
+	f.AlterUserPasswordUsername = username
+	f.AlterUserPasswordPassword = password
+	f.AlterUserPasswordPasswords = append(f.AlterUserPasswordPasswords, password)
+
+	return f.AlterUserPasswordError
+}
+
 func (f *FakeSQLEngine) DropUser(username string) error {
 	f.DropUserCalled = true
 	f.DropUserUsername = username
@@ -102,16 +130,35 @@ func (f *FakeSQLEngine) DropUser(username string) error {
 	return f.DropUserError
 }
 
+func (f *FakeSQLEngine) CreateOwnerRole(dbname string, rolename string) error {
+	f.CreateOwnerRoleCalled = true
+	f.CreateOwnerRoleDBName = dbname
+	f.CreateOwnerRoleRolename = rolename
+
+	return f.CreateOwnerRoleError
+}
+
+func (f *FakeSQLEngine) ReassignOwnership(dbname string, username string, rolename string) error {
+	f.ReassignOwnershipCalled = true
+	f.ReassignOwnershipDBNames = append(f.ReassignOwnershipDBNames, dbname)
+	f.ReassignOwnershipUsername = username
+	f.ReassignOwnershipRolename = rolename
+
+	return f.ReassignOwnershipError
+}
+
 func (f *FakeSQLEngine) Privileges() (map[string][]string, error) {
 	f.PrivilegesCalled = true
 
 	return f.PrivilegesPrivileges, f.PrivilegesError
 }
 
-func (f *FakeSQLEngine) GrantPrivileges(dbname string, username string) error {
+func (f *FakeSQLEngine) GrantPrivileges(dbname string, username string, role string, ownerRole string) error {
 	f.GrantPrivilegesCalled = true
 	f.GrantPrivilegesDBName = dbname
 	f.GrantPrivilegesUsername = username
+	f.GrantPrivilegesRole = role
+	f.GrantPrivilegesOwnerRole = ownerRole
 
 	return f.GrantPrivilegesError
 }
@@ -3,6 +3,8 @@ package sqlengine
 import (
 	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
 
 	_ "github.com/go-sql-driver/mysql" // MySQL Driver
 
@@ -22,7 +24,7 @@ func NewMySQLEngine(logger lager.Logger) *MySQLEngine {
 
 func (d *MySQLEngine) Open(address string, port int64, dbname string, username string, password string) error {
 	connectionString := d.connectionString(address, port, dbname, username, password)
-	d.logger.Debug("sql-open", lager.Data{"connection-string": connectionString})
+	d.logger.Debug("sql-open", lager.Data{"address": address, "port": port, "dbname": dbname, "username": username})
 
 	db, err := sql.Open("mysql", connectionString)
 	if err != nil {
@@ -41,11 +43,11 @@ func (d *MySQLEngine) Close() {
 }
 
 func (d *MySQLEngine) ExistsDB(dbname string) (bool, error) {
-	selectDatabaseStatement := "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = '" + dbname + "'"
-	d.logger.Debug("database-exists", lager.Data{"statement": selectDatabaseStatement})
+	selectDatabaseStatement := "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = ?"
+	d.logger.Debug("database-exists", lager.Data{"statement": selectDatabaseStatement, "dbname": dbname})
 
 	var dummy string
-	err := d.db.QueryRow(selectDatabaseStatement).Scan(&dummy)
+	err := d.db.QueryRow(selectDatabaseStatement, dbname).Scan(&dummy)
 	switch {
 	case err == sql.ErrNoRows:
 		return false, nil
@@ -65,7 +67,7 @@ func (d *MySQLEngine) CreateDB(dbname string) error {
 		return nil
 	}
 
-	createDBStatement := "CREATE DATABASE IF NOT EXISTS " + dbname
+	createDBStatement := "CREATE DATABASE IF NOT EXISTS " + d.QuoteIdentifier(dbname)
 	d.logger.Debug("create-database", lager.Data{"statement": createDBStatement})
 
 	if _, err := d.db.Exec(createDBStatement); err != nil {
@@ -77,7 +79,7 @@ func (d *MySQLEngine) CreateDB(dbname string) error {
 }
 
 func (d *MySQLEngine) DropDB(dbname string) error {
-	dropDBStatement := "DROP DATABASE IF EXISTS " + dbname
+	dropDBStatement := "DROP DATABASE IF EXISTS " + d.QuoteIdentifier(dbname)
 	d.logger.Debug("drop-database", lager.Data{"statement": dropDBStatement})
 
 	if _, err := d.db.Exec(dropDBStatement); err != nil {
@@ -89,8 +91,8 @@ func (d *MySQLEngine) DropDB(dbname string) error {
 }
 
 func (d *MySQLEngine) CreateUser(username string, password string) error {
-	createUserStatement := "CREATE USER '" + username + "' IDENTIFIED BY '" + password + "'"
-	d.logger.Debug("create-user", lager.Data{"statement": createUserStatement})
+	createUserStatement := "CREATE USER " + d.userAccount(username) + " IDENTIFIED BY " + d.QuoteLiteral(password)
+	d.logger.Debug("create-user", lager.Data{"username": username})
 
 	if _, err := d.db.Exec(createUserStatement); err != nil {
 		d.logger.Error("sql-error", err)
@@ -100,8 +102,26 @@ func (d *MySQLEngine) CreateUser(username string, password string) error {
 	return nil
 }
 
+func (d *MySQLEngine) AlterUserPassword(username string, password string) error {
+	var serverVersion string
+	if err := d.db.QueryRow("SELECT VERSION()").Scan(&serverVersion); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	alterUserPasswordStatement := d.AlterUserPasswordStatement(username, password, serverVersion)
+	d.logger.Debug("alter-user-password", lager.Data{"username": username, "server-version": serverVersion})
+
+	if _, err := d.db.Exec(alterUserPasswordStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (d *MySQLEngine) DropUser(username string) error {
-	dropUserStatement := "DROP USER '" + username + "'@'%'"
+	dropUserStatement := "DROP USER " + d.userAccount(username)
 	d.logger.Debug("drop-user", lager.Data{"statement": dropUserStatement})
 
 	if _, err := d.db.Exec(dropUserStatement); err != nil {
@@ -112,6 +132,18 @@ func (d *MySQLEngine) DropUser(username string) error {
 	return nil
 }
 
+func (d *MySQLEngine) CreateOwnerRole(dbname string, rolename string) error {
+	// For MySQL objects are not owned by users, so there is no need for an owner role
+
+	return nil
+}
+
+func (d *MySQLEngine) ReassignOwnership(dbname string, username string, rolename string) error {
+	// For MySQL objects are not owned by users, so there is nothing to reassign
+
+	return nil
+}
+
 func (d *MySQLEngine) Privileges() (map[string][]string, error) {
 	privileges := make(map[string][]string)
 
@@ -148,8 +180,11 @@ func (d *MySQLEngine) Privileges() (map[string][]string, error) {
 	return privileges, nil
 }
 
-func (d *MySQLEngine) GrantPrivileges(dbname string, username string) error {
-	grantPrivilegesStatement := "GRANT ALL PRIVILEGES ON " + dbname + ".* TO '" + username + "'@'%'"
+func (d *MySQLEngine) GrantPrivileges(dbname string, username string, role string, ownerRole string) error {
+	grantPrivilegesStatement, err := d.GrantPrivilegesStatement(dbname, username, role)
+	if err != nil {
+		return err
+	}
 	d.logger.Debug("grant-privileges", lager.Data{"statement": grantPrivilegesStatement})
 
 	if _, err := d.db.Exec(grantPrivilegesStatement); err != nil {
@@ -161,7 +196,7 @@ func (d *MySQLEngine) GrantPrivileges(dbname string, username string) error {
 }
 
 func (d *MySQLEngine) RevokePrivileges(dbname string, username string) error {
-	revokePrivilegesStatement := "REVOKE ALL PRIVILEGES ON " + dbname + ".* from '" + username + "'@'%'"
+	revokePrivilegesStatement := "REVOKE ALL PRIVILEGES ON " + d.QuoteIdentifier(dbname) + ".* FROM " + d.userAccount(username)
 	d.logger.Debug("revoke-privileges", lager.Data{"statement": revokePrivilegesStatement})
 
 	if _, err := d.db.Exec(revokePrivilegesStatement); err != nil {
@@ -172,6 +207,34 @@ func (d *MySQLEngine) RevokePrivileges(dbname string, username string) error {
 	return nil
 }
 
+func (d *MySQLEngine) GrantPrivilegesStatement(dbname string, username string, role string) (string, error) {
+	var privileges string
+	switch role {
+	case RoleReadOnly:
+		privileges = "SELECT, SHOW VIEW"
+	case RoleReadWrite:
+		privileges = "SELECT, INSERT, UPDATE, DELETE, SHOW VIEW, CREATE TEMPORARY TABLES, LOCK TABLES, EXECUTE"
+	case RoleDDL:
+		privileges = "SELECT, INSERT, UPDATE, DELETE, SHOW VIEW, CREATE TEMPORARY TABLES, LOCK TABLES, EXECUTE, CREATE, ALTER, DROP, INDEX, REFERENCES, CREATE VIEW"
+	case RoleOwner:
+		privileges = "ALL PRIVILEGES"
+	default:
+		return "", ValidateRole(role)
+	}
+
+	return "GRANT " + privileges + " ON " + d.QuoteIdentifier(dbname) + ".* TO " + d.userAccount(username), nil
+}
+
+// AlterUserPasswordStatement uses ALTER USER, as SET PASSWORD ... = PASSWORD() was removed in MySQL 8.0,
+// except on servers that do not support it yet (MySQL before 5.7.6, Aurora MySQL 1.x and MariaDB before 10.2).
+func (d *MySQLEngine) AlterUserPasswordStatement(username string, password string, serverVersion string) string {
+	if !supportsAlterUser(serverVersion) {
+		return "SET PASSWORD FOR " + d.userAccount(username) + " = PASSWORD(" + d.QuoteLiteral(password) + ")"
+	}
+
+	return "ALTER USER " + d.userAccount(username) + " IDENTIFIED BY " + d.QuoteLiteral(password)
+}
+
 func (d *MySQLEngine) URI(address string, port int64, dbname string, username string, password string) string {
 	return fmt.Sprintf("mysql://%s:%s@%s:%d/%s?reconnect=true", username, password, address, port, dbname)
 }
@@ -180,6 +243,41 @@ func (d *MySQLEngine) JDBCURI(address string, port int64, dbname string, usernam
 	return fmt.Sprintf("jdbc:mysql://%s:%d/%s?user=%s&password=%s", address, port, dbname, username, password)
 }
 
+func (d *MySQLEngine) QuoteIdentifier(identifier string) string {
+	return "`" + strings.Replace(identifier, "`", "``", -1) + "`"
+}
+
+func (d *MySQLEngine) QuoteLiteral(literal string) string {
+	literal = strings.Replace(literal, `\`, `\\`, -1)
+	literal = strings.Replace(literal, `'`, `''`, -1)
+	return "'" + literal + "'"
+}
+
+func (d *MySQLEngine) userAccount(username string) string {
+	return d.QuoteLiteral(username) + "@'%'"
+}
+
 func (d *MySQLEngine) connectionString(address string, port int64, dbname string, username string, password string) string {
 	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", username, password, address, port, dbname)
 }
+
+func supportsAlterUser(serverVersion string) bool {
+	minimumVersion := []int{5, 7, 6}
+	if strings.Contains(serverVersion, "MariaDB") {
+		minimumVersion = []int{10, 2, 0}
+	}
+
+	// Versions look like "5.6.10", "8.0.16-log" or "10.3.13-MariaDB-log"
+	version := strings.SplitN(serverVersion, "-", 2)[0]
+	for i, part := range strings.SplitN(version, ".", 3) {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return true
+		}
+		if number != minimumVersion[i] {
+			return number > minimumVersion[i]
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,88 @@
+package sqlengine_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
+
+	"github.com/pivotal-golang/lager"
+)
+
+var _ = Describe("MySQL Engine", func() {
+	var (
+		mysqlEngine *MySQLEngine
+	)
+
+	BeforeEach(func() {
+		mysqlEngine = NewMySQLEngine(lager.NewLogger("mysql_engine_test"))
+	})
+
+	Describe("QuoteIdentifier", func() {
+		It("quotes identifiers with backticks", func() {
+			Expect(mysqlEngine.QuoteIdentifier("test_db")).To(Equal("`test_db`"))
+		})
+
+		It("escapes backticks", func() {
+			Expect(mysqlEngine.QuoteIdentifier("db`; DROP DATABASE mysql; --")).To(Equal("`db``; DROP DATABASE mysql; --`"))
+		})
+	})
+
+	Describe("QuoteLiteral", func() {
+		It("quotes literals with single quotes", func() {
+			Expect(mysqlEngine.QuoteLiteral("password")).To(Equal("'password'"))
+		})
+
+		It("escapes single quotes and backslashes", func() {
+			Expect(mysqlEngine.QuoteLiteral(`pass'word\`)).To(Equal(`'pass''word\\'`))
+		})
+	})
+
+	Describe("AlterUserPasswordStatement", func() {
+		It("uses ALTER USER", func() {
+			Expect(mysqlEngine.AlterUserPasswordStatement("user", "pass'word", "8.0.16")).To(Equal("ALTER USER 'user'@'%' IDENTIFIED BY 'pass''word'"))
+			Expect(mysqlEngine.AlterUserPasswordStatement("user", "pass'word", "5.7.6-log")).To(Equal("ALTER USER 'user'@'%' IDENTIFIED BY 'pass''word'"))
+			Expect(mysqlEngine.AlterUserPasswordStatement("user", "pass'word", "10.3.13-MariaDB-log")).To(Equal("ALTER USER 'user'@'%' IDENTIFIED BY 'pass''word'"))
+		})
+
+		It("uses SET PASSWORD on servers without ALTER USER ... IDENTIFIED BY", func() {
+			Expect(mysqlEngine.AlterUserPasswordStatement("user", "pass'word", "5.6.10")).To(Equal("SET PASSWORD FOR 'user'@'%' = PASSWORD('pass''word')"))
+			Expect(mysqlEngine.AlterUserPasswordStatement("user", "pass'word", "5.7.5")).To(Equal("SET PASSWORD FOR 'user'@'%' = PASSWORD('pass''word')"))
+			Expect(mysqlEngine.AlterUserPasswordStatement("user", "pass'word", "10.1.26-MariaDB")).To(Equal("SET PASSWORD FOR 'user'@'%' = PASSWORD('pass''word')"))
+		})
+	})
+
+	Describe("GrantPrivilegesStatement", func() {
+		It("grants only SELECT for read only bindings", func() {
+			statement, err := mysqlEngine.GrantPrivilegesStatement("test_db", "user", "read_only")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statement).To(Equal("GRANT SELECT, SHOW VIEW ON `test_db`.* TO 'user'@'%'"))
+		})
+
+		It("grants data manipulation privileges for read write bindings", func() {
+			statement, err := mysqlEngine.GrantPrivilegesStatement("test_db", "user", "read_write")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statement).To(ContainSubstring("INSERT, UPDATE, DELETE"))
+			Expect(statement).ToNot(ContainSubstring("CREATE,"))
+			Expect(statement).ToNot(ContainSubstring("DROP"))
+		})
+
+		It("grants schema privileges for ddl bindings", func() {
+			statement, err := mysqlEngine.GrantPrivilegesStatement("test_db", "user", "ddl")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statement).To(ContainSubstring("CREATE, ALTER, DROP, INDEX"))
+		})
+
+		It("grants all privileges for owner bindings", func() {
+			statement, err := mysqlEngine.GrantPrivilegesStatement("test_db", "user", "owner")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statement).To(Equal("GRANT ALL PRIVILEGES ON `test_db`.* TO 'user'@'%'"))
+		})
+
+		It("returns error if the role is not valid", func() {
+			_, err := mysqlEngine.GrantPrivilegesStatement("test_db", "user", "superuser")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Role 'superuser' is not valid"))
+		})
+	})
+})
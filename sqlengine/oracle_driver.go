@@ -0,0 +1,8 @@
+//go:build oracle
+// +build oracle
+
+package sqlengine
+
+import (
+	_ "github.com/mattn/go-oci8" // Oracle Driver, requires cgo and the Oracle Instant Client
+)
@@ -0,0 +1,277 @@
+package sqlengine
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pivotal-golang/lager"
+)
+
+// The Oracle driver (github.com/mattn/go-oci8) is registered by oracle_driver.go when building with the "oracle" tag
+const oracleDriverName = "oci8"
+
+var oracleDBNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,7}$`)
+
+func ValidateOracleDBName(dbname string) error {
+	if !oracleDBNameRegexp.MatchString(dbname) {
+		return fmt.Errorf("Database name '%s' is not valid for Oracle: it must begin with a letter and contain only letters and digits (up to 8 characters)", dbname)
+	}
+
+	return nil
+}
+
+type OracleEngine struct {
+	logger lager.Logger
+	db     *sql.DB
+	dbname string
+}
+
+func NewOracleEngine(logger lager.Logger) *OracleEngine {
+	return &OracleEngine{
+		logger: logger.Session("oracle-engine"),
+	}
+}
+
+func (d *OracleEngine) Open(address string, port int64, dbname string, username string, password string) error {
+	connectionString := d.connectionString(address, port, dbname, username, password)
+	d.logger.Debug("sql-open", lager.Data{"address": address, "port": port, "dbname": dbname, "username": username})
+
+	db, err := sql.Open(oracleDriverName, connectionString)
+	if err != nil {
+		return err
+	}
+
+	d.db = db
+	d.dbname = dbname
+
+	return nil
+}
+
+func (d *OracleEngine) Close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+// An Oracle DB Instance holds a single database, so bindings cannot create additional ones
+func (d *OracleEngine) ExistsDB(dbname string) (bool, error) {
+	return dbname == d.dbname, nil
+}
+
+func (d *OracleEngine) CreateDB(dbname string) error {
+	if dbname == d.dbname {
+		return nil
+	}
+
+	return fmt.Errorf("Oracle DB Instances only hold the '%s' database, database '%s' cannot be created", d.dbname, dbname)
+}
+
+func (d *OracleEngine) DropDB(dbname string) error {
+	return fmt.Errorf("Oracle DB Instances only hold the '%s' database, database '%s' cannot be dropped", d.dbname, dbname)
+}
+
+func (d *OracleEngine) CreateUser(username string, password string) error {
+	createUserStatement := "CREATE USER " + d.QuoteIdentifier(username) + " IDENTIFIED BY " + d.QuoteIdentifier(password)
+	d.logger.Debug("create-user", lager.Data{"username": username})
+
+	if _, err := d.db.Exec(createUserStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *OracleEngine) AlterUserPassword(username string, password string) error {
+	alterUserPasswordStatement := "ALTER USER " + d.QuoteIdentifier(username) + " IDENTIFIED BY " + d.QuoteIdentifier(password)
+	d.logger.Debug("alter-user-password", lager.Data{"username": username})
+
+	if _, err := d.db.Exec(alterUserPasswordStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *OracleEngine) DropUser(username string) error {
+	ok, err := d.existsUser(username)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	// Objects created by users live in the owner schema, so anything left in their own schema is dropped with them
+	dropUserStatement := "DROP USER " + d.QuoteIdentifier(username) + " CASCADE"
+	d.logger.Debug("drop-user", lager.Data{"statement": dropUserStatement})
+
+	if _, err := d.db.Exec(dropUserStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *OracleEngine) CreateOwnerRole(dbname string, rolename string) error {
+	ok, err := d.existsUser(rolename)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	// Oracle schemas are users, so the owner role is a user nobody can log in as
+	ownerRoleStatements := d.OwnerRoleStatements(rolename)
+	d.logger.Debug("create-owner-role", lager.Data{"statements": ownerRoleStatements})
+
+	return d.exec(ownerRoleStatements)
+}
+
+func (d *OracleEngine) OwnerRoleStatements(rolename string) []string {
+	owner := d.QuoteIdentifier(rolename)
+	return []string{
+		"CREATE USER " + owner + " NO AUTHENTICATION",
+		"ALTER USER " + owner + " QUOTA UNLIMITED ON USERS",
+	}
+}
+
+func (d *OracleEngine) ReassignOwnership(dbname string, username string, rolename string) error {
+	// Objects created by users already belong to the owner schema (see GrantPrivilegesStatements)
+	return nil
+}
+
+func (d *OracleEngine) Privileges() (map[string][]string, error) {
+	privileges := make(map[string][]string)
+
+	selectPrivilegesStatement := "SELECT grantee FROM dba_sys_privs WHERE privilege = 'CREATE SESSION' AND grantee IN (SELECT username FROM dba_users WHERE oracle_maintained = 'N')"
+	d.logger.Debug("database-privileges", lager.Data{"statement": selectPrivilegesStatement})
+
+	rows, err := d.db.Query(selectPrivilegesStatement)
+	if err != nil {
+		d.logger.Error("sql-error", err)
+		return privileges, err
+	}
+	defer rows.Close()
+
+	var username string
+	for rows.Next() {
+		if err := rows.Scan(&username); err != nil {
+			d.logger.Error("sql-error", err)
+			return privileges, err
+		}
+		privileges[d.dbname] = append(privileges[d.dbname], username)
+	}
+	if err := rows.Err(); err != nil {
+		d.logger.Error("sql-error", err)
+		return privileges, err
+	}
+
+	d.logger.Debug("database-privileges", lager.Data{"output": privileges})
+
+	return privileges, nil
+}
+
+func (d *OracleEngine) GrantPrivileges(dbname string, username string, role string, ownerRole string) error {
+	grantPrivilegesStatements, err := d.GrantPrivilegesStatements(username, role, ownerRole)
+	if err != nil {
+		return err
+	}
+	d.logger.Debug("grant-privileges", lager.Data{"statements": grantPrivilegesStatements})
+
+	return d.exec(grantPrivilegesStatements)
+}
+
+func (d *OracleEngine) RevokePrivileges(dbname string, username string) error {
+	revokePrivilegesStatements := d.RevokePrivilegesStatements(username)
+	d.logger.Debug("revoke-privileges", lager.Data{"statements": revokePrivilegesStatements})
+
+	return d.exec(revokePrivilegesStatements)
+}
+
+func (d *OracleEngine) GrantPrivilegesStatements(username string, role string, ownerRole string) ([]string, error) {
+	// A DB Instance holds the data of a single service instance, so privileges are granted on ANY object
+	var privileges string
+	switch role {
+	case RoleReadOnly:
+		privileges = "CREATE SESSION, SELECT ANY TABLE, SELECT ANY SEQUENCE"
+	case RoleReadWrite:
+		privileges = "CREATE SESSION, SELECT ANY TABLE, SELECT ANY SEQUENCE, INSERT ANY TABLE, UPDATE ANY TABLE, DELETE ANY TABLE"
+	case RoleDDL, RoleOwner:
+		privileges = "CREATE SESSION, SELECT ANY TABLE, SELECT ANY SEQUENCE, INSERT ANY TABLE, UPDATE ANY TABLE, DELETE ANY TABLE, CREATE ANY TABLE, ALTER ANY TABLE, DROP ANY TABLE, CREATE ANY INDEX, ALTER ANY INDEX, DROP ANY INDEX, CREATE ANY SEQUENCE, ALTER ANY SEQUENCE, DROP ANY SEQUENCE, CREATE ANY VIEW, DROP ANY VIEW"
+	default:
+		return nil, ValidateRole(role)
+	}
+
+	// Sessions default to the owner schema, so objects created by any user are shared by all the bindings
+	user := d.QuoteIdentifier(username)
+	logonTriggerStatement := fmt.Sprintf("ALTER SESSION SET CURRENT_SCHEMA = %s", d.QuoteIdentifier(ownerRole))
+	return []string{
+		"GRANT " + privileges + " TO " + user,
+		"CREATE OR REPLACE TRIGGER " + d.logonTrigger(username) + " AFTER LOGON ON " + user + ".SCHEMA BEGIN EXECUTE IMMEDIATE " + d.QuoteLiteral(logonTriggerStatement) + "; END;",
+	}, nil
+}
+
+func (d *OracleEngine) RevokePrivilegesStatements(username string) []string {
+	return []string{
+		"BEGIN FOR p IN (SELECT privilege FROM dba_sys_privs WHERE grantee = " + d.QuoteLiteral(username) + ") LOOP EXECUTE IMMEDIATE 'REVOKE ' || p.privilege || ' FROM ' || " + d.QuoteLiteral(d.QuoteIdentifier(username)) + "; END LOOP; END;",
+		"BEGIN EXECUTE IMMEDIATE " + d.QuoteLiteral("DROP TRIGGER "+d.logonTrigger(username)) + "; EXCEPTION WHEN OTHERS THEN IF SQLCODE != -4080 THEN RAISE; END IF; END;",
+	}
+}
+
+func (d *OracleEngine) URI(address string, port int64, dbname string, username string, password string) string {
+	return fmt.Sprintf("oracle://%s:%s@%s:%d/%s", username, password, address, port, dbname)
+}
+
+func (d *OracleEngine) JDBCURI(address string, port int64, dbname string, username string, password string) string {
+	return fmt.Sprintf("jdbc:oracle:thin:%s/%s@//%s:%d/%s", username, password, address, port, dbname)
+}
+
+func (d *OracleEngine) QuoteIdentifier(identifier string) string {
+	return `"` + strings.Replace(identifier, `"`, `""`, -1) + `"`
+}
+
+func (d *OracleEngine) QuoteLiteral(literal string) string {
+	return "'" + strings.Replace(literal, "'", "''", -1) + "'"
+}
+
+func (d *OracleEngine) logonTrigger(username string) string {
+	return d.QuoteIdentifier(username + "_LOGON")
+}
+
+func (d *OracleEngine) existsUser(username string) (bool, error) {
+	selectUserStatement := "SELECT username FROM dba_users WHERE username = :1"
+	d.logger.Debug("user-exists", lager.Data{"statement": selectUserStatement, "username": username})
+
+	var dummy string
+	err := d.db.QueryRow(selectUserStatement, username).Scan(&dummy)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		d.logger.Error("sql-error", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (d *OracleEngine) exec(statements []string) error {
+	for _, statement := range statements {
+		if _, err := d.db.Exec(statement); err != nil {
+			d.logger.Error("sql-error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *OracleEngine) connectionString(address string, port int64, dbname string, username string, password string) string {
+	return fmt.Sprintf("%s/%s@%s:%d/%s", username, password, address, port, dbname)
+}
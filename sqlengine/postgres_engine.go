@@ -3,15 +3,23 @@ package sqlengine
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
-	_ "github.com/lib/pq" // PostgreSQL Driver
+	"github.com/lib/pq" // PostgreSQL Driver
 
 	"github.com/pivotal-golang/lager"
 )
 
+const duplicateObjectErrorCode = "42710"
+
 type PostgresEngine struct {
-	logger lager.Logger
-	db     *sql.DB
+	logger   lager.Logger
+	db       *sql.DB
+	address  string
+	port     int64
+	dbname   string
+	username string
+	password string
 }
 
 func NewPostgresEngine(logger lager.Logger) *PostgresEngine {
@@ -22,7 +30,7 @@ func NewPostgresEngine(logger lager.Logger) *PostgresEngine {
 
 func (d *PostgresEngine) Open(address string, port int64, dbname string, username string, password string) error {
 	connectionString := d.connectionString(address, port, dbname, username, password)
-	d.logger.Debug("sql-open", lager.Data{"connection-string": connectionString})
+	d.logger.Debug("sql-open", lager.Data{"address": address, "port": port, "dbname": dbname, "username": username})
 
 	db, err := sql.Open("postgres", connectionString)
 	if err != nil {
@@ -30,6 +38,11 @@ func (d *PostgresEngine) Open(address string, port int64, dbname string, usernam
 	}
 
 	d.db = db
+	d.address = address
+	d.port = port
+	d.dbname = dbname
+	d.username = username
+	d.password = password
 
 	return nil
 }
@@ -41,11 +54,11 @@ func (d *PostgresEngine) Close() {
 }
 
 func (d *PostgresEngine) ExistsDB(dbname string) (bool, error) {
-	selectDatabaseStatement := "SELECT datname FROM pg_database WHERE datname='" + dbname + "'"
-	d.logger.Debug("database-exists", lager.Data{"statement": selectDatabaseStatement})
+	selectDatabaseStatement := "SELECT datname FROM pg_database WHERE datname = $1"
+	d.logger.Debug("database-exists", lager.Data{"statement": selectDatabaseStatement, "dbname": dbname})
 
 	var dummy string
-	err := d.db.QueryRow(selectDatabaseStatement).Scan(&dummy)
+	err := d.db.QueryRow(selectDatabaseStatement, dbname).Scan(&dummy)
 	switch {
 	case err == sql.ErrNoRows:
 		return false, nil
@@ -65,7 +78,7 @@ func (d *PostgresEngine) CreateDB(dbname string) error {
 		return nil
 	}
 
-	createDBStatement := "CREATE DATABASE \"" + dbname + "\""
+	createDBStatement := "CREATE DATABASE " + d.QuoteIdentifier(dbname)
 	d.logger.Debug("create-database", lager.Data{"statement": createDBStatement})
 
 	if _, err := d.db.Exec(createDBStatement); err != nil {
@@ -81,7 +94,7 @@ func (d *PostgresEngine) DropDB(dbname string) error {
 		return err
 	}
 
-	dropDBStatement := "DROP DATABASE IF EXISTS \"" + dbname + "\""
+	dropDBStatement := "DROP DATABASE IF EXISTS " + d.QuoteIdentifier(dbname)
 	d.logger.Debug("drop-database", lager.Data{"statement": dropDBStatement})
 
 	if _, err := d.db.Exec(dropDBStatement); err != nil {
@@ -93,8 +106,8 @@ func (d *PostgresEngine) DropDB(dbname string) error {
 }
 
 func (d *PostgresEngine) CreateUser(username string, password string) error {
-	createUserStatement := "CREATE USER \"" + username + "\" WITH PASSWORD '" + password + "'"
-	d.logger.Debug("create-user", lager.Data{"statement": createUserStatement})
+	createUserStatement := "CREATE USER " + d.QuoteIdentifier(username) + " WITH PASSWORD " + d.QuoteLiteral(password)
+	d.logger.Debug("create-user", lager.Data{"username": username})
 
 	if _, err := d.db.Exec(createUserStatement); err != nil {
 		d.logger.Error("sql-error", err)
@@ -104,12 +117,100 @@ func (d *PostgresEngine) CreateUser(username string, password string) error {
 	return nil
 }
 
+func (d *PostgresEngine) AlterUserPassword(username string, password string) error {
+	alterUserPasswordStatement := "ALTER USER " + d.QuoteIdentifier(username) + " WITH PASSWORD " + d.QuoteLiteral(password)
+	d.logger.Debug("alter-user-password", lager.Data{"username": username})
+
+	if _, err := d.db.Exec(alterUserPasswordStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (d *PostgresEngine) DropUser(username string) error {
-	// For PostgreSQL we don't drop the user because it might still be owner of some objects
+	// Objects owned by the user must be reassigned (see ReassignOwnership) before dropping it
+	dropUserStatement := "DROP ROLE IF EXISTS " + d.QuoteIdentifier(username)
+	d.logger.Debug("drop-user", lager.Data{"statement": dropUserStatement})
+
+	if _, err := d.db.Exec(dropUserStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
 
 	return nil
 }
 
+func (d *PostgresEngine) CreateOwnerRole(dbname string, rolename string) error {
+	ok, err := d.existsRole(rolename)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		createRoleStatement := "CREATE ROLE " + d.QuoteIdentifier(rolename) + " NOLOGIN"
+		d.logger.Debug("create-owner-role", lager.Data{"statement": createRoleStatement})
+
+		if _, err := d.db.Exec(createRoleStatement); err != nil {
+			if pqErr, ok := err.(*pq.Error); !ok || pqErr.Code != duplicateObjectErrorCode {
+				d.logger.Error("sql-error", err)
+				return err
+			}
+		}
+	}
+
+	ownerRoleStatements := d.OwnerRoleStatements(dbname, rolename)
+	d.logger.Debug("create-owner-role", lager.Data{"statements": ownerRoleStatements})
+
+	return d.execInDB(dbname, ownerRoleStatements)
+}
+
+func (d *PostgresEngine) OwnerRoleStatements(dbname string, rolename string) []string {
+	// The master user must be a member of the owner role to transfer and reassign objects to it
+	owner := d.QuoteIdentifier(rolename)
+	return []string{
+		"GRANT " + owner + " TO CURRENT_USER",
+		"ALTER DATABASE " + d.QuoteIdentifier(dbname) + " OWNER TO " + owner,
+		"ALTER SCHEMA public OWNER TO " + owner,
+	}
+}
+
+func (d *PostgresEngine) ReassignOwnership(dbname string, username string, rolename string) error {
+	ok, err := d.existsRole(username)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	// Instances bound before owner roles were introduced have none, so objects go to the master user
+	ok, err = d.existsRole(rolename)
+	if err != nil {
+		return err
+	}
+	owner := "CURRENT_USER"
+	if ok {
+		owner = d.QuoteIdentifier(rolename)
+	}
+
+	reassignOwnershipStatements := d.ReassignOwnershipStatements(username, owner)
+	d.logger.Debug("reassign-ownership", lager.Data{"statements": reassignOwnershipStatements, "dbname": dbname})
+
+	return d.execInDB(dbname, reassignOwnershipStatements)
+}
+
+func (d *PostgresEngine) ReassignOwnershipStatements(username string, owner string) []string {
+	// The owner must already be quoted, as it can also be CURRENT_USER
+	user := d.QuoteIdentifier(username)
+	return []string{
+		"GRANT " + user + " TO CURRENT_USER",
+		"REASSIGN OWNED BY " + user + " TO " + owner,
+		"DROP OWNED BY " + user,
+	}
+}
+
 func (d *PostgresEngine) Privileges() (map[string][]string, error) {
 	privileges := make(map[string][]string)
 
@@ -146,28 +247,88 @@ func (d *PostgresEngine) Privileges() (map[string][]string, error) {
 	return privileges, nil
 }
 
-func (d *PostgresEngine) GrantPrivileges(dbname string, username string) error {
-	grantPrivilegesStatement := "GRANT ALL PRIVILEGES ON DATABASE \"" + dbname + "\" TO \"" + username + "\""
-	d.logger.Debug("grant-privileges", lager.Data{"statement": grantPrivilegesStatement})
-
-	if _, err := d.db.Exec(grantPrivilegesStatement); err != nil {
-		d.logger.Error("sql-error", err)
+func (d *PostgresEngine) GrantPrivileges(dbname string, username string, role string, ownerRole string) error {
+	grantPrivilegesStatements, err := d.GrantPrivilegesStatements(dbname, username, role, ownerRole)
+	if err != nil {
 		return err
 	}
+	d.logger.Debug("grant-privileges", lager.Data{"statements": grantPrivilegesStatements})
 
-	return nil
+	return d.execInDB(dbname, grantPrivilegesStatements)
 }
 
 func (d *PostgresEngine) RevokePrivileges(dbname string, username string) error {
-	revokePrivilegesStatement := "REVOKE ALL PRIVILEGES ON DATABASE \"" + dbname + "\" FROM \"" + username + "\""
-	d.logger.Debug("revoke-privileges", lager.Data{"statement": revokePrivilegesStatement})
+	revokePrivilegesStatements := d.RevokePrivilegesStatements(dbname, username)
+	d.logger.Debug("revoke-privileges", lager.Data{"statements": revokePrivilegesStatements})
 
-	if _, err := d.db.Exec(revokePrivilegesStatement); err != nil {
-		d.logger.Error("sql-error", err)
-		return err
+	return d.execInDB(dbname, revokePrivilegesStatements)
+}
+
+func (d *PostgresEngine) GrantPrivilegesStatements(dbname string, username string, role string, ownerRole string) ([]string, error) {
+	var databasePrivileges, schemaPrivileges, tablePrivileges, sequencePrivileges string
+	switch role {
+	case RoleReadOnly:
+		databasePrivileges = "CONNECT"
+		schemaPrivileges = "USAGE"
+		tablePrivileges = "SELECT"
+		sequencePrivileges = "SELECT"
+	case RoleReadWrite:
+		databasePrivileges = "CONNECT, TEMPORARY"
+		schemaPrivileges = "USAGE"
+		tablePrivileges = "SELECT, INSERT, UPDATE, DELETE"
+		sequencePrivileges = "USAGE, SELECT, UPDATE"
+	case RoleDDL:
+		databasePrivileges = "CONNECT, TEMPORARY"
+		schemaPrivileges = "USAGE, CREATE"
+		tablePrivileges = "ALL PRIVILEGES"
+		sequencePrivileges = "ALL PRIVILEGES"
+	case RoleOwner:
+		databasePrivileges = "ALL PRIVILEGES"
+		schemaPrivileges = "ALL PRIVILEGES"
+		tablePrivileges = "ALL PRIVILEGES"
+		sequencePrivileges = "ALL PRIVILEGES"
+	default:
+		return nil, ValidateRole(role)
 	}
 
-	return nil
+	user := d.QuoteIdentifier(username)
+	owner := d.QuoteIdentifier(ownerRole)
+	statements := []string{
+		"GRANT " + databasePrivileges + " ON DATABASE " + d.QuoteIdentifier(dbname) + " TO " + user,
+		"GRANT " + schemaPrivileges + " ON SCHEMA public TO " + user,
+		"GRANT " + tablePrivileges + " ON ALL TABLES IN SCHEMA public TO " + user,
+		"GRANT " + sequencePrivileges + " ON ALL SEQUENCES IN SCHEMA public TO " + user,
+		"ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT " + tablePrivileges + " ON TABLES TO " + user,
+		"ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT " + sequencePrivileges + " ON SEQUENCES TO " + user,
+	}
+
+	switch role {
+	case RoleDDL, RoleOwner:
+		// Objects created by users allowed to change the schema are owned by the shared owner role
+		statements = append(statements,
+			"GRANT "+owner+" TO "+user,
+			"ALTER ROLE "+user+" SET role TO "+d.QuoteLiteral(ownerRole),
+		)
+	default:
+		statements = append(statements,
+			"ALTER DEFAULT PRIVILEGES FOR ROLE "+owner+" IN SCHEMA public GRANT "+tablePrivileges+" ON TABLES TO "+user,
+			"ALTER DEFAULT PRIVILEGES FOR ROLE "+owner+" IN SCHEMA public GRANT "+sequencePrivileges+" ON SEQUENCES TO "+user,
+		)
+	}
+
+	return statements, nil
+}
+
+func (d *PostgresEngine) RevokePrivilegesStatements(dbname string, username string) []string {
+	user := d.QuoteIdentifier(username)
+	return []string{
+		"ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE ALL PRIVILEGES ON SEQUENCES FROM " + user,
+		"ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE ALL PRIVILEGES ON TABLES FROM " + user,
+		"REVOKE ALL PRIVILEGES ON ALL SEQUENCES IN SCHEMA public FROM " + user,
+		"REVOKE ALL PRIVILEGES ON ALL TABLES IN SCHEMA public FROM " + user,
+		"REVOKE ALL PRIVILEGES ON SCHEMA public FROM " + user,
+		"REVOKE ALL PRIVILEGES ON DATABASE " + d.QuoteIdentifier(dbname) + " FROM " + user,
+	}
 }
 
 func (d *PostgresEngine) URI(address string, port int64, dbname string, username string, password string) string {
@@ -178,11 +339,50 @@ func (d *PostgresEngine) JDBCURI(address string, port int64, dbname string, user
 	return fmt.Sprintf("jdbc:postgresql://%s:%d/%s?user=%s&password=%s", address, port, dbname, username, password)
 }
 
+func (d *PostgresEngine) existsRole(rolename string) (bool, error) {
+	selectRoleStatement := "SELECT rolname FROM pg_roles WHERE rolname = $1"
+	d.logger.Debug("role-exists", lager.Data{"statement": selectRoleStatement, "rolename": rolename})
+
+	var dummy string
+	err := d.db.QueryRow(selectRoleStatement, rolename).Scan(&dummy)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		d.logger.Error("sql-error", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (d *PostgresEngine) execInDB(dbname string, statements []string) error {
+	// Schema level privileges can only be managed while connected to the database they belong to
+	db := d.db
+	if dbname != d.dbname {
+		var err error
+		db, err = sql.Open("postgres", d.connectionString(d.address, d.port, dbname, d.username, d.password))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			d.logger.Error("sql-error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (d *PostgresEngine) dropConnections(dbname string) error {
-	dropDBConnectionsStatement := "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = '" + dbname + "' AND pid <> pg_backend_pid()"
-	d.logger.Debug("drop-connections", lager.Data{"statement": dropDBConnectionsStatement})
+	dropDBConnectionsStatement := "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()"
+	d.logger.Debug("drop-connections", lager.Data{"statement": dropDBConnectionsStatement, "dbname": dbname})
 
-	if _, err := d.db.Exec(dropDBConnectionsStatement); err != nil {
+	if _, err := d.db.Exec(dropDBConnectionsStatement, dbname); err != nil {
 		d.logger.Error("sql-error", err)
 		return err
 	}
@@ -190,6 +390,24 @@ func (d *PostgresEngine) dropConnections(dbname string) error {
 	return nil
 }
 
+func (d *PostgresEngine) QuoteIdentifier(identifier string) string {
+	return pq.QuoteIdentifier(identifier)
+}
+
+func (d *PostgresEngine) QuoteLiteral(literal string) string {
+	literal = strings.Replace(literal, `'`, `''`, -1)
+	if strings.Contains(literal, `\`) {
+		return "E'" + strings.Replace(literal, `\`, `\\`, -1) + "'"
+	}
+	return "'" + literal + "'"
+}
+
 func (d *PostgresEngine) connectionString(address string, port int64, dbname string, username string, password string) string {
-	return fmt.Sprintf("host=%s port=%d dbname=%s user='%s' password='%s'", address, port, dbname, username, password)
+	return fmt.Sprintf("host=%s port=%d dbname=%s user=%s password=%s", address, port, d.connectionValue(dbname), d.connectionValue(username), d.connectionValue(password))
+}
+
+func (d *PostgresEngine) connectionValue(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `'`, `\'`, -1)
+	return "'" + value + "'"
 }
@@ -0,0 +1,135 @@
+package sqlengine_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
+
+	"github.com/pivotal-golang/lager"
+)
+
+var _ = Describe("Postgres Engine", func() {
+	var (
+		postgresEngine *PostgresEngine
+	)
+
+	BeforeEach(func() {
+		postgresEngine = NewPostgresEngine(lager.NewLogger("postgres_engine_test"))
+	})
+
+	Describe("QuoteIdentifier", func() {
+		It("quotes identifiers with double quotes", func() {
+			Expect(postgresEngine.QuoteIdentifier("test_db")).To(Equal(`"test_db"`))
+		})
+
+		It("escapes double quotes", func() {
+			Expect(postgresEngine.QuoteIdentifier(`db"; DROP DATABASE postgres; --`)).To(Equal(`"db""; DROP DATABASE postgres; --"`))
+		})
+	})
+
+	Describe("QuoteLiteral", func() {
+		It("quotes literals with single quotes", func() {
+			Expect(postgresEngine.QuoteLiteral("password")).To(Equal("'password'"))
+		})
+
+		It("escapes single quotes", func() {
+			Expect(postgresEngine.QuoteLiteral("pass'; DROP ROLE postgres; --")).To(Equal("'pass''; DROP ROLE postgres; --'"))
+		})
+
+		It("uses escape string syntax when there are backslashes", func() {
+			Expect(postgresEngine.QuoteLiteral(`pass\'word`)).To(Equal(`E'pass\\''word'`))
+		})
+	})
+
+	Describe("GrantPrivilegesStatements", func() {
+		It("grants read only privileges including default privileges", func() {
+			statements, err := postgresEngine.GrantPrivilegesStatements("test_db", "user", "read_only", "owner_role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statements).To(Equal([]string{
+				`GRANT CONNECT ON DATABASE "test_db" TO "user"`,
+				`GRANT USAGE ON SCHEMA public TO "user"`,
+				`GRANT SELECT ON ALL TABLES IN SCHEMA public TO "user"`,
+				`GRANT SELECT ON ALL SEQUENCES IN SCHEMA public TO "user"`,
+				`ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT ON TABLES TO "user"`,
+				`ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT SELECT ON SEQUENCES TO "user"`,
+				`ALTER DEFAULT PRIVILEGES FOR ROLE "owner_role" IN SCHEMA public GRANT SELECT ON TABLES TO "user"`,
+				`ALTER DEFAULT PRIVILEGES FOR ROLE "owner_role" IN SCHEMA public GRANT SELECT ON SEQUENCES TO "user"`,
+			}))
+		})
+
+		It("does not make read only users members of the owner role", func() {
+			statements, err := postgresEngine.GrantPrivilegesStatements("test_db", "user", "read_only", "owner_role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statements).ToNot(ContainElement(`GRANT "owner_role" TO "user"`))
+		})
+
+		It("grants data manipulation privileges for read write bindings", func() {
+			statements, err := postgresEngine.GrantPrivilegesStatements("test_db", "user", "read_write", "owner_role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statements).To(ContainElement(`GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO "user"`))
+			Expect(statements).To(ContainElement(`GRANT USAGE ON SCHEMA public TO "user"`))
+			Expect(statements).To(ContainElement(`ALTER DEFAULT PRIVILEGES FOR ROLE "owner_role" IN SCHEMA public GRANT SELECT, INSERT, UPDATE, DELETE ON TABLES TO "user"`))
+			Expect(statements).ToNot(ContainElement(`GRANT "owner_role" TO "user"`))
+		})
+
+		It("grants create on the schema for ddl bindings", func() {
+			statements, err := postgresEngine.GrantPrivilegesStatements("test_db", "user", "ddl", "owner_role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statements).To(ContainElement(`GRANT USAGE, CREATE ON SCHEMA public TO "user"`))
+			Expect(statements).To(ContainElement(`GRANT CONNECT, TEMPORARY ON DATABASE "test_db" TO "user"`))
+			Expect(statements).To(ContainElement(`GRANT "owner_role" TO "user"`))
+			Expect(statements).To(ContainElement(`ALTER ROLE "user" SET role TO 'owner_role'`))
+		})
+
+		It("grants all privileges for owner bindings", func() {
+			statements, err := postgresEngine.GrantPrivilegesStatements("test_db", "user", "owner", "owner_role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statements).To(ContainElement(`GRANT ALL PRIVILEGES ON DATABASE "test_db" TO "user"`))
+			Expect(statements).To(ContainElement(`GRANT "owner_role" TO "user"`))
+			Expect(statements).To(ContainElement(`ALTER ROLE "user" SET role TO 'owner_role'`))
+		})
+
+		It("returns error if the role is not valid", func() {
+			_, err := postgresEngine.GrantPrivilegesStatements("test_db", "user", "superuser", "owner_role")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Role 'superuser' is not valid"))
+		})
+	})
+
+	Describe("RevokePrivilegesStatements", func() {
+		It("revokes database, schema and default privileges", func() {
+			statements := postgresEngine.RevokePrivilegesStatements("test_db", "user")
+			Expect(statements).To(ContainElement(`ALTER DEFAULT PRIVILEGES IN SCHEMA public REVOKE ALL PRIVILEGES ON TABLES FROM "user"`))
+			Expect(statements).To(ContainElement(`REVOKE ALL PRIVILEGES ON ALL TABLES IN SCHEMA public FROM "user"`))
+			Expect(statements).To(ContainElement(`REVOKE ALL PRIVILEGES ON DATABASE "test_db" FROM "user"`))
+		})
+	})
+
+	Describe("OwnerRoleStatements", func() {
+		It("makes the owner role the owner of the database and its public schema", func() {
+			statements := postgresEngine.OwnerRoleStatements("test_db", "owner_role")
+			Expect(statements).To(Equal([]string{
+				`GRANT "owner_role" TO CURRENT_USER`,
+				`ALTER DATABASE "test_db" OWNER TO "owner_role"`,
+				`ALTER SCHEMA public OWNER TO "owner_role"`,
+			}))
+		})
+	})
+
+	Describe("ReassignOwnershipStatements", func() {
+		It("reassigns the objects owned by the user to the owner role and drops the rest", func() {
+			statements := postgresEngine.ReassignOwnershipStatements("user", `"owner_role"`)
+			Expect(statements).To(Equal([]string{
+				`GRANT "user" TO CURRENT_USER`,
+				`REASSIGN OWNED BY "user" TO "owner_role"`,
+				`DROP OWNED BY "user"`,
+			}))
+		})
+
+		It("reassigns the objects owned by the user to the master user", func() {
+			statements := postgresEngine.ReassignOwnershipStatements("user", "CURRENT_USER")
+			Expect(statements).To(ContainElement(`REASSIGN OWNED BY "user" TO CURRENT_USER`))
+		})
+	})
+})
@@ -1,10 +1,13 @@
 package sqlengine
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/pivotal-golang/lager"
+
+	"github.com/cloudfoundry-community/pe-rds-broker/awsrds"
 )
 
 type ProviderService struct {
@@ -16,12 +19,46 @@ func NewProviderService(logger lager.Logger) *ProviderService {
 }
 
 func (p *ProviderService) GetSQLEngine(engine string) (SQLEngine, error) {
-	switch strings.ToLower(engine) {
-	case "aurora", "mariadb", "mysql":
+	switch engineProtocol(engine) {
+	case awsrds.ProtocolMySQL:
 		return NewMySQLEngine(p.logger), nil
-	case "postgres", "postgresql":
+	case awsrds.ProtocolPostgres, "postgresql":
 		return NewPostgresEngine(p.logger), nil
+	case awsrds.ProtocolOracle:
+		return NewOracleEngine(p.logger), nil
+	case awsrds.ProtocolSQLServer:
+		return NewSQLServerEngine(p.logger), nil
 	}
 
 	return nil, fmt.Errorf("SQL Engine '%s' not supported", engine)
 }
+
+// ValidateDriver returns an error if the database driver of an RDS engine has not been compiled into the broker
+// (the Oracle and SQL Server drivers are only registered when building with the "oracle" and "sqlserver" tags)
+func ValidateDriver(engine string) error {
+	var driverName, buildTag string
+	switch engineProtocol(engine) {
+	case awsrds.ProtocolOracle:
+		driverName, buildTag = oracleDriverName, "oracle"
+	case awsrds.ProtocolSQLServer:
+		driverName, buildTag = sqlServerDriverName, "sqlserver"
+	default:
+		return nil
+	}
+
+	for _, driver := range sql.Drivers() {
+		if driver == driverName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("SQL driver '%s' for engine '%s' is not available: the broker must be built with the '%s' tag", driverName, engine, buildTag)
+}
+
+func engineProtocol(engine string) string {
+	if engineFamily, ok := awsrds.FindEngineFamily(engine); ok {
+		return engineFamily.Protocol
+	}
+
+	return strings.ToLower(engine)
+}
@@ -0,0 +1,25 @@
+//go:build !oracle && !sqlserver
+// +build !oracle,!sqlserver
+
+package sqlengine_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
+)
+
+var _ = Describe("ValidateDriver without the optional drivers", func() {
+	It("returns error if the engine is Oracle", func() {
+		err := ValidateDriver("oracle-se2")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("SQL driver 'oci8' for engine 'oracle-se2' is not available: the broker must be built with the 'oracle' tag"))
+	})
+
+	It("returns error if the engine is SQL Server", func() {
+		err := ValidateDriver("sqlserver-ex")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("SQL driver 'mssql' for engine 'sqlserver-ex' is not available: the broker must be built with the 'sqlserver' tag"))
+	})
+})
@@ -35,6 +35,22 @@ var _ = Describe("Provider Service", func() {
 			})
 		})
 
+		Context("when engine is aurora-mysql", func() {
+			It("return the proper SQL Engine", func() {
+				sqlEngine, err := sqlProvider.GetSQLEngine("aurora-mysql")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine).To(BeAssignableToTypeOf(&MySQLEngine{}))
+			})
+		})
+
+		Context("when engine is aurora-postgresql", func() {
+			It("return the proper SQL Engine", func() {
+				sqlEngine, err := sqlProvider.GetSQLEngine("aurora-postgresql")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine).To(BeAssignableToTypeOf(&PostgresEngine{}))
+			})
+		})
+
 		Context("when engine is mariadb", func() {
 			It("return the proper SQL Engine", func() {
 				sqlEngine, err := sqlProvider.GetSQLEngine("mariadb")
@@ -66,5 +82,30 @@ var _ = Describe("Provider Service", func() {
 				Expect(sqlEngine).To(BeAssignableToTypeOf(&PostgresEngine{}))
 			})
 		})
+
+		Context("when engine is oracle-se2", func() {
+			It("return the proper SQL Engine", func() {
+				sqlEngine, err := sqlProvider.GetSQLEngine("oracle-se2")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine).To(BeAssignableToTypeOf(&OracleEngine{}))
+			})
+		})
+
+		Context("when engine is sqlserver-ex", func() {
+			It("return the proper SQL Engine", func() {
+				sqlEngine, err := sqlProvider.GetSQLEngine("sqlserver-ex")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sqlEngine).To(BeAssignableToTypeOf(&SQLServerEngine{}))
+			})
+		})
+	})
+
+	Describe("ValidateDriver", func() {
+		It("does not return error for engines whose driver is always compiled in", func() {
+			Expect(ValidateDriver("aurora-postgresql")).To(Succeed())
+			Expect(ValidateDriver("mariadb")).To(Succeed())
+			Expect(ValidateDriver("mysql")).To(Succeed())
+			Expect(ValidateDriver("postgres")).To(Succeed())
+		})
 	})
 })
@@ -1,5 +1,19 @@
 package sqlengine
 
+import (
+	"fmt"
+	"regexp"
+)
+
+const (
+	RoleReadOnly  = "read_only"
+	RoleReadWrite = "read_write"
+	RoleDDL       = "ddl"
+	RoleOwner     = "owner"
+)
+
+var Roles = []string{RoleReadOnly, RoleReadWrite, RoleDDL, RoleOwner}
+
 type SQLEngine interface {
 	Open(address string, port int64, dbname string, username string, password string) error
 	Close()
@@ -7,10 +21,33 @@ type SQLEngine interface {
 	CreateDB(dbname string) error
 	DropDB(dbname string) error
 	CreateUser(username string, password string) error
+	AlterUserPassword(username string, password string) error
 	DropUser(username string) error
+	CreateOwnerRole(dbname string, rolename string) error
+	ReassignOwnership(dbname string, username string, rolename string) error
 	Privileges() (map[string][]string, error)
-	GrantPrivileges(dbname string, username string) error
+	GrantPrivileges(dbname string, username string, role string, ownerRole string) error
 	RevokePrivileges(dbname string, username string) error
 	URI(address string, port int64, dbname string, username string, password string) string
 	JDBCURI(address string, port int64, dbname string, username string, password string) string
 }
+
+var dbNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,62}$`)
+
+func ValidateDBName(dbname string) error {
+	if !dbNameRegexp.MatchString(dbname) {
+		return fmt.Errorf("Database name '%s' is not valid: it must begin with a letter and contain only letters, digits, hyphens and underscores (up to 63 characters)", dbname)
+	}
+
+	return nil
+}
+
+func ValidateRole(role string) error {
+	for _, validRole := range Roles {
+		if role == validRole {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Role '%s' is not valid: it must be one of %v", role, Roles)
+}
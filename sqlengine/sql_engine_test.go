@@ -0,0 +1,51 @@
+package sqlengine_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/sqlengine"
+)
+
+var _ = Describe("ValidateDBName", func() {
+	It("accepts valid database names", func() {
+		for _, dbname := range []string{"a", "test_db", "test-db", "TestDB1", "cf_6e2f3c4d_5a6b"} {
+			Expect(ValidateDBName(dbname)).To(Succeed(), dbname)
+		}
+	})
+
+	It("rejects hostile database names", func() {
+		for _, dbname := range []string{
+			"",
+			"1db",
+			"_db",
+			"db; DROP DATABASE mysql",
+			"db`; DROP DATABASE mysql; --",
+			"db\"; DROP DATABASE postgres; --",
+			"db'",
+			"db name",
+			"db.table",
+			"db\x00",
+			"db\n",
+			"a234567890123456789012345678901234567890123456789012345678901234",
+		} {
+			err := ValidateDBName(dbname)
+			Expect(err).To(HaveOccurred(), dbname)
+			Expect(err.Error()).To(ContainSubstring("is not valid"))
+		}
+	})
+})
+
+var _ = Describe("ValidateRole", func() {
+	It("accepts valid roles", func() {
+		for _, role := range []string{"read_only", "read_write", "ddl", "owner"} {
+			Expect(ValidateRole(role)).To(Succeed(), role)
+		}
+	})
+
+	It("rejects unknown roles", func() {
+		err := ValidateRole("superuser")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Role 'superuser' is not valid"))
+	})
+})
@@ -0,0 +1,8 @@
+//go:build sqlserver
+// +build sqlserver
+
+package sqlengine
+
+import (
+	_ "github.com/denisenkom/go-mssqldb" // SQL Server Driver
+)
@@ -0,0 +1,327 @@
+package sqlengine
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pivotal-golang/lager"
+)
+
+// The SQL Server driver (github.com/denisenkom/go-mssqldb) is registered by sqlserver_driver.go when building with the "sqlserver" tag
+const sqlServerDriverName = "mssql"
+
+type SQLServerEngine struct {
+	logger lager.Logger
+	db     *sql.DB
+}
+
+func NewSQLServerEngine(logger lager.Logger) *SQLServerEngine {
+	return &SQLServerEngine{
+		logger: logger.Session("sqlserver-engine"),
+	}
+}
+
+func (d *SQLServerEngine) Open(address string, port int64, dbname string, username string, password string) error {
+	// RDS does not create an initial database for SQL Server, so connections always start at the master database
+	connectionString := d.connectionString(address, port, "master", username, password)
+	d.logger.Debug("sql-open", lager.Data{"address": address, "port": port, "dbname": "master", "username": username})
+
+	db, err := sql.Open(sqlServerDriverName, connectionString)
+	if err != nil {
+		return err
+	}
+
+	d.db = db
+
+	return nil
+}
+
+func (d *SQLServerEngine) Close() {
+	if d.db != nil {
+		d.db.Close()
+	}
+}
+
+func (d *SQLServerEngine) ExistsDB(dbname string) (bool, error) {
+	selectDatabaseStatement := "SELECT name FROM sys.databases WHERE name = @p1"
+	d.logger.Debug("database-exists", lager.Data{"statement": selectDatabaseStatement, "dbname": dbname})
+
+	var dummy string
+	err := d.db.QueryRow(selectDatabaseStatement, dbname).Scan(&dummy)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (d *SQLServerEngine) CreateDB(dbname string) error {
+	ok, err := d.ExistsDB(dbname)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	createDBStatement := "CREATE DATABASE " + d.QuoteIdentifier(dbname)
+	d.logger.Debug("create-database", lager.Data{"statement": createDBStatement})
+
+	if _, err := d.db.Exec(createDBStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *SQLServerEngine) DropDB(dbname string) error {
+	ok, err := d.ExistsDB(dbname)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	dropDBStatements := []string{
+		"ALTER DATABASE " + d.QuoteIdentifier(dbname) + " SET SINGLE_USER WITH ROLLBACK IMMEDIATE",
+		"DROP DATABASE " + d.QuoteIdentifier(dbname),
+	}
+	d.logger.Debug("drop-database", lager.Data{"statements": dropDBStatements})
+
+	for _, statement := range dropDBStatements {
+		if _, err := d.db.Exec(statement); err != nil {
+			d.logger.Error("sql-error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *SQLServerEngine) CreateUser(username string, password string) error {
+	createUserStatement := "CREATE LOGIN " + d.QuoteIdentifier(username) + " WITH PASSWORD = " + d.QuoteLiteral(password)
+	d.logger.Debug("create-user", lager.Data{"username": username})
+
+	if _, err := d.db.Exec(createUserStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *SQLServerEngine) AlterUserPassword(username string, password string) error {
+	alterUserPasswordStatement := "ALTER LOGIN " + d.QuoteIdentifier(username) + " WITH PASSWORD = " + d.QuoteLiteral(password)
+	d.logger.Debug("alter-user-password", lager.Data{"username": username})
+
+	if _, err := d.db.Exec(alterUserPasswordStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *SQLServerEngine) DropUser(username string) error {
+	// Database users mapped to the login are dropped by ReassignOwnership
+	dropUserStatement := "IF SUSER_ID(" + d.QuoteLiteral(username) + ") IS NOT NULL DROP LOGIN " + d.QuoteIdentifier(username)
+	d.logger.Debug("drop-user", lager.Data{"statement": dropUserStatement})
+
+	if _, err := d.db.Exec(dropUserStatement); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *SQLServerEngine) CreateOwnerRole(dbname string, rolename string) error {
+	// The default database of a service instance is created the first time it is bound
+	if err := d.CreateDB(dbname); err != nil {
+		return err
+	}
+
+	ownerRoleStatements := d.OwnerRoleStatements(rolename)
+	d.logger.Debug("create-owner-role", lager.Data{"statements": ownerRoleStatements, "dbname": dbname})
+
+	return d.execInDB(dbname, ownerRoleStatements)
+}
+
+func (d *SQLServerEngine) OwnerRoleStatements(rolename string) []string {
+	owner := d.QuoteIdentifier(rolename)
+	return []string{
+		"IF DATABASE_PRINCIPAL_ID(" + d.QuoteLiteral(rolename) + ") IS NULL CREATE ROLE " + owner,
+		"ALTER ROLE [db_owner] ADD MEMBER " + owner,
+	}
+}
+
+func (d *SQLServerEngine) ReassignOwnership(dbname string, username string, rolename string) error {
+	reassignOwnershipStatements := d.ReassignOwnershipStatements(username, rolename)
+	d.logger.Debug("reassign-ownership", lager.Data{"statements": reassignOwnershipStatements, "dbname": dbname})
+
+	return d.execInDB(dbname, reassignOwnershipStatements)
+}
+
+func (d *SQLServerEngine) ReassignOwnershipStatements(username string, rolename string) []string {
+	// Objects belong to the owner of their schema, so only the schemas owned by the user have to be transferred.
+	// Databases bound before owner roles were introduced have none, so their schemas go to dbo.
+	user := d.QuoteLiteral(username)
+	owner := d.QuoteLiteral(rolename)
+	return []string{
+		"DECLARE @statements NVARCHAR(MAX) = N''",
+		"DECLARE @owner SYSNAME = CASE WHEN DATABASE_PRINCIPAL_ID(" + owner + ") IS NULL THEN N'dbo' ELSE " + owner + " END",
+		"SELECT @statements = @statements + N'ALTER AUTHORIZATION ON SCHEMA::' + QUOTENAME(name) + N' TO ' + QUOTENAME(@owner) + N';' FROM sys.schemas WHERE principal_id = DATABASE_PRINCIPAL_ID(" + user + ")",
+		"EXEC sp_executesql @statements",
+		"IF DATABASE_PRINCIPAL_ID(" + user + ") IS NOT NULL DROP USER " + d.QuoteIdentifier(username),
+	}
+}
+
+func (d *SQLServerEngine) Privileges() (map[string][]string, error) {
+	privileges := make(map[string][]string)
+
+	selectDatabasesStatement := "SELECT name FROM sys.databases WHERE database_id > 4"
+	d.logger.Debug("database-privileges", lager.Data{"statement": selectDatabasesStatement})
+
+	dbnames, err := d.queryStrings(selectDatabasesStatement)
+	if err != nil {
+		return privileges, err
+	}
+
+	for _, dbname := range dbnames {
+		// Skip the built-in dbo, guest, INFORMATION_SCHEMA and sys principals
+		selectUsersStatement := "SELECT name FROM " + d.QuoteIdentifier(dbname) + ".sys.database_principals WHERE type = 'S' AND principal_id > 4"
+		d.logger.Debug("database-privileges", lager.Data{"statement": selectUsersStatement})
+
+		usernames, err := d.queryStrings(selectUsersStatement)
+		if err != nil {
+			return privileges, err
+		}
+		if len(usernames) > 0 {
+			privileges[dbname] = usernames
+		}
+	}
+
+	d.logger.Debug("database-privileges", lager.Data{"output": privileges})
+
+	return privileges, nil
+}
+
+func (d *SQLServerEngine) GrantPrivileges(dbname string, username string, role string, ownerRole string) error {
+	grantPrivilegesStatements, err := d.GrantPrivilegesStatements(username, role, ownerRole)
+	if err != nil {
+		return err
+	}
+	d.logger.Debug("grant-privileges", lager.Data{"statements": grantPrivilegesStatements, "dbname": dbname})
+
+	return d.execInDB(dbname, grantPrivilegesStatements)
+}
+
+func (d *SQLServerEngine) RevokePrivileges(dbname string, username string) error {
+	revokePrivilegesStatements := d.RevokePrivilegesStatements(username)
+	d.logger.Debug("revoke-privileges", lager.Data{"statements": revokePrivilegesStatements, "dbname": dbname})
+
+	return d.execInDB(dbname, revokePrivilegesStatements)
+}
+
+func (d *SQLServerEngine) GrantPrivilegesStatements(username string, role string, ownerRole string) ([]string, error) {
+	var roles []string
+	switch role {
+	case RoleReadOnly:
+		roles = []string{"[db_datareader]"}
+	case RoleReadWrite:
+		roles = []string{"[db_datareader]", "[db_datawriter]"}
+	case RoleDDL:
+		roles = []string{"[db_datareader]", "[db_datawriter]", "[db_ddladmin]"}
+	case RoleOwner:
+		roles = []string{d.QuoteIdentifier(ownerRole)}
+	default:
+		return nil, ValidateRole(role)
+	}
+
+	user := d.QuoteIdentifier(username)
+	statements := []string{
+		"IF DATABASE_PRINCIPAL_ID(" + d.QuoteLiteral(username) + ") IS NULL CREATE USER " + user + " FOR LOGIN " + user + " WITH DEFAULT_SCHEMA = [dbo]",
+	}
+	for _, role := range roles {
+		statements = append(statements, "ALTER ROLE "+role+" ADD MEMBER "+user)
+	}
+
+	return statements, nil
+}
+
+func (d *SQLServerEngine) RevokePrivilegesStatements(username string) []string {
+	user := d.QuoteIdentifier(username)
+	return []string{
+		"DECLARE @statements NVARCHAR(MAX) = N''",
+		"SELECT @statements = @statements + N'ALTER ROLE ' + QUOTENAME(r.name) + N' DROP MEMBER " + strings.Replace(user, "'", "''", -1) + ";' FROM sys.database_role_members m JOIN sys.database_principals r ON r.principal_id = m.role_principal_id WHERE m.member_principal_id = DATABASE_PRINCIPAL_ID(" + d.QuoteLiteral(username) + ")",
+		"EXEC sp_executesql @statements",
+	}
+}
+
+func (d *SQLServerEngine) URI(address string, port int64, dbname string, username string, password string) string {
+	return fmt.Sprintf("sqlserver://%s:%s@%s:%d?database=%s", username, password, address, port, dbname)
+}
+
+func (d *SQLServerEngine) JDBCURI(address string, port int64, dbname string, username string, password string) string {
+	return fmt.Sprintf("jdbc:sqlserver://%s:%d;databaseName=%s;user=%s;password=%s", address, port, dbname, username, password)
+}
+
+func (d *SQLServerEngine) QuoteIdentifier(identifier string) string {
+	return "[" + strings.Replace(identifier, "]", "]]", -1) + "]"
+}
+
+func (d *SQLServerEngine) QuoteLiteral(literal string) string {
+	return "N'" + strings.Replace(literal, "'", "''", -1) + "'"
+}
+
+func (d *SQLServerEngine) execInDB(dbname string, statements []string) error {
+	// Statements run as a single batch, so they all use the same connection and database
+	batch := "USE " + d.QuoteIdentifier(dbname) + ";\n" + strings.Join(statements, ";\n")
+
+	if _, err := d.db.Exec(batch); err != nil {
+		d.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *SQLServerEngine) queryStrings(statement string) ([]string, error) {
+	values := []string{}
+
+	rows, err := d.db.Query(statement)
+	if err != nil {
+		d.logger.Error("sql-error", err)
+		return values, err
+	}
+	defer rows.Close()
+
+	var value string
+	for rows.Next() {
+		if err := rows.Scan(&value); err != nil {
+			d.logger.Error("sql-error", err)
+			return values, err
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		d.logger.Error("sql-error", err)
+		return values, err
+	}
+
+	return values, nil
+}
+
+func (d *SQLServerEngine) connectionString(address string, port int64, dbname string, username string, password string) string {
+	return fmt.Sprintf("server=%s;port=%d;database=%s;user id=%s;password=%s", address, port, d.connectionValue(dbname), d.connectionValue(username), d.connectionValue(password))
+}
+
+func (d *SQLServerEngine) connectionValue(value string) string {
+	return "{" + strings.Replace(value, "}", "}}", -1) + "}"
+}
@@ -0,0 +1,50 @@
+package statestore
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pivotal-golang/lager"
+)
+
+type Config struct {
+	Type       string `json:"type"`
+	Path       string `json:"path,omitempty"`
+	Driver     string `json:"driver,omitempty"`
+	DataSource string `json:"data_source,omitempty"`
+}
+
+func (c Config) Validate() error {
+	switch strings.ToLower(c.Type) {
+	case "file":
+		if c.Path == "" {
+			return errors.New("Must provide a non-empty Path")
+		}
+	case "sql":
+		switch c.Driver {
+		case "mysql", "postgres":
+		default:
+			return fmt.Errorf("This broker does not support SQL driver '%s'", c.Driver)
+		}
+
+		if c.DataSource == "" {
+			return errors.New("Must provide a non-empty DataSource")
+		}
+	default:
+		return fmt.Errorf("This broker does not support state store type '%s'", c.Type)
+	}
+
+	return nil
+}
+
+func New(config Config, logger lager.Logger) (Store, error) {
+	switch strings.ToLower(config.Type) {
+	case "file":
+		return NewFileStore(config.Path, logger)
+	case "sql":
+		return NewSQLStore(config.Driver, config.DataSource, logger)
+	}
+
+	return nil, fmt.Errorf("State store type '%s' not supported", config.Type)
+}
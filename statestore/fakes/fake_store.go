@@ -0,0 +1,174 @@
+package fakes
+
+import (
+	"github.com/cloudfoundry-community/pe-rds-broker/statestore"
+)
+
+type FakeStore struct {
+	Instances map[string]statestore.InstanceDetails
+	Bindings  map[string]map[string]statestore.BindingDetails
+
+	SaveInstanceCalled  bool
+	SaveInstanceDetails statestore.InstanceDetails
+	SaveInstanceError   error
+
+	FindInstanceCalled bool
+	FindInstanceID     string
+	FindInstanceError  error
+
+	ListInstancesCalled bool
+	ListInstancesError  error
+
+	DeleteInstanceCalled bool
+	DeleteInstanceID     string
+	DeleteInstanceError  error
+
+	SaveBindingCalled     bool
+	SaveBindingCallCount  int
+	SaveBindingDetails    statestore.BindingDetails
+	SaveBindingError      error
+	SaveBindingErrorAfter int
+
+	FindBindingCalled     bool
+	FindBindingInstanceID string
+	FindBindingID         string
+	FindBindingError      error
+
+	ListBindingsCalled     bool
+	ListBindingsInstanceID string
+	ListBindingsError      error
+
+	DeleteBindingCalled     bool
+	DeleteBindingInstanceID string
+	DeleteBindingID         string
+	DeleteBindingError      error
+}
+
+func NewFakeStore() *FakeStore {
+	return &FakeStore{
+		Instances: make(map[string]statestore.InstanceDetails),
+		Bindings:  make(map[string]map[string]statestore.BindingDetails),
+	}
+}
+
+func (f *FakeStore) SaveInstance(instance statestore.InstanceDetails) error {
+	f.SaveInstanceCalled = true
+	f.SaveInstanceDetails = instance
+
+	if f.SaveInstanceError != nil {
+		return f.SaveInstanceError
+	}
+
+	f.Instances[instance.ID] = instance
+
+	return nil
+}
+
+func (f *FakeStore) FindInstance(instanceID string) (statestore.InstanceDetails, error) {
+	f.FindInstanceCalled = true
+	f.FindInstanceID = instanceID
+
+	if f.FindInstanceError != nil {
+		return statestore.InstanceDetails{}, f.FindInstanceError
+	}
+
+	instance, ok := f.Instances[instanceID]
+	if !ok {
+		return instance, statestore.ErrInstanceNotFound
+	}
+
+	return instance, nil
+}
+
+func (f *FakeStore) ListInstances() ([]statestore.InstanceDetails, error) {
+	f.ListInstancesCalled = true
+
+	instances := []statestore.InstanceDetails{}
+	for _, instance := range f.Instances {
+		instances = append(instances, instance)
+	}
+
+	return instances, f.ListInstancesError
+}
+
+func (f *FakeStore) DeleteInstance(instanceID string) error {
+	f.DeleteInstanceCalled = true
+	f.DeleteInstanceID = instanceID
+
+	if f.DeleteInstanceError != nil {
+		return f.DeleteInstanceError
+	}
+
+	if _, ok := f.Instances[instanceID]; !ok {
+		return statestore.ErrInstanceNotFound
+	}
+
+	delete(f.Instances, instanceID)
+	delete(f.Bindings, instanceID)
+
+	return nil
+}
+
+func (f *FakeStore) SaveBinding(binding statestore.BindingDetails) error {
+	f.SaveBindingCalled = true
+	f.SaveBindingCallCount++
+	f.SaveBindingDetails = binding
+
+	if f.SaveBindingError != nil && f.SaveBindingCallCount > f.SaveBindingErrorAfter {
+		return f.SaveBindingError
+	}
+
+	if _, ok := f.Bindings[binding.InstanceID]; !ok {
+		f.Bindings[binding.InstanceID] = make(map[string]statestore.BindingDetails)
+	}
+	f.Bindings[binding.InstanceID][binding.ID] = binding
+
+	return nil
+}
+
+func (f *FakeStore) FindBinding(instanceID string, bindingID string) (statestore.BindingDetails, error) {
+	f.FindBindingCalled = true
+	f.FindBindingInstanceID = instanceID
+	f.FindBindingID = bindingID
+
+	if f.FindBindingError != nil {
+		return statestore.BindingDetails{}, f.FindBindingError
+	}
+
+	binding, ok := f.Bindings[instanceID][bindingID]
+	if !ok {
+		return binding, statestore.ErrBindingNotFound
+	}
+
+	return binding, nil
+}
+
+func (f *FakeStore) ListBindings(instanceID string) ([]statestore.BindingDetails, error) {
+	f.ListBindingsCalled = true
+	f.ListBindingsInstanceID = instanceID
+
+	bindings := []statestore.BindingDetails{}
+	for _, binding := range f.Bindings[instanceID] {
+		bindings = append(bindings, binding)
+	}
+
+	return bindings, f.ListBindingsError
+}
+
+func (f *FakeStore) DeleteBinding(instanceID string, bindingID string) error {
+	f.DeleteBindingCalled = true
+	f.DeleteBindingInstanceID = instanceID
+	f.DeleteBindingID = bindingID
+
+	if f.DeleteBindingError != nil {
+		return f.DeleteBindingError
+	}
+
+	if _, ok := f.Bindings[instanceID][bindingID]; !ok {
+		return statestore.ErrBindingNotFound
+	}
+
+	delete(f.Bindings[instanceID], bindingID)
+
+	return nil
+}
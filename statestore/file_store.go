@@ -0,0 +1,256 @@
+package statestore
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pivotal-golang/lager"
+)
+
+type FileStore struct {
+	path   string
+	logger lager.Logger
+
+	mutex sync.Mutex
+	state fileState
+}
+
+type fileState struct {
+	Instances map[string]InstanceDetails           `json:"instances"`
+	Bindings  map[string]map[string]BindingDetails `json:"bindings"`
+}
+
+func NewFileStore(path string, logger lager.Logger) (*FileStore, error) {
+	f := &FileStore{
+		path:   path,
+		logger: logger.Session("file-store"),
+		state: fileState{
+			Instances: make(map[string]InstanceDetails),
+			Bindings:  make(map[string]map[string]BindingDetails),
+		},
+	}
+
+	if err := f.load(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *FileStore) SaveInstance(instance InstanceDetails) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	instance, err := copyInstance(instance)
+	if err != nil {
+		return err
+	}
+	f.state.Instances[instance.ID] = instance
+
+	return f.persist()
+}
+
+func (f *FileStore) FindInstance(instanceID string) (InstanceDetails, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	instance, ok := f.state.Instances[instanceID]
+	if !ok {
+		return instance, ErrInstanceNotFound
+	}
+
+	return copyInstance(instance)
+}
+
+func (f *FileStore) ListInstances() ([]InstanceDetails, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	instanceIDs := make([]string, 0, len(f.state.Instances))
+	for instanceID := range f.state.Instances {
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+	sort.Strings(instanceIDs)
+
+	instances := make([]InstanceDetails, 0, len(instanceIDs))
+	for _, instanceID := range instanceIDs {
+		instance, err := copyInstance(f.state.Instances[instanceID])
+		if err != nil {
+			return instances, err
+		}
+		instances = append(instances, instance)
+	}
+
+	return instances, nil
+}
+
+func (f *FileStore) DeleteInstance(instanceID string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if _, ok := f.state.Instances[instanceID]; !ok {
+		return ErrInstanceNotFound
+	}
+
+	delete(f.state.Instances, instanceID)
+	delete(f.state.Bindings, instanceID)
+
+	return f.persist()
+}
+
+func (f *FileStore) SaveBinding(binding BindingDetails) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	binding, err := copyBinding(binding)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := f.state.Bindings[binding.InstanceID]; !ok {
+		f.state.Bindings[binding.InstanceID] = make(map[string]BindingDetails)
+	}
+	f.state.Bindings[binding.InstanceID][binding.ID] = binding
+
+	return f.persist()
+}
+
+func (f *FileStore) FindBinding(instanceID string, bindingID string) (BindingDetails, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	binding, ok := f.state.Bindings[instanceID][bindingID]
+	if !ok {
+		return binding, ErrBindingNotFound
+	}
+
+	return copyBinding(binding)
+}
+
+func (f *FileStore) ListBindings(instanceID string) ([]BindingDetails, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	bindingIDs := make([]string, 0, len(f.state.Bindings[instanceID]))
+	for bindingID := range f.state.Bindings[instanceID] {
+		bindingIDs = append(bindingIDs, bindingID)
+	}
+	sort.Strings(bindingIDs)
+
+	bindings := make([]BindingDetails, 0, len(bindingIDs))
+	for _, bindingID := range bindingIDs {
+		binding, err := copyBinding(f.state.Bindings[instanceID][bindingID])
+		if err != nil {
+			return bindings, err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	return bindings, nil
+}
+
+func (f *FileStore) DeleteBinding(instanceID string, bindingID string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if _, ok := f.state.Bindings[instanceID][bindingID]; !ok {
+		return ErrBindingNotFound
+	}
+
+	delete(f.state.Bindings[instanceID], bindingID)
+	if len(f.state.Bindings[instanceID]) == 0 {
+		delete(f.state.Bindings, instanceID)
+	}
+
+	return f.persist()
+}
+
+// copyInstance and copyBinding round trip the details through JSON, as the SQL Store does, so callers
+// never share the Parameters, Context or RDSProperties of the cached state.
+func copyInstance(instance InstanceDetails) (InstanceDetails, error) {
+	instanceCopy := InstanceDetails{}
+	err := copyDetails(instance, &instanceCopy)
+	return instanceCopy, err
+}
+
+func copyBinding(binding BindingDetails) (BindingDetails, error) {
+	bindingCopy := BindingDetails{}
+	err := copyDetails(binding, &bindingCopy)
+	return bindingCopy, err
+}
+
+func copyDetails(details interface{}, detailsCopy interface{}) error {
+	data, err := json.Marshal(details)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, detailsCopy)
+}
+
+func (f *FileStore) load() error {
+	f.logger.Debug("load-state", lager.Data{"path": f.path})
+
+	bytes, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		f.logger.Error("read-error", err)
+		return err
+	}
+
+	if err = json.Unmarshal(bytes, &f.state); err != nil {
+		f.logger.Error("unmarshal-error", err)
+		return err
+	}
+
+	if f.state.Instances == nil {
+		f.state.Instances = make(map[string]InstanceDetails)
+	}
+
+	if f.state.Bindings == nil {
+		f.state.Bindings = make(map[string]map[string]BindingDetails)
+	}
+
+	return nil
+}
+
+func (f *FileStore) persist() error {
+	bytes, err := json.MarshalIndent(f.state, "", "  ")
+	if err != nil {
+		f.logger.Error("marshal-error", err)
+		return err
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path))
+	if err != nil {
+		f.logger.Error("write-error", err)
+		return err
+	}
+
+	if _, err = tmpFile.Write(bytes); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		f.logger.Error("write-error", err)
+		return err
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		f.logger.Error("write-error", err)
+		return err
+	}
+
+	if err = os.Rename(tmpFile.Name(), f.path); err != nil {
+		os.Remove(tmpFile.Name())
+		f.logger.Error("write-error", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package statestore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/statestore"
+
+	"github.com/pivotal-golang/lager"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+var _ = Describe("File Store", func() {
+	var (
+		tmpDir string
+		path   string
+
+		logger lager.Logger
+
+		fileStore *FileStore
+
+		instance InstanceDetails
+		binding  BindingDetails
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "file-store-test")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tmpDir, "state.json")
+
+		logger = lager.NewLogger("filestore_test")
+		logger.RegisterSink(lagertest.NewTestSink())
+
+		instance = InstanceDetails{
+			ID:               "instance-id",
+			ServiceID:        "service-id",
+			PlanID:           "plan-id",
+			OrganizationGUID: "organization-id",
+			SpaceGUID:        "space-id",
+			LastOperation: OperationDetails{
+				Type: OperationProvision,
+			},
+		}
+
+		binding = BindingDetails{
+			ID:         "binding-id",
+			InstanceID: "instance-id",
+			Username:   "username",
+			DBName:     "dbname",
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		fileStore, err = NewFileStore(path, logger)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Describe("Instances", func() {
+		It("returns the proper error when the instance does not exist", func() {
+			_, err := fileStore.FindInstance("unknown")
+			Expect(err).To(Equal(ErrInstanceNotFound))
+
+			err = fileStore.DeleteInstance("unknown")
+			Expect(err).To(Equal(ErrInstanceNotFound))
+		})
+
+		It("saves, finds, lists and deletes instances", func() {
+			err := fileStore.SaveInstance(instance)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundInstance, err := fileStore.FindInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundInstance.PlanID).To(Equal("plan-id"))
+			Expect(foundInstance.LastOperation.Type).To(Equal(OperationProvision))
+
+			instances, err := fileStore.ListInstances()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+
+			err = fileStore.DeleteInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = fileStore.FindInstance("instance-id")
+			Expect(err).To(Equal(ErrInstanceNotFound))
+		})
+
+		It("does not share the stored parameters with callers", func() {
+			instance.Parameters = map[string]interface{}{"key": "value"}
+			err := fileStore.SaveInstance(instance)
+			Expect(err).ToNot(HaveOccurred())
+			instance.Parameters["key"] = "changed-after-save"
+
+			foundInstance, err := fileStore.FindInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundInstance.Parameters).To(Equal(map[string]interface{}{"key": "value"}))
+			foundInstance.Parameters["key"] = "changed-after-find"
+
+			instances, err := fileStore.ListInstances()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances[0].Parameters).To(Equal(map[string]interface{}{"key": "value"}))
+		})
+
+		It("persists instances across store reloads", func() {
+			err := fileStore.SaveInstance(instance)
+			Expect(err).ToNot(HaveOccurred())
+
+			reloadedStore, err := NewFileStore(path, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundInstance, err := reloadedStore.FindInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundInstance.ServiceID).To(Equal("service-id"))
+		})
+	})
+
+	Describe("Bindings", func() {
+		It("returns the proper error when the binding does not exist", func() {
+			_, err := fileStore.FindBinding("instance-id", "unknown")
+			Expect(err).To(Equal(ErrBindingNotFound))
+
+			err = fileStore.DeleteBinding("instance-id", "unknown")
+			Expect(err).To(Equal(ErrBindingNotFound))
+		})
+
+		It("saves, finds, lists and deletes bindings", func() {
+			err := fileStore.SaveBinding(binding)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundBinding, err := fileStore.FindBinding("instance-id", "binding-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundBinding.Username).To(Equal("username"))
+
+			bindings, err := fileStore.ListBindings("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bindings).To(HaveLen(1))
+
+			err = fileStore.DeleteBinding("instance-id", "binding-id")
+			Expect(err).ToNot(HaveOccurred())
+
+			bindings, err = fileStore.ListBindings("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bindings).To(BeEmpty())
+		})
+
+		It("does not share the stored parameters with callers", func() {
+			binding.Parameters = map[string]interface{}{"key": "value"}
+			err := fileStore.SaveBinding(binding)
+			Expect(err).ToNot(HaveOccurred())
+			binding.Parameters["key"] = "changed-after-save"
+
+			foundBinding, err := fileStore.FindBinding("instance-id", "binding-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundBinding.Parameters).To(Equal(map[string]interface{}{"key": "value"}))
+			foundBinding.Parameters["key"] = "changed-after-find"
+
+			bindings, err := fileStore.ListBindings("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bindings[0].Parameters).To(Equal(map[string]interface{}{"key": "value"}))
+		})
+
+		It("deletes bindings when the instance is deleted", func() {
+			err := fileStore.SaveInstance(instance)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = fileStore.SaveBinding(binding)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = fileStore.DeleteInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = fileStore.FindBinding("instance-id", "binding-id")
+			Expect(err).To(Equal(ErrBindingNotFound))
+		})
+	})
+})
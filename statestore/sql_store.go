@@ -0,0 +1,282 @@
+package statestore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql" // MySQL Driver
+	_ "github.com/lib/pq"              // PostgreSQL Driver
+
+	"github.com/pivotal-golang/lager"
+)
+
+const instancesTable = "rds_broker_instances"
+const bindingsTable = "rds_broker_bindings"
+
+type SQLStore struct {
+	driver string
+	db     *sql.DB
+	logger lager.Logger
+}
+
+func NewSQLStore(driver string, dataSource string, logger lager.Logger) (*SQLStore, error) {
+	db, err := sql.Open(driver, dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSQLStoreWithDB(driver, db, logger)
+}
+
+// NewSQLStoreWithDB creates a SQL Store on an already opened database, using the SQL dialect of the given driver
+func NewSQLStoreWithDB(driver string, db *sql.DB, logger lager.Logger) (*SQLStore, error) {
+	s := &SQLStore{
+		driver: driver,
+		db:     db,
+		logger: logger.Session("sql-store"),
+	}
+
+	if err := s.createTables(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *SQLStore) SaveInstance(instance InstanceDetails) error {
+	data, err := json.Marshal(instance)
+	if err != nil {
+		s.logger.Error("marshal-error", err)
+		return err
+	}
+
+	return s.upsert(
+		"INSERT INTO "+instancesTable+" (id, data) VALUES (?, ?)",
+		"id",
+		instance.ID, string(data),
+	)
+}
+
+func (s *SQLStore) FindInstance(instanceID string) (InstanceDetails, error) {
+	instance := InstanceDetails{}
+
+	var data string
+	err := s.db.QueryRow(s.rebind("SELECT data FROM "+instancesTable+" WHERE id = ?"), instanceID).Scan(&data)
+	switch {
+	case err == sql.ErrNoRows:
+		return instance, ErrInstanceNotFound
+	case err != nil:
+		s.logger.Error("sql-error", err)
+		return instance, err
+	}
+
+	if err = json.Unmarshal([]byte(data), &instance); err != nil {
+		s.logger.Error("unmarshal-error", err)
+		return instance, err
+	}
+
+	return instance, nil
+}
+
+func (s *SQLStore) ListInstances() ([]InstanceDetails, error) {
+	instances := []InstanceDetails{}
+
+	rows, err := s.db.Query("SELECT data FROM " + instancesTable + " ORDER BY id")
+	if err != nil {
+		s.logger.Error("sql-error", err)
+		return instances, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var data string
+		if err = rows.Scan(&data); err != nil {
+			s.logger.Error("sql-error", err)
+			return instances, err
+		}
+
+		instance := InstanceDetails{}
+		if err = json.Unmarshal([]byte(data), &instance); err != nil {
+			s.logger.Error("unmarshal-error", err)
+			return instances, err
+		}
+		instances = append(instances, instance)
+	}
+
+	if err = rows.Err(); err != nil {
+		s.logger.Error("sql-error", err)
+		return instances, err
+	}
+
+	return instances, nil
+}
+
+func (s *SQLStore) DeleteInstance(instanceID string) error {
+	// The instance and its bindings are deleted together, so no orphan bindings are left behind
+	tx, err := s.db.Begin()
+	if err != nil {
+		s.logger.Error("sql-error", err)
+		return err
+	}
+
+	result, err := tx.Exec(s.rebind("DELETE FROM "+instancesTable+" WHERE id = ?"), instanceID)
+	if err != nil {
+		tx.Rollback()
+		s.logger.Error("sql-error", err)
+		return err
+	}
+
+	if rowsAffected, err := result.RowsAffected(); err == nil && rowsAffected == 0 {
+		tx.Rollback()
+		return ErrInstanceNotFound
+	}
+
+	if _, err = tx.Exec(s.rebind("DELETE FROM "+bindingsTable+" WHERE instance_id = ?"), instanceID); err != nil {
+		tx.Rollback()
+		s.logger.Error("sql-error", err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		s.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *SQLStore) SaveBinding(binding BindingDetails) error {
+	data, err := json.Marshal(binding)
+	if err != nil {
+		s.logger.Error("marshal-error", err)
+		return err
+	}
+
+	return s.upsert(
+		"INSERT INTO "+bindingsTable+" (instance_id, id, data) VALUES (?, ?, ?)",
+		"instance_id, id",
+		binding.InstanceID, binding.ID, string(data),
+	)
+}
+
+func (s *SQLStore) FindBinding(instanceID string, bindingID string) (BindingDetails, error) {
+	binding := BindingDetails{}
+
+	var data string
+	err := s.db.QueryRow(s.rebind("SELECT data FROM "+bindingsTable+" WHERE instance_id = ? AND id = ?"), instanceID, bindingID).Scan(&data)
+	switch {
+	case err == sql.ErrNoRows:
+		return binding, ErrBindingNotFound
+	case err != nil:
+		s.logger.Error("sql-error", err)
+		return binding, err
+	}
+
+	if err = json.Unmarshal([]byte(data), &binding); err != nil {
+		s.logger.Error("unmarshal-error", err)
+		return binding, err
+	}
+
+	return binding, nil
+}
+
+func (s *SQLStore) ListBindings(instanceID string) ([]BindingDetails, error) {
+	bindings := []BindingDetails{}
+
+	rows, err := s.db.Query(s.rebind("SELECT data FROM "+bindingsTable+" WHERE instance_id = ? ORDER BY id"), instanceID)
+	if err != nil {
+		s.logger.Error("sql-error", err)
+		return bindings, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var data string
+		if err = rows.Scan(&data); err != nil {
+			s.logger.Error("sql-error", err)
+			return bindings, err
+		}
+
+		binding := BindingDetails{}
+		if err = json.Unmarshal([]byte(data), &binding); err != nil {
+			s.logger.Error("unmarshal-error", err)
+			return bindings, err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	if err = rows.Err(); err != nil {
+		s.logger.Error("sql-error", err)
+		return bindings, err
+	}
+
+	return bindings, nil
+}
+
+func (s *SQLStore) DeleteBinding(instanceID string, bindingID string) error {
+	result, err := s.db.Exec(s.rebind("DELETE FROM "+bindingsTable+" WHERE instance_id = ? AND id = ?"), instanceID, bindingID)
+	if err != nil {
+		s.logger.Error("sql-error", err)
+		return err
+	}
+
+	if rowsAffected, err := result.RowsAffected(); err == nil && rowsAffected == 0 {
+		return ErrBindingNotFound
+	}
+
+	return nil
+}
+
+func (s *SQLStore) Close() {
+	s.db.Close()
+}
+
+func (s *SQLStore) createTables() error {
+	createTableStatements := []string{
+		"CREATE TABLE IF NOT EXISTS " + instancesTable + " (id VARCHAR(255) NOT NULL, data TEXT NOT NULL, PRIMARY KEY (id))",
+		"CREATE TABLE IF NOT EXISTS " + bindingsTable + " (instance_id VARCHAR(255) NOT NULL, id VARCHAR(255) NOT NULL, data TEXT NOT NULL, PRIMARY KEY (instance_id, id))",
+	}
+
+	for _, createTableStatement := range createTableStatements {
+		s.logger.Debug("create-table", lager.Data{"statement": createTableStatement})
+		if _, err := s.db.Exec(createTableStatement); err != nil {
+			s.logger.Error("sql-error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SQLStore) upsert(insertStatement string, keyColumns string, args ...interface{}) error {
+	// A single statement replaces the existing row atomically, so concurrent saves of the same key cannot
+	// fail on the primary key (PostgreSQL requires 9.5 or later for ON CONFLICT)
+	upsertStatement := insertStatement + " ON DUPLICATE KEY UPDATE data = VALUES(data)"
+	if s.driver == "postgres" {
+		upsertStatement = insertStatement + " ON CONFLICT (" + keyColumns + ") DO UPDATE SET data = EXCLUDED.data"
+	}
+
+	if _, err := s.db.Exec(s.rebind(upsertStatement), args...); err != nil {
+		s.logger.Error("sql-error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *SQLStore) rebind(statement string) string {
+	if s.driver != "postgres" {
+		return statement
+	}
+
+	parts := strings.Split(statement, "?")
+	rebound := parts[0]
+	for i, part := range parts[1:] {
+		rebound += fmt.Sprintf("$%d", i+1) + part
+	}
+
+	return rebound
+}
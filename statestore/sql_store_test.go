@@ -0,0 +1,260 @@
+package statestore_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry-community/pe-rds-broker/statestore"
+
+	"github.com/pivotal-golang/lager"
+	"github.com/pivotal-golang/lager/lagertest"
+)
+
+var _ = Describe("SQL Store", func() {
+	var (
+		driver string
+		db     *sql.DB
+		mock   sqlmock.Sqlmock
+
+		logger lager.Logger
+
+		sqlStore *SQLStore
+
+		instance     InstanceDetails
+		instanceData string
+		binding      BindingDetails
+		bindingData  string
+	)
+
+	marshal := func(value interface{}) string {
+		data, err := json.Marshal(value)
+		Expect(err).ToNot(HaveOccurred())
+		return string(data)
+	}
+
+	BeforeEach(func() {
+		driver = "mysql"
+
+		var err error
+		db, mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+		Expect(err).ToNot(HaveOccurred())
+
+		logger = lager.NewLogger("sqlstore_test")
+		logger.RegisterSink(lagertest.NewTestSink())
+
+		instance = InstanceDetails{
+			ID:        "instance-id",
+			ServiceID: "service-id",
+			PlanID:    "plan-id",
+			LastOperation: OperationDetails{
+				Type: OperationProvision,
+			},
+		}
+		instanceData = marshal(instance)
+
+		binding = BindingDetails{
+			ID:         "binding-id",
+			InstanceID: "instance-id",
+			Username:   "username",
+			DBName:     "dbname",
+		}
+		bindingData = marshal(binding)
+	})
+
+	JustBeforeEach(func() {
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS rds_broker_instances (id VARCHAR(255) NOT NULL, data TEXT NOT NULL, PRIMARY KEY (id))").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("CREATE TABLE IF NOT EXISTS rds_broker_bindings (instance_id VARCHAR(255) NOT NULL, id VARCHAR(255) NOT NULL, data TEXT NOT NULL, PRIMARY KEY (instance_id, id))").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		var err error
+		sqlStore, err = NewSQLStoreWithDB(driver, db, logger)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).To(Succeed())
+		db.Close()
+	})
+
+	Describe("Instances", func() {
+		It("returns the proper error when the instance does not exist", func() {
+			mock.ExpectQuery("SELECT data FROM rds_broker_instances WHERE id = ?").
+				WithArgs("unknown").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}))
+			mock.ExpectBegin()
+			mock.ExpectExec("DELETE FROM rds_broker_instances WHERE id = ?").
+				WithArgs("unknown").
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+
+			_, err := sqlStore.FindInstance("unknown")
+			Expect(err).To(Equal(ErrInstanceNotFound))
+
+			err = sqlStore.DeleteInstance("unknown")
+			Expect(err).To(Equal(ErrInstanceNotFound))
+		})
+
+		It("saves, finds, lists and deletes instances", func() {
+			mock.ExpectExec("INSERT INTO rds_broker_instances (id, data) VALUES (?, ?) ON DUPLICATE KEY UPDATE data = VALUES(data)").
+				WithArgs("instance-id", instanceData).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery("SELECT data FROM rds_broker_instances WHERE id = ?").
+				WithArgs("instance-id").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(instanceData))
+			mock.ExpectQuery("SELECT data FROM rds_broker_instances ORDER BY id").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(instanceData))
+			mock.ExpectBegin()
+			mock.ExpectExec("DELETE FROM rds_broker_instances WHERE id = ?").
+				WithArgs("instance-id").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("DELETE FROM rds_broker_bindings WHERE instance_id = ?").
+				WithArgs("instance-id").
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectCommit()
+
+			err := sqlStore.SaveInstance(instance)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundInstance, err := sqlStore.FindInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundInstance).To(Equal(instance))
+
+			instances, err := sqlStore.ListInstances()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(Equal([]InstanceDetails{instance}))
+
+			err = sqlStore.DeleteInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("overwrites an existing instance", func() {
+			updatedInstance := instance
+			updatedInstance.PlanID = "other-plan-id"
+			updatedInstanceData := marshal(updatedInstance)
+
+			mock.ExpectExec("INSERT INTO rds_broker_instances (id, data) VALUES (?, ?) ON DUPLICATE KEY UPDATE data = VALUES(data)").
+				WithArgs("instance-id", instanceData).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO rds_broker_instances (id, data) VALUES (?, ?) ON DUPLICATE KEY UPDATE data = VALUES(data)").
+				WithArgs("instance-id", updatedInstanceData).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectQuery("SELECT data FROM rds_broker_instances WHERE id = ?").
+				WithArgs("instance-id").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(updatedInstanceData))
+
+			err := sqlStore.SaveInstance(instance)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = sqlStore.SaveInstance(updatedInstance)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundInstance, err := sqlStore.FindInstance("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundInstance.PlanID).To(Equal("other-plan-id"))
+		})
+
+		It("keeps the bindings when deleting the instance fails", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec("DELETE FROM rds_broker_instances WHERE id = ?").
+				WithArgs("instance-id").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("DELETE FROM rds_broker_bindings WHERE instance_id = ?").
+				WithArgs("instance-id").
+				WillReturnError(errors.New("Failed to delete bindings"))
+			mock.ExpectRollback()
+
+			err := sqlStore.DeleteInstance("instance-id")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("Failed to delete bindings"))
+		})
+
+		Context("when the driver is postgres", func() {
+			BeforeEach(func() {
+				driver = "postgres"
+			})
+
+			It("saves and finds instances", func() {
+				mock.ExpectExec("INSERT INTO rds_broker_instances (id, data) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data").
+					WithArgs("instance-id", instanceData).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery("SELECT data FROM rds_broker_instances WHERE id = $1").
+					WithArgs("instance-id").
+					WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(instanceData))
+
+				err := sqlStore.SaveInstance(instance)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundInstance, err := sqlStore.FindInstance("instance-id")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(foundInstance).To(Equal(instance))
+			})
+		})
+	})
+
+	Describe("Bindings", func() {
+		It("returns the proper error when the binding does not exist", func() {
+			mock.ExpectQuery("SELECT data FROM rds_broker_bindings WHERE instance_id = ? AND id = ?").
+				WithArgs("instance-id", "unknown").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}))
+			mock.ExpectExec("DELETE FROM rds_broker_bindings WHERE instance_id = ? AND id = ?").
+				WithArgs("instance-id", "unknown").
+				WillReturnResult(sqlmock.NewResult(0, 0))
+
+			_, err := sqlStore.FindBinding("instance-id", "unknown")
+			Expect(err).To(Equal(ErrBindingNotFound))
+
+			err = sqlStore.DeleteBinding("instance-id", "unknown")
+			Expect(err).To(Equal(ErrBindingNotFound))
+		})
+
+		It("saves, finds, lists and deletes bindings", func() {
+			mock.ExpectExec("INSERT INTO rds_broker_bindings (instance_id, id, data) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE data = VALUES(data)").
+				WithArgs("instance-id", "binding-id", bindingData).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery("SELECT data FROM rds_broker_bindings WHERE instance_id = ? AND id = ?").
+				WithArgs("instance-id", "binding-id").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(bindingData))
+			mock.ExpectQuery("SELECT data FROM rds_broker_bindings WHERE instance_id = ? ORDER BY id").
+				WithArgs("instance-id").
+				WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(bindingData))
+			mock.ExpectExec("DELETE FROM rds_broker_bindings WHERE instance_id = ? AND id = ?").
+				WithArgs("instance-id", "binding-id").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			err := sqlStore.SaveBinding(binding)
+			Expect(err).ToNot(HaveOccurred())
+
+			foundBinding, err := sqlStore.FindBinding("instance-id", "binding-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(foundBinding).To(Equal(binding))
+
+			bindings, err := sqlStore.ListBindings("instance-id")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bindings).To(Equal([]BindingDetails{binding}))
+
+			err = sqlStore.DeleteBinding("instance-id", "binding-id")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the driver is postgres", func() {
+			BeforeEach(func() {
+				driver = "postgres"
+			})
+
+			It("upserts on the instance and binding ids", func() {
+				mock.ExpectExec("INSERT INTO rds_broker_bindings (instance_id, id, data) VALUES ($1, $2, $3) ON CONFLICT (instance_id, id) DO UPDATE SET data = EXCLUDED.data").
+					WithArgs("instance-id", "binding-id", bindingData).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+
+				err := sqlStore.SaveBinding(binding)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package statestore
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type Store interface {
+	SaveInstance(instance InstanceDetails) error
+	FindInstance(instanceID string) (InstanceDetails, error)
+	ListInstances() ([]InstanceDetails, error)
+	DeleteInstance(instanceID string) error
+	SaveBinding(binding BindingDetails) error
+	FindBinding(instanceID string, bindingID string) (BindingDetails, error)
+	ListBindings(instanceID string) ([]BindingDetails, error)
+	DeleteBinding(instanceID string, bindingID string) error
+}
+
+type InstanceDetails struct {
+	ID                    string                 `json:"id"`
+	ServiceID             string                 `json:"service_id"`
+	PlanID                string                 `json:"plan_id"`
+	OrganizationGUID      string                 `json:"organization_guid,omitempty"`
+	SpaceGUID             string                 `json:"space_guid,omitempty"`
+	Parameters            map[string]interface{} `json:"parameters,omitempty"`
+	Context               map[string]interface{} `json:"context,omitempty"`
+	RDSProperties         json.RawMessage        `json:"rds_properties,omitempty"`
+	MasterPassword        string                 `json:"master_password,omitempty"`
+	PendingMasterPassword string                 `json:"pending_master_password,omitempty"`
+	ResetMasterPassword   bool                   `json:"reset_master_password,omitempty"`
+	ReadReplicaOf         string                 `json:"read_replica_of,omitempty"`
+	LastOperation         OperationDetails       `json:"last_operation"`
+	CreatedAt             time.Time              `json:"created_at"`
+	UpdatedAt             time.Time              `json:"updated_at"`
+}
+
+type OperationDetails struct {
+	Type         string                 `json:"type"`
+	State        string                 `json:"state,omitempty"`
+	Step         string                 `json:"step,omitempty"`
+	RollbackStep string                 `json:"rollback_step,omitempty"`
+	Attempts     int                    `json:"attempts,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+	StartedAt    time.Time              `json:"started_at"`
+}
+
+type BindingDetails struct {
+	ID              string                 `json:"id"`
+	InstanceID      string                 `json:"instance_id"`
+	AppGUID         string                 `json:"app_guid,omitempty"`
+	Username        string                 `json:"username"`
+	Password        string                 `json:"password,omitempty"`
+	PendingPassword string                 `json:"pending_password,omitempty"`
+	DBName          string                 `json:"dbname"`
+	Role            string                 `json:"role,omitempty"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+	RotatedAt       time.Time              `json:"rotated_at,omitempty"`
+}
+
+const (
+	OperationProvision            = "provision"
+	OperationUpdate               = "update"
+	OperationDeprovision          = "deprovision"
+	OperationRotateMasterPassword = "rotate-master-password"
+)
+
+var (
+	ErrInstanceNotFound = errors.New("state store instance not found")
+	ErrBindingNotFound  = errors.New("state store binding not found")
+)